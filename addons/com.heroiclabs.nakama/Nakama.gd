@@ -62,7 +62,10 @@ func create_socket_from(p_client : NakamaClient) -> NakamaSocket:
 	var scheme = "ws"
 	if p_client.scheme == "https":
 		scheme = "wss"
-	return NakamaSocket.new(create_socket_adapter(), p_client.host, p_client.port, scheme, true)
+	var socket = NakamaSocket.new(create_socket_adapter(), p_client.host, p_client.port, scheme, true)
+	if p_client.trace_id:
+		socket.trace_id = p_client.trace_id
+	return socket
 
 func get_device_id() -> String:
 	if _device_id != "":
@@ -10,27 +10,122 @@ class ApiAuthenticateLogoutRequest extends SatoriAsyncResult:
 		"refresh_token": {"name": "_refresh_token", "type": TYPE_STRING, "required": false},
 		"token": {"name": "_token", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _refresh_token
 	## Refresh token to invalidate.
 	var refresh_token : String:
 		get:
 			return "" if not _refresh_token is String else String(_refresh_token)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refresh_token: %s" % [v])
+				return
+			v = str(v)
+			_refresh_token = v
+
 	var _token
 	## Session token to log out.
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAuthenticateLogoutRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_refresh_token = null, # : String
+		p_token = null, # : String
+	) -> ApiAuthenticateLogoutRequest:
+		var obj := ApiAuthenticateLogoutRequest.new()
+		if p_refresh_token != null:
+			obj.refresh_token = str(p_refresh_token) if p_refresh_token is int else p_refresh_token
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAuthenticateLogoutRequest:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiAuthenticateLogoutRequest", p_dict), ApiAuthenticateLogoutRequest) as ApiAuthenticateLogoutRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAuthenticateLogoutRequest from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAuthenticateLogoutRequest:
+		var obj := ApiAuthenticateLogoutRequest.new()
+		var v : Variant
+		v = p_dict.get("refresh_token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refresh_token = v
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _refresh_token != null:
+			out["refresh_token"] = _refresh_token
+		if _token != null:
+			out["token"] = _token
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAuthenticateLogoutRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAuthenticateLogoutRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAuthenticateLogoutRequest = p_other
+		if typeof(_refresh_token) != typeof(other._refresh_token):
+			return false
+		if _refresh_token != other._refresh_token:
+			return false
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAuthenticateLogoutRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAuthenticateLogoutRequest:
+		var obj := ApiAuthenticateLogoutRequest.new(_ex)
+		obj._refresh_token = _refresh_token
+		obj._token = _token
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_refresh_token)
+		values.append(_token)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -48,21 +143,93 @@ class ApiAuthenticateRefreshRequest extends SatoriAsyncResult:
 	const _SCHEMA = {
 		"refresh_token": {"name": "_refresh_token", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _refresh_token
 	## Refresh token.
 	var refresh_token : String:
 		get:
 			return "" if not _refresh_token is String else String(_refresh_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refresh_token: %s" % [v])
+				return
+			v = str(v)
+			_refresh_token = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAuthenticateRefreshRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_refresh_token = null, # : String
+	) -> ApiAuthenticateRefreshRequest:
+		var obj := ApiAuthenticateRefreshRequest.new()
+		if p_refresh_token != null:
+			obj.refresh_token = str(p_refresh_token) if p_refresh_token is int else p_refresh_token
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAuthenticateRefreshRequest:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiAuthenticateRefreshRequest", p_dict), ApiAuthenticateRefreshRequest) as ApiAuthenticateRefreshRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAuthenticateRefreshRequest from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAuthenticateRefreshRequest:
+		var obj := ApiAuthenticateRefreshRequest.new()
+		var v : Variant
+		v = p_dict.get("refresh_token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refresh_token = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _refresh_token != null:
+			out["refresh_token"] = _refresh_token
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAuthenticateRefreshRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAuthenticateRefreshRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAuthenticateRefreshRequest = p_other
+		if typeof(_refresh_token) != typeof(other._refresh_token):
+			return false
+		if _refresh_token != other._refresh_token:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAuthenticateRefreshRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAuthenticateRefreshRequest:
+		var obj := ApiAuthenticateRefreshRequest.new(_ex)
+		obj._refresh_token = _refresh_token
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_refresh_token)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -80,36 +247,180 @@ class ApiAuthenticateRequest extends SatoriAsyncResult:
 		"default": {"name": "_default", "type": TYPE_DICTIONARY, "required": false, "content": TYPE_STRING},
 		"id": {"name": "_id", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _custom
 	## Optional custom properties to update with this call. [br]
 	## If not set, properties are left as they are on the server.
 	var custom : Dictionary:
 		get:
 			return Dictionary() if not _custom is Dictionary else _custom.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for custom: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of custom: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_custom = map
+
 	var _default
 	## Optional default properties to update with this call. [br]
 	## If not set, properties are left as they are on the server.
 	var default : Dictionary:
 		get:
 			return Dictionary() if not _default is Dictionary else _default.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for default: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of default: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_default = map
+
 	var _id
 	## Identity ID. Must be between eight and 128 characters (inclusive). [br]
 	## Must be an alphanumeric string with only underscores and hyphens allowed.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAuthenticateRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_custom = null, # : Dictionary
+		p_default = null, # : Dictionary
+		p_id = null, # : String
+	) -> ApiAuthenticateRequest:
+		var obj := ApiAuthenticateRequest.new()
+		if p_custom != null:
+			obj.custom = p_custom
+		if p_default != null:
+			obj.default = p_default
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAuthenticateRequest:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiAuthenticateRequest", p_dict), ApiAuthenticateRequest) as ApiAuthenticateRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAuthenticateRequest from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAuthenticateRequest:
+		var obj := ApiAuthenticateRequest.new()
+		var v : Variant
+		v = p_dict.get("custom")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._custom = map
+		v = p_dict.get("default")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._default = map
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _custom is Dictionary:
+			var map := {}
+			for k in _custom:
+				if _custom[k] is String:
+					map[k] = _custom[k]
+			out["custom"] = map
+		if _default is Dictionary:
+			var map := {}
+			for k in _default:
+				if _default[k] is String:
+					map[k] = _default[k]
+			out["default"] = map
+		if _id != null:
+			out["id"] = _id
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAuthenticateRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAuthenticateRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAuthenticateRequest = p_other
+		if typeof(_custom) != typeof(other._custom):
+			return false
+		if _custom != other._custom:
+			return false
+		if typeof(_default) != typeof(other._default):
+			return false
+		if _default != other._default:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAuthenticateRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAuthenticateRequest:
+		var obj := ApiAuthenticateRequest.new(_ex)
+		if _custom is Dictionary:
+			var map : Dictionary = _custom
+			obj._custom = map.duplicate(true)
+		if _default is Dictionary:
+			var map : Dictionary = _default
+			obj._default = map.duplicate(true)
+		obj._id = _id
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_custom))
+		values.append(_hashable(_default))
+		values.append(_id)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -140,46 +451,223 @@ class ApiEvent extends SatoriAsyncResult:
 		"timestamp": {"name": "_timestamp", "type": TYPE_STRING, "required": false},
 		"value": {"name": "_value", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _id
 	## Optional event ID assigned by the client, used to de-duplicate in retransmission scenarios. [br]
 	## If not supplied the server will assign a randomly generated unique event identifier.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
+
 	var _metadata
 	## Event metadata, if any.
 	var metadata : Dictionary:
 		get:
 			return Dictionary() if not _metadata is Dictionary else _metadata.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for metadata: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of metadata: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_metadata = map
+
 	var _name
 	## Event name.
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
+
 	var _timestamp
 	## The time when the event was triggered on the producer side.
 	var timestamp : String:
 		get:
 			return "" if not _timestamp is String else String(_timestamp)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for timestamp: %s" % [v])
+				return
+			v = str(v)
+			_timestamp = v
+
 	var _value
 	## Optional value.
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiEvent from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_id = null, # : String
+		p_metadata = null, # : Dictionary
+		p_name = null, # : String
+		p_timestamp = null, # : String
+		p_value = null, # : String
+	) -> ApiEvent:
+		var obj := ApiEvent.new()
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_metadata != null:
+			obj.metadata = p_metadata
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_timestamp != null:
+			obj.timestamp = str(p_timestamp) if p_timestamp is int else p_timestamp
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiEvent:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiEvent", p_dict), ApiEvent) as ApiEvent
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiEvent from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiEvent:
+		var obj := ApiEvent.new()
+		var v : Variant
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("metadata")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._metadata = map
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("timestamp")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._timestamp = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _id != null:
+			out["id"] = _id
+		if _metadata is Dictionary:
+			var map := {}
+			for k in _metadata:
+				if _metadata[k] is String:
+					map[k] = _metadata[k]
+			out["metadata"] = map
+		if _name != null:
+			out["name"] = _name
+		if _timestamp != null:
+			out["timestamp"] = _timestamp
+		if _value != null:
+			out["value"] = _value
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiEvent, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiEvent:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiEvent = p_other
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_timestamp) != typeof(other._timestamp):
+			return false
+		if _timestamp != other._timestamp:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiEvent which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiEvent:
+		var obj := ApiEvent.new(_ex)
+		obj._id = _id
+		if _metadata is Dictionary:
+			var map : Dictionary = _metadata
+			obj._metadata = map.duplicate(true)
+		obj._name = _name
+		obj._timestamp = _timestamp
+		obj._value = _value
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_id)
+		values.append(_hashable(_metadata))
+		values.append(_name)
+		values.append(_timestamp)
+		values.append(_value)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -203,21 +691,102 @@ class ApiEventRequest extends SatoriAsyncResult:
 	const _SCHEMA = {
 		"events": {"name": "_events", "type": TYPE_ARRAY, "required": false, "content": TYPE_DICTIONARY},
 	}
-	
+
 	var _events
 	## Some number of events produced by a client.
 	var events : Array:
 		get:
 			return Array() if not _events is Array else Array(_events)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				var v : Variant = e
+				if not (v is Dictionary):
+					push_error("Invalid Dictionary value for an element of events: %s" % [v])
+					return
+				arr.append(v)
+			_events = arr
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiEventRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_events = null, # : Array
+	) -> ApiEventRequest:
+		var obj := ApiEventRequest.new()
+		if p_events != null:
+			obj.events = p_events
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiEventRequest:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiEventRequest", p_dict), ApiEventRequest) as ApiEventRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiEventRequest from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiEventRequest:
+		var obj := ApiEventRequest.new()
+		var v : Variant
+		v = p_dict.get("events")
+		if v is Array:
+			var src : Array = v
+			var arr := src.duplicate()
+			obj._events = arr
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _events != null:
+			var arr := []
+			for e in _events:
+				arr.append(e)
+			out["events"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiEventRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiEventRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiEventRequest = p_other
+		if typeof(_events) != typeof(other._events):
+			return false
+		if _events != other._events:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiEventRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiEventRequest:
+		var obj := ApiEventRequest.new(_ex)
+		if _events is Array:
+			var arr : Array = _events
+			obj._events = arr.duplicate(true)
+		else:
+			obj._events = _events
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_events))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -235,27 +804,121 @@ class ApiExperiment extends SatoriAsyncResult:
 		"name": {"name": "_name", "type": TYPE_STRING, "required": false},
 		"value": {"name": "_value", "type": TYPE_STRING, "required": false},
 	}
-	
-	# 
+
 	var _name
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
+
 	var _value
 	## Value associated with this Experiment.
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiExperiment from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_name = null, # : String
+		p_value = null, # : String
+	) -> ApiExperiment:
+		var obj := ApiExperiment.new()
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiExperiment:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiExperiment", p_dict), ApiExperiment) as ApiExperiment
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiExperiment from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiExperiment:
+		var obj := ApiExperiment.new()
+		var v : Variant
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _name != null:
+			out["name"] = _name
+		if _value != null:
+			out["value"] = _value
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiExperiment, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiExperiment:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiExperiment = p_other
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiExperiment which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiExperiment:
+		var obj := ApiExperiment.new(_ex)
+		obj._name = _name
+		obj._value = _value
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_name)
+		values.append(_value)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -273,21 +936,102 @@ class ApiExperimentList extends SatoriAsyncResult:
 	const _SCHEMA = {
 		"experiments": {"name": "_experiments", "type": TYPE_ARRAY, "required": false, "content": TYPE_DICTIONARY},
 	}
-	
+
 	var _experiments
 	## All experiments for this identity.
 	var experiments : Array:
 		get:
 			return Array() if not _experiments is Array else Array(_experiments)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				var v : Variant = e
+				if not (v is Dictionary):
+					push_error("Invalid Dictionary value for an element of experiments: %s" % [v])
+					return
+				arr.append(v)
+			_experiments = arr
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiExperimentList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_experiments = null, # : Array
+	) -> ApiExperimentList:
+		var obj := ApiExperimentList.new()
+		if p_experiments != null:
+			obj.experiments = p_experiments
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiExperimentList:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiExperimentList", p_dict), ApiExperimentList) as ApiExperimentList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiExperimentList from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiExperimentList:
+		var obj := ApiExperimentList.new()
+		var v : Variant
+		v = p_dict.get("experiments")
+		if v is Array:
+			var src : Array = v
+			var arr := src.duplicate()
+			obj._experiments = arr
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _experiments != null:
+			var arr := []
+			for e in _experiments:
+				arr.append(e)
+			out["experiments"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiExperimentList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiExperimentList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiExperimentList = p_other
+		if typeof(_experiments) != typeof(other._experiments):
+			return false
+		if _experiments != other._experiments:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiExperimentList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiExperimentList:
+		var obj := ApiExperimentList.new(_ex)
+		if _experiments is Array:
+			var arr : Array = _experiments
+			obj._experiments = arr.duplicate(true)
+		else:
+			obj._experiments = _experiments
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_experiments))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -306,33 +1050,147 @@ class ApiFlag extends SatoriAsyncResult:
 		"name": {"name": "_name", "type": TYPE_STRING, "required": false},
 		"value": {"name": "_value", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _condition_changed
 	## Whether the value for this flag has conditionally changed from the default state.
 	var condition_changed : bool:
 		get:
 			return false if not _condition_changed is bool else bool(_condition_changed)
-	
-	# 
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for condition_changed: %s" % [v])
+				return
+			_condition_changed = v
+
 	var _name
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
+
 	var _value
 	## Value associated with this flag.
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiFlag from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_condition_changed = null, # : bool
+		p_name = null, # : String
+		p_value = null, # : String
+	) -> ApiFlag:
+		var obj := ApiFlag.new()
+		if p_condition_changed != null:
+			obj.condition_changed = p_condition_changed
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiFlag:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiFlag", p_dict), ApiFlag) as ApiFlag
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiFlag from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiFlag:
+		var obj := ApiFlag.new()
+		var v : Variant
+		v = p_dict.get("condition_changed")
+		if v is bool:
+			obj._condition_changed = v
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _condition_changed != null:
+			out["condition_changed"] = _condition_changed
+		if _name != null:
+			out["name"] = _name
+		if _value != null:
+			out["value"] = _value
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiFlag, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiFlag:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiFlag = p_other
+		if typeof(_condition_changed) != typeof(other._condition_changed):
+			return false
+		if _condition_changed != other._condition_changed:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiFlag which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiFlag:
+		var obj := ApiFlag.new(_ex)
+		obj._condition_changed = _condition_changed
+		obj._name = _name
+		obj._value = _value
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_condition_changed)
+		values.append(_name)
+		values.append(_value)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -350,20 +1208,101 @@ class ApiFlagList extends SatoriAsyncResult:
 	const _SCHEMA = {
 		"flags": {"name": "_flags", "type": TYPE_ARRAY, "required": false, "content": TYPE_DICTIONARY},
 	}
-	
+
 	var _flags
 	var flags : Array:
 		get:
 			return Array() if not _flags is Array else Array(_flags)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				var v : Variant = e
+				if not (v is Dictionary):
+					push_error("Invalid Dictionary value for an element of flags: %s" % [v])
+					return
+				arr.append(v)
+			_flags = arr
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiFlagList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_flags = null, # : Array
+	) -> ApiFlagList:
+		var obj := ApiFlagList.new()
+		if p_flags != null:
+			obj.flags = p_flags
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiFlagList:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiFlagList", p_dict), ApiFlagList) as ApiFlagList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiFlagList from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiFlagList:
+		var obj := ApiFlagList.new()
+		var v : Variant
+		v = p_dict.get("flags")
+		if v is Array:
+			var src : Array = v
+			var arr := src.duplicate()
+			obj._flags = arr
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _flags != null:
+			var arr := []
+			for e in _flags:
+				arr.append(e)
+			out["flags"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiFlagList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiFlagList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiFlagList = p_other
+		if typeof(_flags) != typeof(other._flags):
+			return false
+		if _flags != other._flags:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiFlagList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiFlagList:
+		var obj := ApiFlagList.new(_ex)
+		if _flags is Array:
+			var arr : Array = _flags
+			obj._flags = arr.duplicate(true)
+		else:
+			obj._flags = _flags
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_flags))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -383,39 +1322,189 @@ class ApiGetMessageListResponse extends SatoriAsyncResult:
 		"next_cursor": {"name": "_next_cursor", "type": TYPE_STRING, "required": false},
 		"prev_cursor": {"name": "_prev_cursor", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _cacheable_cursor
 	## Cacheable cursor to list newer messages. Durable and designed to be stored, unlike next/prev cursors.
 	var cacheable_cursor : String:
 		get:
 			return "" if not _cacheable_cursor is String else String(_cacheable_cursor)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cacheable_cursor: %s" % [v])
+				return
+			v = str(v)
+			_cacheable_cursor = v
+
 	var _messages
 	## The list of messages.
 	var messages : Array:
 		get:
 			return Array() if not _messages is Array else Array(_messages)
-	
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				var v : Variant = e
+				if not (v is Dictionary):
+					push_error("Invalid Dictionary value for an element of messages: %s" % [v])
+					return
+				arr.append(v)
+			_messages = arr
+
 	var _next_cursor
 	## The cursor to send when retrieving the next page, if any.
 	var next_cursor : String:
 		get:
 			return "" if not _next_cursor is String else String(_next_cursor)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for next_cursor: %s" % [v])
+				return
+			v = str(v)
+			_next_cursor = v
+
 	var _prev_cursor
 	## The cursor to send when retrieving the previous page, if any.
 	var prev_cursor : String:
 		get:
 			return "" if not _prev_cursor is String else String(_prev_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for prev_cursor: %s" % [v])
+				return
+			v = str(v)
+			_prev_cursor = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiGetMessageListResponse from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cacheable_cursor = null, # : String
+		p_messages = null, # : Array
+		p_next_cursor = null, # : String
+		p_prev_cursor = null, # : String
+	) -> ApiGetMessageListResponse:
+		var obj := ApiGetMessageListResponse.new()
+		if p_cacheable_cursor != null:
+			obj.cacheable_cursor = str(p_cacheable_cursor) if p_cacheable_cursor is int else p_cacheable_cursor
+		if p_messages != null:
+			obj.messages = p_messages
+		if p_next_cursor != null:
+			obj.next_cursor = str(p_next_cursor) if p_next_cursor is int else p_next_cursor
+		if p_prev_cursor != null:
+			obj.prev_cursor = str(p_prev_cursor) if p_prev_cursor is int else p_prev_cursor
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiGetMessageListResponse:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiGetMessageListResponse", p_dict), ApiGetMessageListResponse) as ApiGetMessageListResponse
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiGetMessageListResponse from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiGetMessageListResponse:
+		var obj := ApiGetMessageListResponse.new()
+		var v : Variant
+		v = p_dict.get("cacheable_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cacheable_cursor = v
+		v = p_dict.get("messages")
+		if v is Array:
+			var src : Array = v
+			var arr := src.duplicate()
+			obj._messages = arr
+		v = p_dict.get("next_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._next_cursor = v
+		v = p_dict.get("prev_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._prev_cursor = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cacheable_cursor != null:
+			out["cacheable_cursor"] = _cacheable_cursor
+		if _messages != null:
+			var arr := []
+			for e in _messages:
+				arr.append(e)
+			out["messages"] = arr
+		if _next_cursor != null:
+			out["next_cursor"] = _next_cursor
+		if _prev_cursor != null:
+			out["prev_cursor"] = _prev_cursor
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiGetMessageListResponse, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiGetMessageListResponse:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiGetMessageListResponse = p_other
+		if typeof(_cacheable_cursor) != typeof(other._cacheable_cursor):
+			return false
+		if _cacheable_cursor != other._cacheable_cursor:
+			return false
+		if typeof(_messages) != typeof(other._messages):
+			return false
+		if _messages != other._messages:
+			return false
+		if typeof(_next_cursor) != typeof(other._next_cursor):
+			return false
+		if _next_cursor != other._next_cursor:
+			return false
+		if typeof(_prev_cursor) != typeof(other._prev_cursor):
+			return false
+		if _prev_cursor != other._prev_cursor:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiGetMessageListResponse which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiGetMessageListResponse:
+		var obj := ApiGetMessageListResponse.new(_ex)
+		obj._cacheable_cursor = _cacheable_cursor
+		if _messages is Array:
+			var arr : Array = _messages
+			obj._messages = arr.duplicate(true)
+		else:
+			obj._messages = _messages
+		obj._next_cursor = _next_cursor
+		obj._prev_cursor = _prev_cursor
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cacheable_cursor)
+		values.append(_hashable(_messages))
+		values.append(_next_cursor)
+		values.append(_prev_cursor)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -437,35 +1526,179 @@ class ApiIdentifyRequest extends SatoriAsyncResult:
 		"default": {"name": "_default", "type": TYPE_DICTIONARY, "required": false, "content": TYPE_STRING},
 		"id": {"name": "_id", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _custom
 	## Optional custom properties to update with this call. [br]
 	## If not set, properties are left as they are on the server.
 	var custom : Dictionary:
 		get:
 			return Dictionary() if not _custom is Dictionary else _custom.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for custom: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of custom: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_custom = map
+
 	var _default
 	## Optional default properties to update with this call. [br]
 	## If not set, properties are left as they are on the server.
 	var default : Dictionary:
 		get:
 			return Dictionary() if not _default is Dictionary else _default.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for default: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of default: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_default = map
+
 	var _id
 	## Identity ID to enrich the current session and return a new session. Old session will no longer be usable.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiIdentifyRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_custom = null, # : Dictionary
+		p_default = null, # : Dictionary
+		p_id = null, # : String
+	) -> ApiIdentifyRequest:
+		var obj := ApiIdentifyRequest.new()
+		if p_custom != null:
+			obj.custom = p_custom
+		if p_default != null:
+			obj.default = p_default
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiIdentifyRequest:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiIdentifyRequest", p_dict), ApiIdentifyRequest) as ApiIdentifyRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiIdentifyRequest from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiIdentifyRequest:
+		var obj := ApiIdentifyRequest.new()
+		var v : Variant
+		v = p_dict.get("custom")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._custom = map
+		v = p_dict.get("default")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._default = map
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _custom is Dictionary:
+			var map := {}
+			for k in _custom:
+				if _custom[k] is String:
+					map[k] = _custom[k]
+			out["custom"] = map
+		if _default is Dictionary:
+			var map := {}
+			for k in _default:
+				if _default[k] is String:
+					map[k] = _default[k]
+			out["default"] = map
+		if _id != null:
+			out["id"] = _id
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiIdentifyRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiIdentifyRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiIdentifyRequest = p_other
+		if typeof(_custom) != typeof(other._custom):
+			return false
+		if _custom != other._custom:
+			return false
+		if typeof(_default) != typeof(other._default):
+			return false
+		if _default != other._default:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiIdentifyRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiIdentifyRequest:
+		var obj := ApiIdentifyRequest.new(_ex)
+		if _custom is Dictionary:
+			var map : Dictionary = _custom
+			obj._custom = map.duplicate(true)
+		if _default is Dictionary:
+			var map : Dictionary = _default
+			obj._default = map.duplicate(true)
+		obj._id = _id
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_custom))
+		values.append(_hashable(_default))
+		values.append(_id)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -497,51 +1730,238 @@ class ApiLiveEvent extends SatoriAsyncResult:
 		"name": {"name": "_name", "type": TYPE_STRING, "required": false},
 		"value": {"name": "_value", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _active_end_time_sec
 	## End time of current event run.
 	var active_end_time_sec : String:
 		get:
 			return "" if not _active_end_time_sec is String else String(_active_end_time_sec)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for active_end_time_sec: %s" % [v])
+				return
+			v = str(v)
+			_active_end_time_sec = v
+
 	var _active_start_time_sec
 	## Start time of current event run.
 	var active_start_time_sec : String:
 		get:
 			return "" if not _active_start_time_sec is String else String(_active_start_time_sec)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for active_start_time_sec: %s" % [v])
+				return
+			v = str(v)
+			_active_start_time_sec = v
+
 	var _description
 	## Description.
 	var description : String:
 		get:
 			return "" if not _description is String else String(_description)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for description: %s" % [v])
+				return
+			v = str(v)
+			_description = v
+
 	var _id
 	## The live event identifier.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
+
 	var _name
 	## Name.
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
+
 	var _value
 	## Event value.
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiLiveEvent from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_active_end_time_sec = null, # : String
+		p_active_start_time_sec = null, # : String
+		p_description = null, # : String
+		p_id = null, # : String
+		p_name = null, # : String
+		p_value = null, # : String
+	) -> ApiLiveEvent:
+		var obj := ApiLiveEvent.new()
+		if p_active_end_time_sec != null:
+			obj.active_end_time_sec = str(p_active_end_time_sec) if p_active_end_time_sec is int else p_active_end_time_sec
+		if p_active_start_time_sec != null:
+			obj.active_start_time_sec = str(p_active_start_time_sec) if p_active_start_time_sec is int else p_active_start_time_sec
+		if p_description != null:
+			obj.description = str(p_description) if p_description is int else p_description
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiLiveEvent:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiLiveEvent", p_dict), ApiLiveEvent) as ApiLiveEvent
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiLiveEvent from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiLiveEvent:
+		var obj := ApiLiveEvent.new()
+		var v : Variant
+		v = p_dict.get("active_end_time_sec")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._active_end_time_sec = v
+		v = p_dict.get("active_start_time_sec")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._active_start_time_sec = v
+		v = p_dict.get("description")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._description = v
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _active_end_time_sec != null:
+			out["active_end_time_sec"] = _active_end_time_sec
+		if _active_start_time_sec != null:
+			out["active_start_time_sec"] = _active_start_time_sec
+		if _description != null:
+			out["description"] = _description
+		if _id != null:
+			out["id"] = _id
+		if _name != null:
+			out["name"] = _name
+		if _value != null:
+			out["value"] = _value
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiLiveEvent, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiLiveEvent:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiLiveEvent = p_other
+		if typeof(_active_end_time_sec) != typeof(other._active_end_time_sec):
+			return false
+		if _active_end_time_sec != other._active_end_time_sec:
+			return false
+		if typeof(_active_start_time_sec) != typeof(other._active_start_time_sec):
+			return false
+		if _active_start_time_sec != other._active_start_time_sec:
+			return false
+		if typeof(_description) != typeof(other._description):
+			return false
+		if _description != other._description:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiLiveEvent which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiLiveEvent:
+		var obj := ApiLiveEvent.new(_ex)
+		obj._active_end_time_sec = _active_end_time_sec
+		obj._active_start_time_sec = _active_start_time_sec
+		obj._description = _description
+		obj._id = _id
+		obj._name = _name
+		obj._value = _value
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_active_end_time_sec)
+		values.append(_active_start_time_sec)
+		values.append(_description)
+		values.append(_id)
+		values.append(_name)
+		values.append(_value)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -563,21 +1983,102 @@ class ApiLiveEventList extends SatoriAsyncResult:
 	const _SCHEMA = {
 		"live_events": {"name": "_live_events", "type": TYPE_ARRAY, "required": false, "content": TYPE_DICTIONARY},
 	}
-	
+
 	var _live_events
 	## Live events.
 	var live_events : Array:
 		get:
 			return Array() if not _live_events is Array else Array(_live_events)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				var v : Variant = e
+				if not (v is Dictionary):
+					push_error("Invalid Dictionary value for an element of live_events: %s" % [v])
+					return
+				arr.append(v)
+			_live_events = arr
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiLiveEventList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_live_events = null, # : Array
+	) -> ApiLiveEventList:
+		var obj := ApiLiveEventList.new()
+		if p_live_events != null:
+			obj.live_events = p_live_events
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiLiveEventList:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiLiveEventList", p_dict), ApiLiveEventList) as ApiLiveEventList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiLiveEventList from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiLiveEventList:
+		var obj := ApiLiveEventList.new()
+		var v : Variant
+		v = p_dict.get("live_events")
+		if v is Array:
+			var src : Array = v
+			var arr := src.duplicate()
+			obj._live_events = arr
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _live_events != null:
+			var arr := []
+			for e in _live_events:
+				arr.append(e)
+			out["live_events"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiLiveEventList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiLiveEventList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiLiveEventList = p_other
+		if typeof(_live_events) != typeof(other._live_events):
+			return false
+		if _live_events != other._live_events:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiLiveEventList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiLiveEventList:
+		var obj := ApiLiveEventList.new(_ex)
+		if _live_events is Array:
+			var arr : Array = _live_events
+			obj._live_events = arr.duplicate(true)
+		else:
+			obj._live_events = _live_events
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_live_events))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -601,63 +2102,309 @@ class ApiMessage extends SatoriAsyncResult:
 		"text": {"name": "_text", "type": TYPE_STRING, "required": false},
 		"update_time": {"name": "_update_time", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _consume_time
 	## The time the message was consumed by the identity.
 	var consume_time : String:
 		get:
 			return "" if not _consume_time is String else String(_consume_time)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for consume_time: %s" % [v])
+				return
+			v = str(v)
+			_consume_time = v
+
 	var _create_time
 	## The time the message was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
+
 	var _metadata
 	## A key-value pairs of metadata.
 	var metadata : Dictionary:
 		get:
 			return Dictionary() if not _metadata is Dictionary else _metadata.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for metadata: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of metadata: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_metadata = map
+
 	var _read_time
 	## The time the message was read by the client.
 	var read_time : String:
 		get:
 			return "" if not _read_time is String else String(_read_time)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for read_time: %s" % [v])
+				return
+			v = str(v)
+			_read_time = v
+
 	var _schedule_id
 	## The identifier of the schedule.
 	var schedule_id : String:
 		get:
 			return "" if not _schedule_id is String else String(_schedule_id)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for schedule_id: %s" % [v])
+				return
+			v = str(v)
+			_schedule_id = v
+
 	var _send_time
 	## The send time for the message.
 	var send_time : String:
 		get:
 			return "" if not _send_time is String else String(_send_time)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for send_time: %s" % [v])
+				return
+			v = str(v)
+			_send_time = v
+
 	var _text
 	## The message's text.
 	var text : String:
 		get:
 			return "" if not _text is String else String(_text)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for text: %s" % [v])
+				return
+			v = str(v)
+			_text = v
+
 	var _update_time
 	## The time the message was updated.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiMessage from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_consume_time = null, # : String
+		p_create_time = null, # : String
+		p_metadata = null, # : Dictionary
+		p_read_time = null, # : String
+		p_schedule_id = null, # : String
+		p_send_time = null, # : String
+		p_text = null, # : String
+		p_update_time = null, # : String
+	) -> ApiMessage:
+		var obj := ApiMessage.new()
+		if p_consume_time != null:
+			obj.consume_time = str(p_consume_time) if p_consume_time is int else p_consume_time
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_metadata != null:
+			obj.metadata = p_metadata
+		if p_read_time != null:
+			obj.read_time = str(p_read_time) if p_read_time is int else p_read_time
+		if p_schedule_id != null:
+			obj.schedule_id = str(p_schedule_id) if p_schedule_id is int else p_schedule_id
+		if p_send_time != null:
+			obj.send_time = str(p_send_time) if p_send_time is int else p_send_time
+		if p_text != null:
+			obj.text = str(p_text) if p_text is int else p_text
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiMessage:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiMessage", p_dict), ApiMessage) as ApiMessage
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiMessage from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiMessage:
+		var obj := ApiMessage.new()
+		var v : Variant
+		v = p_dict.get("consume_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._consume_time = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("metadata")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._metadata = map
+		v = p_dict.get("read_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._read_time = v
+		v = p_dict.get("schedule_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._schedule_id = v
+		v = p_dict.get("send_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._send_time = v
+		v = p_dict.get("text")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._text = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _consume_time != null:
+			out["consume_time"] = _consume_time
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _metadata is Dictionary:
+			var map := {}
+			for k in _metadata:
+				if _metadata[k] is String:
+					map[k] = _metadata[k]
+			out["metadata"] = map
+		if _read_time != null:
+			out["read_time"] = _read_time
+		if _schedule_id != null:
+			out["schedule_id"] = _schedule_id
+		if _send_time != null:
+			out["send_time"] = _send_time
+		if _text != null:
+			out["text"] = _text
+		if _update_time != null:
+			out["update_time"] = _update_time
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiMessage, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiMessage:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiMessage = p_other
+		if typeof(_consume_time) != typeof(other._consume_time):
+			return false
+		if _consume_time != other._consume_time:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_read_time) != typeof(other._read_time):
+			return false
+		if _read_time != other._read_time:
+			return false
+		if typeof(_schedule_id) != typeof(other._schedule_id):
+			return false
+		if _schedule_id != other._schedule_id:
+			return false
+		if typeof(_send_time) != typeof(other._send_time):
+			return false
+		if _send_time != other._send_time:
+			return false
+		if typeof(_text) != typeof(other._text):
+			return false
+		if _text != other._text:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiMessage which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiMessage:
+		var obj := ApiMessage.new(_ex)
+		obj._consume_time = _consume_time
+		obj._create_time = _create_time
+		if _metadata is Dictionary:
+			var map : Dictionary = _metadata
+			obj._metadata = map.duplicate(true)
+		obj._read_time = _read_time
+		obj._schedule_id = _schedule_id
+		obj._send_time = _send_time
+		obj._text = _text
+		obj._update_time = _update_time
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_consume_time)
+		values.append(_create_time)
+		values.append(_hashable(_metadata))
+		values.append(_read_time)
+		values.append(_schedule_id)
+		values.append(_send_time)
+		values.append(_text)
+		values.append(_update_time)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -687,33 +2434,190 @@ class ApiProperties extends SatoriAsyncResult:
 		"custom": {"name": "_custom", "type": TYPE_DICTIONARY, "required": false, "content": TYPE_STRING},
 		"default": {"name": "_default", "type": TYPE_DICTIONARY, "required": false, "content": TYPE_STRING},
 	}
-	
+
 	var _computed
 	## Event computed properties.
 	var computed : Dictionary:
 		get:
 			return Dictionary() if not _computed is Dictionary else _computed.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for computed: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of computed: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_computed = map
+
 	var _custom
 	## Event custom properties.
 	var custom : Dictionary:
 		get:
 			return Dictionary() if not _custom is Dictionary else _custom.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for custom: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of custom: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_custom = map
+
 	var _default
 	## Event default properties.
 	var default : Dictionary:
 		get:
 			return Dictionary() if not _default is Dictionary else _default.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for default: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of default: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_default = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiProperties from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_computed = null, # : Dictionary
+		p_custom = null, # : Dictionary
+		p_default = null, # : Dictionary
+	) -> ApiProperties:
+		var obj := ApiProperties.new()
+		if p_computed != null:
+			obj.computed = p_computed
+		if p_custom != null:
+			obj.custom = p_custom
+		if p_default != null:
+			obj.default = p_default
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiProperties:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiProperties", p_dict), ApiProperties) as ApiProperties
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiProperties from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiProperties:
+		var obj := ApiProperties.new()
+		var v : Variant
+		v = p_dict.get("computed")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._computed = map
+		v = p_dict.get("custom")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._custom = map
+		v = p_dict.get("default")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._default = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _computed is Dictionary:
+			var map := {}
+			for k in _computed:
+				if _computed[k] is String:
+					map[k] = _computed[k]
+			out["computed"] = map
+		if _custom is Dictionary:
+			var map := {}
+			for k in _custom:
+				if _custom[k] is String:
+					map[k] = _custom[k]
+			out["custom"] = map
+		if _default is Dictionary:
+			var map := {}
+			for k in _default:
+				if _default[k] is String:
+					map[k] = _default[k]
+			out["default"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiProperties, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiProperties:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiProperties = p_other
+		if typeof(_computed) != typeof(other._computed):
+			return false
+		if _computed != other._computed:
+			return false
+		if typeof(_custom) != typeof(other._custom):
+			return false
+		if _custom != other._custom:
+			return false
+		if typeof(_default) != typeof(other._default):
+			return false
+		if _default != other._default:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiProperties which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiProperties:
+		var obj := ApiProperties.new(_ex)
+		if _computed is Dictionary:
+			var map : Dictionary = _computed
+			obj._computed = map.duplicate(true)
+		if _custom is Dictionary:
+			var map : Dictionary = _custom
+			obj._custom = map.duplicate(true)
+		if _default is Dictionary:
+			var map : Dictionary = _default
+			obj._default = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_computed))
+		values.append(_hashable(_custom))
+		values.append(_hashable(_default))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -746,33 +2650,146 @@ class ApiSession extends SatoriAsyncResult:
 		"refresh_token": {"name": "_refresh_token", "type": TYPE_STRING, "required": false},
 		"token": {"name": "_token", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _properties
 	## Properties associated with this identity.
 	var properties : ApiProperties:
 		get:
 			return _properties as ApiProperties
-	
+		set(p_value):
+			_properties = p_value
+
 	var _refresh_token
 	## Refresh token.
 	var refresh_token : String:
 		get:
 			return "" if not _refresh_token is String else String(_refresh_token)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refresh_token: %s" % [v])
+				return
+			v = str(v)
+			_refresh_token = v
+
 	var _token
 	## Token credential.
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiSession from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_properties = null, # : ApiProperties
+		p_refresh_token = null, # : String
+		p_token = null, # : String
+	) -> ApiSession:
+		var obj := ApiSession.new()
+		if p_properties != null:
+			obj.properties = p_properties
+		if p_refresh_token != null:
+			obj.refresh_token = str(p_refresh_token) if p_refresh_token is int else p_refresh_token
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiSession:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiSession", p_dict), ApiSession) as ApiSession
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiSession from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiSession:
+		var obj := ApiSession.new()
+		var v : Variant
+		v = p_dict.get("properties")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._properties = ApiProperties._from_dict(raw)
+		v = p_dict.get("refresh_token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refresh_token = v
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _properties is Object:
+			out["properties"] = _properties._to_dict()
+		if _refresh_token != null:
+			out["refresh_token"] = _refresh_token
+		if _token != null:
+			out["token"] = _token
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiSession, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiSession:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiSession = p_other
+		if typeof(_properties) != typeof(other._properties):
+			return false
+		if _properties != null and not _properties.equals(other._properties):
+			return false
+		if typeof(_refresh_token) != typeof(other._refresh_token):
+			return false
+		if _refresh_token != other._refresh_token:
+			return false
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiSession which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiSession:
+		var obj := ApiSession.new(_ex)
+		if _properties != null:
+			obj._properties = _properties.duplicate_deep()
+		obj._refresh_token = _refresh_token
+		obj._token = _token
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_properties.hash() if _properties != null else null)
+		values.append(_refresh_token)
+		values.append(_token)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -793,33 +2810,174 @@ class ApiUpdatePropertiesRequest extends SatoriAsyncResult:
 		"default": {"name": "_default", "type": TYPE_DICTIONARY, "required": false, "content": TYPE_STRING},
 		"recompute": {"name": "_recompute", "type": TYPE_BOOL, "required": false},
 	}
-	
+
 	var _custom
 	## Event custom properties.
 	var custom : Dictionary:
 		get:
 			return Dictionary() if not _custom is Dictionary else _custom.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for custom: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of custom: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_custom = map
+
 	var _default
 	## Event default properties.
 	var default : Dictionary:
 		get:
 			return Dictionary() if not _default is Dictionary else _default.duplicate()
-	
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for default: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of default: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_default = map
+
 	var _recompute
 	## Informs the server to recompute the audience membership of the identity.
 	var recompute : bool:
 		get:
 			return false if not _recompute is bool else bool(_recompute)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for recompute: %s" % [v])
+				return
+			_recompute = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiUpdatePropertiesRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_custom = null, # : Dictionary
+		p_default = null, # : Dictionary
+		p_recompute = null, # : bool
+	) -> ApiUpdatePropertiesRequest:
+		var obj := ApiUpdatePropertiesRequest.new()
+		if p_custom != null:
+			obj.custom = p_custom
+		if p_default != null:
+			obj.default = p_default
+		if p_recompute != null:
+			obj.recompute = p_recompute
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiUpdatePropertiesRequest:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ApiUpdatePropertiesRequest", p_dict), ApiUpdatePropertiesRequest) as ApiUpdatePropertiesRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiUpdatePropertiesRequest from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiUpdatePropertiesRequest:
+		var obj := ApiUpdatePropertiesRequest.new()
+		var v : Variant
+		v = p_dict.get("custom")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._custom = map
+		v = p_dict.get("default")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._default = map
+		v = p_dict.get("recompute")
+		if v is bool:
+			obj._recompute = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _custom is Dictionary:
+			var map := {}
+			for k in _custom:
+				if _custom[k] is String:
+					map[k] = _custom[k]
+			out["custom"] = map
+		if _default is Dictionary:
+			var map := {}
+			for k in _default:
+				if _default[k] is String:
+					map[k] = _default[k]
+			out["default"] = map
+		if _recompute != null:
+			out["recompute"] = _recompute
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiUpdatePropertiesRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiUpdatePropertiesRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiUpdatePropertiesRequest = p_other
+		if typeof(_custom) != typeof(other._custom):
+			return false
+		if _custom != other._custom:
+			return false
+		if typeof(_default) != typeof(other._default):
+			return false
+		if _default != other._default:
+			return false
+		if typeof(_recompute) != typeof(other._recompute):
+			return false
+		if _recompute != other._recompute:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiUpdatePropertiesRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiUpdatePropertiesRequest:
+		var obj := ApiUpdatePropertiesRequest.new(_ex)
+		if _custom is Dictionary:
+			var map : Dictionary = _custom
+			obj._custom = map.duplicate(true)
+		if _default is Dictionary:
+			var map : Dictionary = _default
+			obj._default = map.duplicate(true)
+		obj._recompute = _recompute
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_hashable(_custom))
+		values.append(_hashable(_default))
+		values.append(_recompute)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -845,20 +3003,92 @@ class ProtobufAny extends SatoriAsyncResult:
 	const _SCHEMA = {
 		"type": {"name": "_type", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _type
 	var type : String:
 		get:
 			return "" if not _type is String else String(_type)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for type: %s" % [v])
+				return
+			v = str(v)
+			_type = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ProtobufAny from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_type = null, # : String
+	) -> ProtobufAny:
+		var obj := ProtobufAny.new()
+		if p_type != null:
+			obj.type = str(p_type) if p_type is int else p_type
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ProtobufAny:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "ProtobufAny", p_dict), ProtobufAny) as ProtobufAny
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ProtobufAny from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ProtobufAny:
+		var obj := ProtobufAny.new()
+		var v : Variant
+		v = p_dict.get("type")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._type = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _type != null:
+			out["type"] = _type
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ProtobufAny, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ProtobufAny:
+			return false
+		if p_other == self:
+			return true
+		var other : ProtobufAny = p_other
+		if typeof(_type) != typeof(other._type):
+			return false
+		if _type != other._type:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ProtobufAny which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ProtobufAny:
+		var obj := ProtobufAny.new(_ex)
+		obj._type = _type
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_type)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -876,30 +3106,163 @@ class RpcStatus extends SatoriAsyncResult:
 		"details": {"name": "_details", "type": TYPE_ARRAY, "required": false, "content": TYPE_DICTIONARY},
 		"message": {"name": "_message", "type": TYPE_STRING, "required": false},
 	}
-	
+
 	var _code
 	var code : int:
 		get:
 			return 0 if not _code is int else int(_code)
-	
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for code: %s" % [v])
+				return
+			v = int(v)
+			_code = v
+
 	var _details
 	var details : Array:
 		get:
 			return Array() if not _details is Array else Array(_details)
-	
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				var v : Variant = e
+				if not (v is Dictionary):
+					push_error("Invalid Dictionary value for an element of details: %s" % [v])
+					return
+				arr.append(v)
+			_details = arr
+
 	var _message
 	var message : String:
 		get:
 			return "" if not _message is String else String(_message)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for message: %s" % [v])
+				return
+			v = str(v)
+			_message = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new RpcStatus from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_code = null, # : int
+		p_details = null, # : Array
+		p_message = null, # : String
+	) -> RpcStatus:
+		var obj := RpcStatus.new()
+		if p_code != null:
+			obj.code = p_code
+		if p_details != null:
+			obj.details = p_details
+		if p_message != null:
+			obj.message = str(p_message) if p_message is int else p_message
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> RpcStatus:
-		return _safe_ret(SatoriSerializer.deserialize(p_ns, "RpcStatus", p_dict), RpcStatus) as RpcStatus
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return SatoriSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a RpcStatus from a decoded JSON dictionary. Same rules as SatoriSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> RpcStatus:
+		var obj := RpcStatus.new()
+		var v : Variant
+		v = p_dict.get("code")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._code = v
+		v = p_dict.get("details")
+		if v is Array:
+			var src : Array = v
+			var arr := src.duplicate()
+			obj._details = arr
+		v = p_dict.get("message")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._message = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as SatoriSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _code != null:
+			out["code"] = _code
+		if _details != null:
+			var arr := []
+			for e in _details:
+				arr.append(e)
+			out["details"] = arr
+		if _message != null:
+			out["message"] = _message
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another RpcStatus, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is RpcStatus:
+			return false
+		if p_other == self:
+			return true
+		var other : RpcStatus = p_other
+		if typeof(_code) != typeof(other._code):
+			return false
+		if _code != other._code:
+			return false
+		if typeof(_details) != typeof(other._details):
+			return false
+		if _details != other._details:
+			return false
+		if typeof(_message) != typeof(other._message):
+			return false
+		if _message != other._message:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this RpcStatus which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> RpcStatus:
+		var obj := RpcStatus.new(_ex)
+		obj._code = _code
+		if _details is Array:
+			var arr : Array = _details
+			obj._details = arr.duplicate(true)
+		else:
+			obj._details = _details
+		obj._message = _message
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_code)
+		values.append(_hashable(_details))
+		values.append(_message)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -923,6 +3286,21 @@ class ApiClient extends RefCounted:
 	var auto_refresh := true
 	var auto_refresh_time := 300
 
+	## Whether requests carry a W3C traceparent header. Its trace ID is on the returned result.
+	var trace_requests := true
+	var _trace_id := ""
+	## The trace ID shared by all requests, 32 lowercase hex digits like SatoriTrace.trace_id_of() makes of the ID of a game session. Each request gets a new one when empty.
+	var trace_id : String:
+		set(p_value):
+			if p_value and not SatoriTrace.is_valid_trace_id(p_value):
+				push_error("Invalid trace ID '%s': expected 32 lowercase hex digits, see SatoriTrace.trace_id_of()." % p_value)
+				return
+			_trace_id = p_value
+		get:
+			return _trace_id
+	## A header which also carries the trace ID, like "X-Correlation-Id", when not empty.
+	var correlation_header := ""
+
 	var auto_retry : bool:
 		set(p_value):
 			_http_adapter.auto_retry = p_value
@@ -941,6 +3319,7 @@ class ApiClient extends RefCounted:
 		get:
 			return _http_adapter.auto_retry_backoff_base
 
+	## The token of the last request sent, to pass to cancel_request(). Deprecated: when requests start in the same frame it may belong to another one, pass a SatoriCancellationToken to the request instead.
 	var last_cancel_token:
 		get:
 			return _http_adapter.get_last_token()
@@ -953,297 +3332,428 @@ class ApiClient extends RefCounted:
 		_server_key = p_server_key
 
 		
-	func _refresh_session(p_session : SatoriSession):
+	## A session refresh in flight, awaited by the requests which need it while it is pending.
+	class _RefreshFlight extends RefCounted:
+		signal completed
+		var result : ApiSession
+
+	## The session refreshes in flight, by refresh token.
+	var _refreshes : Dictionary = {}
+
+	## Refresh the session when it is about to expire. Concurrent calls for the same session share one request, and get the same result.
+	func _refresh_session(p_session : SatoriSession) -> ApiSession:
 		if auto_refresh and p_session.is_valid() and p_session.refresh_token and not p_session.is_refresh_expired() and p_session.would_expire_in(auto_refresh_time):
-			var request = ApiAuthenticateRefreshRequest.new()
-			request._token = p_session.refresh_token
-			return await authenticate_refresh_async(_server_key, "", request)
+			var key : String = p_session.refresh_token
+			if _refreshes.has(key):
+				var pending : _RefreshFlight = _refreshes[key]
+				await pending.completed
+				return pending.result
+			var flight := _RefreshFlight.new()
+			_refreshes[key] = flight
+			var request := ApiAuthenticateRefreshRequest.new()
+			request.token = p_session.refresh_token
+			flight.result = await authenticate_refresh_async(_server_key, "", request)
+			_refreshes.erase(key)
+			flight.completed.emit()
+			return flight.result
 		return null
 
-	func cancel_request(p_token):
+	func cancel_request(p_token) -> void:
 		if p_token:
 			_http_adapter.cancel_request(p_token)
 
+	## A request about to be sent, which interceptors can inspect and change.
+	class Request extends RefCounted:
+		var operation_id : String # The operation ID in the spec, like "GetAccount".
+		var trace_id : String
+		var method : String
+		var uri : String
+		var headers : Dictionary
+		var body : PackedByteArray
+
+	## Called around the requests of the client once added with add_interceptor(). Extend it and override its methods to add headers, sign or log bodies, or rewrite URIs.
+	class Interceptor extends RefCounted:
+
+		## Called before p_request is sent, in the order the interceptors were added. It can await.
+		func before_request(_p_request : Request) -> void:
+			pass
+
+		## Called with the decoded response or the exception of p_request, in the reverse order. Returns the result passed on.
+		func after_response(_p_request : Request, p_result : Variant) -> Variant:
+			return p_result
+
+	var _interceptors : Array = []
+
+	func add_interceptor(p_interceptor : Interceptor) -> void:
+		if not _interceptors.has(p_interceptor):
+			_interceptors.append(p_interceptor)
+
+	func remove_interceptor(p_interceptor : Interceptor) -> void:
+		_interceptors.erase(p_interceptor)
+
+	## The trace ID of a new request, empty when requests are not traced.
+	func _request_trace_id() -> String:
+		if not trace_requests:
+			return ""
+		return _trace_id if _trace_id else SatoriTrace.new_trace_id()
+
+	func _send_async(p_operation_id : String, p_trace_id : String, p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+			p_cancel_token : SatoriCancellationToken = null, p_retryable : bool = true, p_timeout : int = 0) -> Variant:
+		if p_trace_id:
+			p_headers["traceparent"] = SatoriTrace.traceparent(p_trace_id, SatoriTrace.new_span_id())
+			if correlation_header:
+				p_headers[correlation_header] = p_trace_id
+		var result : Variant = await _intercept_async(p_operation_id, p_trace_id, p_method, p_uri, p_headers, p_body, p_cancel_token, p_retryable, p_timeout)
+		if result is SatoriException:
+			var exception : SatoriException = result
+			exception._trace_id = p_trace_id
+		return result
+
+	func _intercept_async(p_operation_id : String, p_trace_id : String, p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+			p_cancel_token : SatoriCancellationToken, p_retryable : bool, p_timeout : int) -> Variant:
+		if _interceptors.is_empty():
+			return await _http_adapter.send_async(p_method, p_uri, p_headers, p_body, p_retryable, p_timeout, p_cancel_token)
+		var request := Request.new()
+		request.operation_id = p_operation_id
+		request.trace_id = p_trace_id
+		request.method = p_method
+		request.uri = p_uri
+		request.headers = p_headers
+		request.body = p_body
+		# Interceptors added or removed while the request is pending apply to the next one.
+		var chain := _interceptors.duplicate()
+		for interceptor in chain:
+			await interceptor.before_request(request)
+		var result : Variant = await _http_adapter.send_async(request.method, request.uri, request.headers, request.body, p_retryable, p_timeout, p_cancel_token)
+		chain.reverse()
+		for interceptor in chain:
+			result = await interceptor.after_response(request, result)
+		return result
+
 	## A healthcheck which load balancers can use to check the service.
 	func healthcheck_async(
 		p_session : SatoriSession
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/healthcheck"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Healthcheck", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## A readycheck which load balancers can use to check the service.
 	func readycheck_async(
 		p_session : SatoriSession
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/readycheck"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Readycheck", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Authenticate against the server.
 	func authenticate_async(
 		p_basic_auth_username : String
 		, p_basic_auth_password : String
 		, p_body : ApiAuthenticateRequest
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v1/authenticate"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Authenticate", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiSession.new(result)
-		var out : ApiSession = SatoriSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
 	func authenticate_logout_async(
 		p_session : SatoriSession
 		, p_body : ApiAuthenticateLogoutRequest
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/authenticate/logout"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateLogout", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Refresh a user's session using a refresh token retrieved from a previous authentication request.
 	func authenticate_refresh_async(
 		p_basic_auth_username : String
 		, p_basic_auth_password : String
 		, p_body : ApiAuthenticateRefreshRequest
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v1/authenticate/refresh"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateRefresh", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiSession.new(result)
-		var out : ApiSession = SatoriSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Publish an event for this session.
 	func event_async(
 		p_session : SatoriSession
 		, p_body : ApiEventRequest
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/event"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Event", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Get or list all available experiments for this identity.
 	func get_experiments_async(
 		p_session : SatoriSession
 		, p_names = null # : array
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiExperimentList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiExperimentList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/experiment"
-		var query_params = ""
+		var query_params := ""
 		if p_names != null:
 			for elem in p_names:
 				query_params += "names=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetExperiments", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiExperimentList.new(result)
-		var out : ApiExperimentList = SatoriSerializer.deserialize(_namespace, "ApiExperimentList", result)
+		var body : Dictionary = result
+		var out : ApiExperimentList = ApiExperimentList._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## List all available flags for this identity.
 	func get_flags_async(
 		p_bearer_token : String
 		, p_names = null # : array
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiFlagList:
 		var urlpath : String = "/v1/flag"
-		var query_params = ""
+		var query_params := ""
 		if p_names != null:
 			for elem in p_names:
 				query_params += "names=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
 		if (p_bearer_token):
-			var header = "Bearer %s" % p_bearer_token
+			var header := "Bearer %s" % p_bearer_token
 			headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetFlags", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiFlagList.new(result)
-		var out : ApiFlagList = SatoriSerializer.deserialize(_namespace, "ApiFlagList", result)
+		var body : Dictionary = result
+		var out : ApiFlagList = ApiFlagList._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Enrich/replace the current session with new identifier.
 	func identify_async(
 		p_session : SatoriSession
 		, p_body : ApiIdentifyRequest
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiSession:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiSession.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/identify"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Identify", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiSession.new(result)
-		var out : ApiSession = SatoriSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Delete the caller's identity and associated data.
 	func delete_identity_async(
 		p_session : SatoriSession
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/identity"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteIdentity", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List available live events.
 	func get_live_events_async(
 		p_session : SatoriSession
 		, p_names = null # : array
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiLiveEventList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiLiveEventList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/live-event"
-		var query_params = ""
+		var query_params := ""
 		if p_names != null:
 			for elem in p_names:
 				query_params += "names=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetLiveEvents", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiLiveEventList.new(result)
-		var out : ApiLiveEventList = SatoriSerializer.deserialize(_namespace, "ApiLiveEventList", result)
+		var body : Dictionary = result
+		var out : ApiLiveEventList = ApiLiveEventList._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Get the list of messages for the identity.
@@ -1252,133 +3762,155 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_forward = null # : boolean
 		, p_cursor = null # : string
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiGetMessageListResponse:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiGetMessageListResponse.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/message"
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_forward != null:
 			query_params += "forward=%s&" % str(bool(p_forward)).to_lower()
 		if p_cursor != null:
-			query_params += "cursor=%s&" % SatoriSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % SatoriSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetMessageList", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiGetMessageListResponse.new(result)
-		var out : ApiGetMessageListResponse = SatoriSerializer.deserialize(_namespace, "ApiGetMessageListResponse", result)
+		var body : Dictionary = result
+		var out : ApiGetMessageListResponse = ApiGetMessageListResponse._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Deletes a message for an identity.
 	func delete_message_async(
 		p_session : SatoriSession
 		, p_id : String
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
-		var urlpath : String = "/v1/message/%s" % SatoriSerializer.escape_http(p_id)
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var urlpath : String = "/v1/message/{id}"
+		urlpath = urlpath.replace("{id}", SatoriSerializer.escape_http(p_id))
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteMessage", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Updates a message for an identity.
 	func update_message_async(
 		p_session : SatoriSession
 		, p_id : String
 		, p_body : 
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
-		var urlpath : String = "/v1/message/%s" % SatoriSerializer.escape_http(p_id)
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var urlpath : String = "/v1/message/{id}"
+		urlpath = urlpath.replace("{id}", SatoriSerializer.escape_http(p_id))
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UpdateMessage", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List properties associated with this identity.
 	func list_properties_async(
 		p_session : SatoriSession
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> ApiProperties:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiProperties.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/properties"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListProperties", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return ApiProperties.new(result)
-		var out : ApiProperties = SatoriSerializer.deserialize(_namespace, "ApiProperties", result)
+		var body : Dictionary = result
+		var out : ApiProperties = ApiProperties._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Update identity properties.
 	func update_properties_async(
 		p_session : SatoriSession
 		, p_body : ApiUpdatePropertiesRequest
+		, p_cancel_token : SatoriCancellationToken = null
 	) -> SatoriAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return SatoriAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v1/properties"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
 		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UpdateProperties", trace, method, uri, headers, content, p_cancel_token)
 		if result is SatoriException:
 			return SatoriAsyncResult.new(result)
-		return SatoriAsyncResult.new()
+		var out := SatoriAsyncResult.new()
+		out._trace_id = trace
+		return out
@@ -1,6 +1,7 @@
 extends RefCounted
 
 ## A client for the API in Satori Server.
+## The requests take an optional last p_cancel_token, a SatoriCancellationToken which cancels them.
 class_name SatoriClient
 
 #region Properties
@@ -37,12 +38,36 @@ var auto_refresh : bool = false:
 	get:
 		return get_auto_refresh()
 
+## The trace ID sent in the W3C traceparent header of all requests, 32 lowercase hex digits like SatoriTrace.trace_id_of() makes of the ID of a game session. Each request gets a new one when empty.
+var trace_id : String:
+	set(v):
+		_api_client.trace_id = v
+	get:
+		return _api_client.trace_id
+
+## A header which also carries the trace ID of each request, like "X-Correlation-Id", when not empty.
+var correlation_header : String:
+	set(v):
+		_api_client.correlation_header = v
+	get:
+		return _api_client.correlation_header
+
 func get_auto_refresh() -> bool:
 	return _api_client.auto_refresh
 
 func set_auto_refresh(p_value) -> void:
 	_api_client.auto_refresh = p_value
 
+## Add an interceptor called around every request of the client, to add headers, sign or log bodies, or rewrite URIs. [br]
+## p_interceptor - An object extending SatoriAPI.ApiClient.Interceptor.
+func add_interceptor(p_interceptor : SatoriAPI.ApiClient.Interceptor) -> void:
+	_api_client.add_interceptor(p_interceptor)
+
+## Remove an interceptor added with add_interceptor(). [br]
+## p_interceptor - The interceptor to remove.
+func remove_interceptor(p_interceptor : SatoriAPI.ApiClient.Interceptor) -> void:
+	_api_client.remove_interceptor(p_interceptor)
+
 #endregion
 
 #region Initialization
@@ -71,22 +96,22 @@ func _init(p_adapter : SatoriHTTPAdapter,
 ## If not set, properties are left as they are on the server. [br]
 ## p_custom_properties: Optional custom properties to update with this call. [br]
 ## If not set, properties are left as they are on the server.
-func authenticate_async(p_id: String, p_default_properties: Dictionary = {}, p_custom_properties: Dictionary = {}) -> SatoriSession:
+func authenticate_async(p_id: String, p_default_properties: Dictionary = {}, p_custom_properties: Dictionary = {}, p_cancel_token : SatoriCancellationToken = null) -> SatoriSession:
 	return _parse_session(await _api_client.authenticate_async(api_key, "",
 		SatoriAPI.ApiAuthenticateRequest.create(SatoriAPI, {
 			"id": p_id,
 			"default": p_default_properties,
 			"custom": p_custom_properties
-		})))
+		}), p_cancel_token))
 
 ## Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user. [br]
 ## p_session: The session of the user.
-func authenticate_logout_async(p_session: SatoriSession) -> SatoriAsyncResult:
+func authenticate_logout_async(p_session: SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
 	return await _api_client.authenticate_logout_async(p_session,
 		SatoriAPI.ApiAuthenticateLogoutRequest.create(SatoriAPI, {
 			"refresh_token": p_session.refresh_token,
 			"token": p_session.token
-		}))
+		}), p_cancel_token)
 
 ## Parses the Satori API session and returns a SatoriSession object.
 func _parse_session(p_session: SatoriAPI.ApiSession) -> SatoriSession:
@@ -97,25 +122,25 @@ func _parse_session(p_session: SatoriAPI.ApiSession) -> SatoriSession:
 
 ## Refresh a user's session using a refresh token retrieved from a previous authentication request. [br]
 ## p_session: The session of the user.
-func session_refresh_async(p_session : SatoriSession) -> SatoriSession:
+func session_refresh_async(p_session : SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> SatoriSession:
 	return _parse_session(await _api_client.authenticate_refresh_async(api_key, "",
 		SatoriAPI.ApiAuthenticateRefreshRequest.create(SatoriAPI, {
 			"refresh_token": p_session.refresh_token,
-		})
+		}), p_cancel_token
 	))
 
 ## Send an event for this session. [br]
 ## p_session: The session of the user. [br]
 ## p_event: The event which will be sent.
-func event_async(p_session: SatoriSession, p_event: Event) -> SatoriAsyncResult:
+func event_async(p_session: SatoriSession, p_event: Event, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
 	return await events_async(p_session, [
 		p_event
-	])
+	], p_cancel_token)
 
 ## Send a batch of events for this session. [br]
 ## p_session: The session of the user. [br]
 ## p_events: The batch of events which will be sent.
-func events_async(p_session: SatoriSession, p_events: Array) -> SatoriAsyncResult:
+func events_async(p_session: SatoriSession, p_events: Array, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
 	var p_dict = {
 		"events": p_events.map(func(e):
 			return e.to_api_event_dict())
@@ -123,18 +148,18 @@ func events_async(p_session: SatoriSession, p_events: Array) -> SatoriAsyncResul
 	
 	var req = SatoriAPI.ApiEventRequest.create(SatoriAPI, p_dict)
 	return await _api_client.event_async(p_session,
-		req)
+		req, p_cancel_token)
 
 ## Get all experiments data. [br]
 ## p_session: The session of the user.
-func get_all_experiments_async(p_session: SatoriSession) -> SatoriAsyncResult:
-	return await _api_client.get_experiments_async(p_session)
+func get_all_experiments_async(p_session: SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
+	return await _api_client.get_experiments_async(p_session, null, p_cancel_token)
 
 ## Get specific experiments data. [br]
 ## p_session: The session of the user. [br]
 ## p_names: Experiment names.
-func get_experiments_async(p_session: SatoriSession, p_names: Array) -> SatoriAPI.ApiExperimentList:
-	return await _api_client.get_experiments_async(p_session, p_names)
+func get_experiments_async(p_session: SatoriSession, p_names: Array, p_cancel_token : SatoriCancellationToken = null) -> SatoriAPI.ApiExperimentList:
+	return await _api_client.get_experiments_async(p_session, p_names, p_cancel_token)
 
 ## Get a single flag for this identity. [br]
 ## This method will return the default value [br]
@@ -142,9 +167,9 @@ func get_experiments_async(p_session: SatoriSession, p_names: Array) -> SatoriAP
 ## p_session: The session of the user. [br]
 ## p_name: The name of the flag. [br]
 ## p_default: The default value if the server is unreachable.
-func get_flag_async(p_session: SatoriSession, p_name: String, p_default: String = "") -> SatoriAPI.ApiFlag:
+func get_flag_async(p_session: SatoriSession, p_name: String, p_default: String = "", p_cancel_token : SatoriCancellationToken = null) -> SatoriAPI.ApiFlag:
 	var p_names = [p_name]
-	var flags = await get_flags_async(p_session, p_names)
+	var flags = await get_flags_async(p_session, p_names, p_cancel_token)
 
 	if flags.is_exception():
 		return SatoriAPI.ApiFlag.create(SatoriAPI, {
@@ -161,14 +186,14 @@ func get_flag_async(p_session: SatoriSession, p_name: String, p_default: String
 ## List all available flags for this identity. [br]
 ## p_session: The session of the user. [br]
 ## p_names: Flag names, if empty all flags will be returned. [br]
-func get_flags_async(p_session: SatoriSession, p_names: Array) -> SatoriAPI.ApiFlagList:
-	return await _api_client.get_flags_async(p_session.token, p_names)
+func get_flags_async(p_session: SatoriSession, p_names: Array, p_cancel_token : SatoriCancellationToken = null) -> SatoriAPI.ApiFlagList:
+	return await _api_client.get_flags_async(p_session.token, p_names, p_cancel_token)
 
 ## List available live events. [br]
 ## p_session: The session of the user. [br]
 ## p_names: Live event names, if null or empty all live events are returned.
-func get_live_events_async(p_session: SatoriSession, p_names: Array = []) -> SatoriAPI.ApiLiveEventList:
-	return await _api_client.get_live_events_async(p_session, p_names)
+func get_live_events_async(p_session: SatoriSession, p_names: Array = [], p_cancel_token : SatoriCancellationToken = null) -> SatoriAPI.ApiLiveEventList:
+	return await _api_client.get_live_events_async(p_session, p_names, p_cancel_token)
 
 ## Identify a session with a new ID. [br]
 ## p_session: The session of the user. [br]
@@ -178,35 +203,35 @@ func get_live_events_async(p_session: SatoriSession, p_names: Array = []) -> Sat
 ## Must be an alphanumeric string with only underscores and hyphens allowed. [br]
 ## p_default_properties: The default properties. [br]
 ## p_custom_properties: The custom event properties.
-func identify_async(p_session: SatoriSession, p_id: String, p_default_properties: Dictionary = {}, p_custom_properties: Dictionary = {}) -> SatoriSession:
+func identify_async(p_session: SatoriSession, p_id: String, p_default_properties: Dictionary = {}, p_custom_properties: Dictionary = {}, p_cancel_token : SatoriCancellationToken = null) -> SatoriSession:
 	var req = SatoriAPI.ApiIdentifyRequest.create(SatoriAPI, {
 		"id": p_id,
 		"default": p_default_properties,
 		"custom": p_custom_properties
 	})
-	return _parse_session(await _api_client.identify_async(p_session, req))
+	return _parse_session(await _api_client.identify_async(p_session, req, p_cancel_token))
 
 ## List properties associated with this identity. [br]
 ## p_session: The session of the user.
-func list_properties_async(p_session: SatoriSession) -> SatoriAsyncResult:
-	return await _api_client.list_properties_async(p_session)
+func list_properties_async(p_session: SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
+	return await _api_client.list_properties_async(p_session, p_cancel_token)
 
 ## Update properties associated with this identity. [br]
 ## p_session: The session of the user. [br]
 ## p_default_properties: The default properties to update. [br]
 ## p_custom_properties: The custom properties to update. [br]
 ## p_recompute: Whether or not to recompute the user's audience membership immediately after property update.
-func update_properties_async(p_session: SatoriSession, p_default_properties: Dictionary, p_custom_properties: Dictionary, p_recompute: bool = false) -> SatoriAsyncResult:
+func update_properties_async(p_session: SatoriSession, p_default_properties: Dictionary, p_custom_properties: Dictionary, p_recompute: bool = false, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
 	var req = SatoriAPI.ApiUpdatePropertiesRequest.create(SatoriAPI, {
 		"default": p_default_properties,
 		"custom": p_custom_properties,
 		"recompute": p_recompute
 	})
-	return await _api_client.update_properties_async(p_session, req)
+	return await _api_client.update_properties_async(p_session, req, p_cancel_token)
 
 ## Delete the caller's identity and associated data. [br]
 ## p_session: The session of the user.
-func delete_identity_async(p_session: SatoriSession) -> SatoriAsyncResult:
-	return await _api_client.delete_identity_async(p_session)
+func delete_identity_async(p_session: SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
+	return await _api_client.delete_identity_async(p_session, p_cancel_token)
 
 #endregion
@@ -33,6 +33,7 @@ class AsyncRequest:
 	var retry_count := 3
 	var backoff_time := 10
 	var logger : SatoriLogger
+	var trace_id := ""
 
 	var cancelled = false
 	var result : int = HTTPRequest.RESULT_NO_RESPONSE
@@ -130,8 +131,8 @@ class AsyncRequest:
 				error = str(parsed)
 			if typeof(error) == TYPE_DICTIONARY:
 				error = JSON.stringify(error)
-			logger.debug("Request %d returned response code: %d, RPC code: %d, error: %s" % [
-				id, response_code, code, error
+			logger.debug("Request %d returned response code: %d, RPC code: %d, error: %s, trace: %s" % [
+				id, response_code, code, error, trace_id
 			])
 			return SatoriException.new(error, response_code, code)
 
@@ -143,11 +144,16 @@ class AsyncRequest:
 ## uri - The fully qualified URI to use. [br]
 ## headers - Request headers to set. [br]
 ## body - Request content body to set. [br]
-## timeoutSec - Request timeout. [br]
+## retryable - Whether the request is safe to send again when it fails, retried only if auto_retry is also true. [br]
+## timeout - Request timeout in seconds, or 0 to use the adapter timeout. [br]
+## cancel_token - A token cancelling this request when it is cancelled. [br]
 ## Returns a task which resolves to the contents of the response.
-func send_async(p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray):
+func send_async(p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+		p_retryable : bool = true, p_timeout : int = 0, p_cancel_token : SatoriCancellationToken = null):
+	if p_cancel_token != null and p_cancel_token.cancelled:
+		return SatoriException.new("Request cancelled", -1, -1, true)
 	var req = HTTPRequest.new()
-	req.timeout = timeout
+	req.timeout = p_timeout if p_timeout > 0 else timeout
 	if use_threads and OS.get_name() != 'Web':
 		req.use_threads = true # Threads not available nor needed on the web.
 
@@ -169,17 +175,27 @@ func send_async(p_method : String, p_uri : String, p_headers : Dictionary, p_bod
 		headers.append("%s: %s" % [k, p_headers[k]])
 
 	id += 1
-	var retry = auto_retry_count if auto_retry else 0
+	var retry = auto_retry_count if auto_retry and p_retryable else 0
 	var backoff = auto_retry_backoff_base
 	_pending[id] = AsyncRequest.new(id, req, p_uri, method, headers, p_body, retry, backoff, logger)
+	# The trace ID of the W3C traceparent header, "00-<trace id>-<span id>-<flags>".
+	var traceparent : PackedStringArray = str(p_headers.get("traceparent", "")).split("-")
+	if traceparent.size() == 4:
+		_pending[id].trace_id = traceparent[1]
 
-	logger.debug("Sending request [ID: %d, Method: %s, Uri: %s, Headers: %s, Body: %s, Timeout: %d, Retries: %d, Backoff base: %d ms]" % [
-		id, p_method, p_uri, p_headers, p_body.get_string_from_utf8(), timeout, retry, backoff
+	logger.debug("Sending request [ID: %d, Trace: %s, Method: %s, Uri: %s, Headers: %s, Body: %s, Timeout: %d, Retries: %d, Backoff base: %d ms]" % [
+		id, _pending[id].trace_id, p_method, p_uri, p_headers, p_body.get_string_from_utf8(), req.timeout, retry, backoff
 	])
 
 	add_child(req)
 
-	return await _send_async(id, _pending)
+	if p_cancel_token == null:
+		return await _send_async(id, _pending)
+	var cancel := cancel_request.bind(id)
+	p_cancel_token.cancellation_requested.connect(cancel)
+	var result = await _send_async(id, _pending)
+	p_cancel_token.cancellation_requested.disconnect(cancel)
+	return result
 
 func get_last_token() -> int:
 	return id
@@ -200,8 +216,8 @@ static func _send_async(p_id : int, p_pending : Dictionary):
 	await req.make_request()
 
 	while req.result != HTTPRequest.RESULT_SUCCESS:
-		req.logger.debug("Request %d failed with result: %d, response code: %d" % [
-			p_id, req.result, req.response_code
+		req.logger.debug("Request %d failed with result: %d, response code: %d, trace: %s" % [
+			p_id, req.result, req.response_code, req.trace_id
 		])
 		if not req.should_retry():
 			break
@@ -7,7 +7,17 @@ var exception : SatoriException:
 	get:
 		return get_exception()
 
+## The trace ID of the request, sent to the server in its traceparent header. Empty when the request was not traced.
+var trace_id : String:
+	set(v):
+		pass
+	get:
+		if _trace_id == "" and is_exception():
+			return get_exception().trace_id
+		return _trace_id
+
 var _ex = null
+var _trace_id : String = ""
 
 func _init(p_ex = null):
 	_ex = p_ex
@@ -32,3 +42,19 @@ static func _safe_ret(p_obj, p_type : GDScript):
 	elif p_obj is SatoriException:
 		return p_type.new(p_obj)
 	return p_type.new(SatoriException.new())
+
+## A value which hashes the same for dictionaries which are equal, whatever the order of their keys.
+static func _hashable(p_value):
+	if p_value is Dictionary:
+		var keys = p_value.keys()
+		keys.sort()
+		var pairs = []
+		for k in keys:
+			pairs.append([k, _hashable(p_value[k])])
+		return pairs
+	if p_value is Array:
+		var values = []
+		for e in p_value:
+			values.append(_hashable(e))
+		return values
+	return p_value
@@ -0,0 +1,24 @@
+extends RefCounted
+
+## A token to cancel requests. Create it before the requests, pass it as their p_cancel_token, and call cancel()
+## to cancel exactly these requests, like the ones started by a screen which is being closed. [br]
+## Requests started with a cancelled token are cancelled right away.
+class_name SatoriCancellationToken
+
+## Emitted once, when the token is cancelled.
+signal cancellation_requested
+
+var _cancelled : bool = false
+## If cancel() was called.
+var cancelled : bool:
+	set(v):
+		pass
+	get:
+		return _cancelled
+
+## Cancel the pending and future requests using this token.
+func cancel() -> void:
+	if _cancelled:
+		return
+	_cancelled = true
+	cancellation_requested.emit()
@@ -25,6 +25,14 @@ var message : String:
 	get:
 		return _message
 
+var _trace_id : String = ""
+## The trace ID of the request which failed, also sent to the server in its traceparent header.
+var trace_id : String:
+	set(v):
+		pass
+	get:
+		return _trace_id
+
 var _cancelled : bool = false
 var cancelled : bool:
 	set(v):
@@ -39,4 +47,6 @@ func _init(p_message : String = "", p_status_code : int = -1, p_grpc_status_code
 	_cancelled = p_cancelled
 
 func _to_string() -> String:
+	if _trace_id:
+		return "SatoriException(StatusCode={%s}, Message='{%s}', GrpcStatusCode={%s}, TraceId='{%s}')" % [_status_code, _message, _grpc_status_code, _trace_id]
 	return "SatoriException(StatusCode={%s}, Message='{%s}', GrpcStatusCode={%s})" % [_status_code, _message, _grpc_status_code]
@@ -0,0 +1,29 @@
+extends RefCounted
+
+## Helpers for W3C trace context, to correlate client requests with server logs.
+class_name SatoriTrace
+
+## Returns a new random trace ID, 32 lowercase hex digits.
+static func new_trace_id() -> String:
+	return Crypto.new().generate_random_bytes(16).hex_encode()
+
+## Returns a new random span ID, 16 lowercase hex digits.
+static func new_span_id() -> String:
+	return Crypto.new().generate_random_bytes(8).hex_encode()
+
+## Returns the value of a traceparent header for a sampled span of the trace.
+static func traceparent(p_trace_id : String, p_span_id : String) -> String:
+	return "00-%s-%s-01" % [p_trace_id, p_span_id]
+
+## Returns whether p_trace_id is a valid W3C trace ID: 32 lowercase hex digits, not all zeros.
+static func is_valid_trace_id(p_trace_id : String) -> bool:
+	if p_trace_id.length() != 32 or p_trace_id == "0".repeat(32):
+		return false
+	for c in p_trace_id:
+		if not "0123456789abcdef".contains(c):
+			return false
+	return true
+
+## Returns a trace ID made from any ID, like the ID of a game session: the ID itself when it is a valid trace ID, or its MD5 hash.
+static func trace_id_of(p_id : String) -> String:
+	return p_id if is_valid_trace_id(p_id) else p_id.md5_text()
@@ -16,28 +16,126 @@ class GroupUserListGroupUser extends NakamaAsyncResult:
 	var state : int:
 		get:
 			return 0 if not _state is int else int(_state)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for state: %s" % [v])
+				return
+			v = int(v)
+			_state = v
 
 	var _user
 	## User.
 	var user : ApiUser:
 		get:
 			return _user as ApiUser
+		set(p_value):
+			_user = p_value
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new GroupUserListGroupUser from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_state = null, # : int
+		p_user = null, # : ApiUser
+	) -> GroupUserListGroupUser:
+		var obj := GroupUserListGroupUser.new()
+		if p_state != null:
+			obj.state = p_state
+		if p_user != null:
+			obj.user = p_user
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> GroupUserListGroupUser:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "GroupUserListGroupUser", p_dict), GroupUserListGroupUser) as GroupUserListGroupUser
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a GroupUserListGroupUser from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> GroupUserListGroupUser:
+		var obj := GroupUserListGroupUser.new()
+		var v : Variant
+		v = p_dict.get("state")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._state = v
+		v = p_dict.get("user")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._user = ApiUser._from_dict(raw)
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _state != null:
+			out["state"] = _state
+		if _user is Object:
+			out["user"] = _user._to_dict()
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another GroupUserListGroupUser, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is GroupUserListGroupUser:
+			return false
+		if p_other == self:
+			return true
+		var other : GroupUserListGroupUser = p_other
+		if typeof(_state) != typeof(other._state):
+			return false
+		if _state != other._state:
+			return false
+		if typeof(_user) != typeof(other._user):
+			return false
+		if _user != null and not _user.equals(other._user):
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this GroupUserListGroupUser which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> GroupUserListGroupUser:
+		var obj := GroupUserListGroupUser.new(_ex)
+		obj._state = _state
+		if _user != null:
+			obj._user = _user.duplicate_deep()
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_state)
+		values.append(_user.hash() if _user != null else null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "state: %s, " % _state
 		output += "user: %s, " % _user
+		output += map_string
 		return output
 
 ## A single group-role pair.
@@ -53,28 +151,126 @@ class UserGroupListUserGroup extends NakamaAsyncResult:
 	var group : ApiGroup:
 		get:
 			return _group as ApiGroup
+		set(p_value):
+			_group = p_value
 
 	var _state
 	## The user's relationship to the group.
 	var state : int:
 		get:
 			return 0 if not _state is int else int(_state)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for state: %s" % [v])
+				return
+			v = int(v)
+			_state = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new UserGroupListUserGroup from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_group = null, # : ApiGroup
+		p_state = null, # : int
+	) -> UserGroupListUserGroup:
+		var obj := UserGroupListUserGroup.new()
+		if p_group != null:
+			obj.group = p_group
+		if p_state != null:
+			obj.state = p_state
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> UserGroupListUserGroup:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "UserGroupListUserGroup", p_dict), UserGroupListUserGroup) as UserGroupListUserGroup
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a UserGroupListUserGroup from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> UserGroupListUserGroup:
+		var obj := UserGroupListUserGroup.new()
+		var v : Variant
+		v = p_dict.get("group")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._group = ApiGroup._from_dict(raw)
+		v = p_dict.get("state")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._state = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _group is Object:
+			out["group"] = _group._to_dict()
+		if _state != null:
+			out["state"] = _state
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another UserGroupListUserGroup, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is UserGroupListUserGroup:
+			return false
+		if p_other == self:
+			return true
+		var other : UserGroupListUserGroup = p_other
+		if typeof(_group) != typeof(other._group):
+			return false
+		if _group != null and not _group.equals(other._group):
+			return false
+		if typeof(_state) != typeof(other._state):
+			return false
+		if _state != other._state:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this UserGroupListUserGroup which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> UserGroupListUserGroup:
+		var obj := UserGroupListUserGroup.new(_ex)
+		if _group != null:
+			obj._group = _group.duplicate_deep()
+		obj._state = _state
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_group.hash() if _group != null else null)
+		values.append(_state)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "group: %s, " % _group
 		output += "state: %s, " % _state
+		output += map_string
 		return output
 
 ## Record values to write.
@@ -92,42 +288,187 @@ class WriteLeaderboardRecordRequestLeaderboardRecordWrite extends NakamaAsyncRes
 	var metadata : String:
 		get:
 			return "" if not _metadata is String else String(_metadata)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for metadata: %s" % [v])
+				return
+			v = str(v)
+			_metadata = v
 
 	var _operator
 	## Operator override.
 	var operator : int:
 		get:
 			return ApiOperator.values()[0] if not ApiOperator.values().has(_operator) else _operator
+		set(p_value):
+			if not ApiOperator.values().has(p_value):
+				push_error("Invalid ApiOperator value for operator: %s" % p_value)
+				return
+			_operator = p_value
 
 	var _score
 	## The score value to submit.
 	var score : String:
 		get:
 			return "" if not _score is String else String(_score)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for score: %s" % [v])
+				return
+			v = str(v)
+			_score = v
 
 	var _subscore
 	## An optional secondary value.
 	var subscore : String:
 		get:
 			return "" if not _subscore is String else String(_subscore)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for subscore: %s" % [v])
+				return
+			v = str(v)
+			_subscore = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new WriteLeaderboardRecordRequestLeaderboardRecordWrite from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_metadata = null, # : String
+		p_operator = null, # : int
+		p_score = null, # : String
+		p_subscore = null, # : String
+	) -> WriteLeaderboardRecordRequestLeaderboardRecordWrite:
+		var obj := WriteLeaderboardRecordRequestLeaderboardRecordWrite.new()
+		if p_metadata != null:
+			obj.metadata = str(p_metadata) if p_metadata is int else p_metadata
+		if p_operator != null:
+			obj.operator = p_operator
+		if p_score != null:
+			obj.score = str(p_score) if p_score is int else p_score
+		if p_subscore != null:
+			obj.subscore = str(p_subscore) if p_subscore is int else p_subscore
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> WriteLeaderboardRecordRequestLeaderboardRecordWrite:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "WriteLeaderboardRecordRequestLeaderboardRecordWrite", p_dict), WriteLeaderboardRecordRequestLeaderboardRecordWrite) as WriteLeaderboardRecordRequestLeaderboardRecordWrite
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a WriteLeaderboardRecordRequestLeaderboardRecordWrite from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> WriteLeaderboardRecordRequestLeaderboardRecordWrite:
+		var obj := WriteLeaderboardRecordRequestLeaderboardRecordWrite.new()
+		var v : Variant
+		v = p_dict.get("metadata")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._metadata = v
+		v = p_dict.get("operator")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._operator = v
+		v = p_dict.get("score")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._score = v
+		v = p_dict.get("subscore")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._subscore = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _metadata != null:
+			out["metadata"] = _metadata
+		if _operator != null:
+			out["operator"] = _operator
+		if _score != null:
+			out["score"] = _score
+		if _subscore != null:
+			out["subscore"] = _subscore
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another WriteLeaderboardRecordRequestLeaderboardRecordWrite, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is WriteLeaderboardRecordRequestLeaderboardRecordWrite:
+			return false
+		if p_other == self:
+			return true
+		var other : WriteLeaderboardRecordRequestLeaderboardRecordWrite = p_other
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_operator) != typeof(other._operator):
+			return false
+		if _operator != other._operator:
+			return false
+		if typeof(_score) != typeof(other._score):
+			return false
+		if _score != other._score:
+			return false
+		if typeof(_subscore) != typeof(other._subscore):
+			return false
+		if _subscore != other._subscore:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this WriteLeaderboardRecordRequestLeaderboardRecordWrite which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> WriteLeaderboardRecordRequestLeaderboardRecordWrite:
+		var obj := WriteLeaderboardRecordRequestLeaderboardRecordWrite.new(_ex)
+		obj._metadata = _metadata
+		obj._operator = _operator
+		obj._score = _score
+		obj._subscore = _subscore
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_metadata)
+		values.append(_operator)
+		values.append(_score)
+		values.append(_subscore)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "metadata: %s, " % _metadata
 		output += "operator: %s, " % _operator
 		output += "score: %s, " % _score
 		output += "subscore: %s, " % _subscore
+		output += map_string
 		return output
 
 ## Record values to write.
@@ -145,42 +486,187 @@ class WriteTournamentRecordRequestTournamentRecordWrite extends NakamaAsyncResul
 	var metadata : String:
 		get:
 			return "" if not _metadata is String else String(_metadata)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for metadata: %s" % [v])
+				return
+			v = str(v)
+			_metadata = v
 
 	var _operator
 	## Operator override.
 	var operator : int:
 		get:
 			return ApiOperator.values()[0] if not ApiOperator.values().has(_operator) else _operator
+		set(p_value):
+			if not ApiOperator.values().has(p_value):
+				push_error("Invalid ApiOperator value for operator: %s" % p_value)
+				return
+			_operator = p_value
 
 	var _score
 	## The score value to submit.
 	var score : String:
 		get:
 			return "" if not _score is String else String(_score)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for score: %s" % [v])
+				return
+			v = str(v)
+			_score = v
 
 	var _subscore
 	## An optional secondary value.
 	var subscore : String:
 		get:
 			return "" if not _subscore is String else String(_subscore)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for subscore: %s" % [v])
+				return
+			v = str(v)
+			_subscore = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new WriteTournamentRecordRequestTournamentRecordWrite from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_metadata = null, # : String
+		p_operator = null, # : int
+		p_score = null, # : String
+		p_subscore = null, # : String
+	) -> WriteTournamentRecordRequestTournamentRecordWrite:
+		var obj := WriteTournamentRecordRequestTournamentRecordWrite.new()
+		if p_metadata != null:
+			obj.metadata = str(p_metadata) if p_metadata is int else p_metadata
+		if p_operator != null:
+			obj.operator = p_operator
+		if p_score != null:
+			obj.score = str(p_score) if p_score is int else p_score
+		if p_subscore != null:
+			obj.subscore = str(p_subscore) if p_subscore is int else p_subscore
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> WriteTournamentRecordRequestTournamentRecordWrite:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "WriteTournamentRecordRequestTournamentRecordWrite", p_dict), WriteTournamentRecordRequestTournamentRecordWrite) as WriteTournamentRecordRequestTournamentRecordWrite
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a WriteTournamentRecordRequestTournamentRecordWrite from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> WriteTournamentRecordRequestTournamentRecordWrite:
+		var obj := WriteTournamentRecordRequestTournamentRecordWrite.new()
+		var v : Variant
+		v = p_dict.get("metadata")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._metadata = v
+		v = p_dict.get("operator")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._operator = v
+		v = p_dict.get("score")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._score = v
+		v = p_dict.get("subscore")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._subscore = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _metadata != null:
+			out["metadata"] = _metadata
+		if _operator != null:
+			out["operator"] = _operator
+		if _score != null:
+			out["score"] = _score
+		if _subscore != null:
+			out["subscore"] = _subscore
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another WriteTournamentRecordRequestTournamentRecordWrite, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is WriteTournamentRecordRequestTournamentRecordWrite:
+			return false
+		if p_other == self:
+			return true
+		var other : WriteTournamentRecordRequestTournamentRecordWrite = p_other
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_operator) != typeof(other._operator):
+			return false
+		if _operator != other._operator:
+			return false
+		if typeof(_score) != typeof(other._score):
+			return false
+		if _score != other._score:
+			return false
+		if typeof(_subscore) != typeof(other._subscore):
+			return false
+		if _subscore != other._subscore:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this WriteTournamentRecordRequestTournamentRecordWrite which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> WriteTournamentRecordRequestTournamentRecordWrite:
+		var obj := WriteTournamentRecordRequestTournamentRecordWrite.new(_ex)
+		obj._metadata = _metadata
+		obj._operator = _operator
+		obj._score = _score
+		obj._subscore = _subscore
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_metadata)
+		values.append(_operator)
+		values.append(_score)
+		values.append(_subscore)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "metadata: %s, " % _metadata
 		output += "operator: %s, " % _operator
 		output += "score: %s, " % _score
 		output += "subscore: %s, " % _subscore
+		output += map_string
 		return output
 
 ## A user with additional account details. Always the current user.
@@ -201,76 +687,353 @@ class ApiAccount extends NakamaAsyncResult:
 	var custom_id : String:
 		get:
 			return "" if not _custom_id is String else String(_custom_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for custom_id: %s" % [v])
+				return
+			v = str(v)
+			_custom_id = v
 
 	var _devices
 	## The devices which belong to the user's account.
 	var devices : Array:
 		get:
+			_materialize_devices()
 			return Array() if not _devices is Array else Array(_devices)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiAccountDevice._from_dict(raw))
+				elif e is ApiAccountDevice:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiAccountDevice value for an element of devices: %s" % [e])
+					return
+			_devices = arr
+			_devices_lazy = false
+
+	## True while _devices still holds the raw dictionaries received from the server.
+	var _devices_lazy := false
+
+	## The number of elements in devices, without deserializing them.
+	func get_devices_count() -> int:
+		if not _devices is Array:
+			return 0
+		var arr : Array = _devices
+		return arr.size()
+
+	## Return element p_index of devices, deserializing only that element, or null when there is none.
+	func get_devices_at(p_index : int) -> ApiAccountDevice:
+		if not _devices is Array:
+			return null
+		var arr : Array = _devices
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiAccountDevice._from_dict(raw)
+		var e : ApiAccountDevice = arr[p_index]
+		return e
+
+	func _materialize_devices() -> void:
+		if not _devices_lazy:
+			return
+		for i in get_devices_count():
+			get_devices_at(i)
+		_devices_lazy = false
 
 	var _disable_time
 	## The UNIX time when the user's account was disabled/banned.
 	var disable_time : String:
 		get:
 			return "" if not _disable_time is String else String(_disable_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for disable_time: %s" % [v])
+				return
+			v = str(v)
+			_disable_time = v
 
 	var _email
 	## The email address of the user.
 	var email : String:
 		get:
 			return "" if not _email is String else String(_email)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for email: %s" % [v])
+				return
+			v = str(v)
+			_email = v
 
 	var _user
 	## The user object.
 	var user : ApiUser:
 		get:
 			return _user as ApiUser
+		set(p_value):
+			_user = p_value
 
 	var _verify_time
 	## The UNIX time when the user's email was verified.
 	var verify_time : String:
 		get:
 			return "" if not _verify_time is String else String(_verify_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for verify_time: %s" % [v])
+				return
+			v = str(v)
+			_verify_time = v
 
 	var _wallet
 	## The user's wallet data.
 	var wallet : String:
 		get:
 			return "" if not _wallet is String else String(_wallet)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for wallet: %s" % [v])
+				return
+			v = str(v)
+			_wallet = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
-	var _wallet_dict = null
+	var _wallet_dict : Variant = null
 	var wallet_dict : Dictionary:
 		get:
 			if _wallet_dict == null:
 				if _wallet == null:
 					return {}
-				var json = JSON.new()
-				if json.parse(_wallet) != OK:
+				var json := JSON.new()
+				if json.parse(wallet) != OK:
 					return {}
 				_wallet_dict = json.get_data()
-			return _wallet_dict as Dictionary
+			return _wallet_dict
 
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccount from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_custom_id = null, # : String
+		p_devices = null, # : Array
+		p_disable_time = null, # : String
+		p_email = null, # : String
+		p_user = null, # : ApiUser
+		p_verify_time = null, # : String
+		p_wallet = null, # : String
+	) -> ApiAccount:
+		var obj := ApiAccount.new()
+		if p_custom_id != null:
+			obj.custom_id = str(p_custom_id) if p_custom_id is int else p_custom_id
+		if p_devices != null:
+			obj.devices = p_devices
+		if p_disable_time != null:
+			obj.disable_time = str(p_disable_time) if p_disable_time is int else p_disable_time
+		if p_email != null:
+			obj.email = str(p_email) if p_email is int else p_email
+		if p_user != null:
+			obj.user = p_user
+		if p_verify_time != null:
+			obj.verify_time = str(p_verify_time) if p_verify_time is int else p_verify_time
+		if p_wallet != null:
+			obj.wallet = str(p_wallet) if p_wallet is int else p_wallet
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccount:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccount", p_dict), ApiAccount) as ApiAccount
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccount from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccount:
+		var obj := ApiAccount.new()
+		var v : Variant
+		v = p_dict.get("custom_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._custom_id = v
+		v = p_dict.get("devices")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._devices = arr
+			obj._devices_lazy = true
+		v = p_dict.get("disable_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._disable_time = v
+		v = p_dict.get("email")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._email = v
+		v = p_dict.get("user")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._user = ApiUser._from_dict(raw)
+		v = p_dict.get("verify_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._verify_time = v
+		v = p_dict.get("wallet")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._wallet = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _custom_id != null:
+			out["custom_id"] = _custom_id
+		if _devices is Array:
+			var arr := []
+			for e in _devices:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiAccountDevice:
+					var item : ApiAccountDevice = e
+					arr.append(item._to_dict())
+			out["devices"] = arr
+		if _disable_time != null:
+			out["disable_time"] = _disable_time
+		if _email != null:
+			out["email"] = _email
+		if _user is Object:
+			out["user"] = _user._to_dict()
+		if _verify_time != null:
+			out["verify_time"] = _verify_time
+		if _wallet != null:
+			out["wallet"] = _wallet
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccount, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccount:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccount = p_other
+		if typeof(_custom_id) != typeof(other._custom_id):
+			return false
+		if _custom_id != other._custom_id:
+			return false
+		if typeof(_devices) != typeof(other._devices):
+			return false
+		if _devices != null:
+			if get_devices_count() != other.get_devices_count():
+				return false
+			for i in get_devices_count():
+				var e : ApiAccountDevice = get_devices_at(i)
+				if e == null or not e.equals(other.get_devices_at(i)):
+					return false
+		if typeof(_disable_time) != typeof(other._disable_time):
+			return false
+		if _disable_time != other._disable_time:
+			return false
+		if typeof(_email) != typeof(other._email):
+			return false
+		if _email != other._email:
+			return false
+		if typeof(_user) != typeof(other._user):
+			return false
+		if _user != null and not _user.equals(other._user):
+			return false
+		if typeof(_verify_time) != typeof(other._verify_time):
+			return false
+		if _verify_time != other._verify_time:
+			return false
+		if typeof(_wallet) != typeof(other._wallet):
+			return false
+		if _wallet != other._wallet:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccount which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccount:
+		var obj := ApiAccount.new(_ex)
+		obj._custom_id = _custom_id
+		if _devices is Array:
+			var arr := []
+			for e in _devices:
+				if e is ApiAccountDevice:
+					var item : ApiAccountDevice = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._devices = arr
+			obj._devices_lazy = _devices_lazy
+		obj._disable_time = _disable_time
+		obj._email = _email
+		if _user != null:
+			obj._user = _user.duplicate_deep()
+		obj._verify_time = _verify_time
+		obj._wallet = _wallet
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_custom_id)
+		if _devices is Array:
+			var hashes := []
+			for i in get_devices_count():
+				var e : ApiAccountDevice = get_devices_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_disable_time)
+		values.append(_email)
+		values.append(_user.hash() if _user != null else null)
+		values.append(_verify_time)
+		values.append(_wallet)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "custom_id: %s, " % _custom_id
-		output += "devices: %s, " % [_devices]
+		output += "devices: %s, " % [devices]
 		output += "disable_time: %s, " % _disable_time
 		output += "email: %s, " % _email
 		output += "user: %s, " % _user
 		output += "verify_time: %s, " % _verify_time
 		output += "wallet: %s, " % _wallet
+		output += map_string
 		return output
 
 ## Send a Apple Sign In token to the server. Used with authenticate/link/unlink.
@@ -286,32 +1049,142 @@ class ApiAccountApple extends NakamaAsyncResult:
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountApple from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_token = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountApple:
+		var obj := ApiAccountApple.new()
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountApple:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountApple", p_dict), ApiAccountApple) as ApiAccountApple
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountApple from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountApple:
+		var obj := ApiAccountApple.new()
+		var v : Variant
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _token != null:
+			out["token"] = _token
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountApple, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountApple:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountApple = p_other
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountApple which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountApple:
+		var obj := ApiAccountApple.new(_ex)
+		obj._token = _token
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_token)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "token: %s, " % _token
 		var map_string : String = ""
+		output += "token: %s, " % _token
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send a custom ID to the server. Used with authenticate/link/unlink.
@@ -327,32 +1200,142 @@ class ApiAccountCustom extends NakamaAsyncResult:
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountCustom from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_id = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountCustom:
+		var obj := ApiAccountCustom.new()
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountCustom:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountCustom", p_dict), ApiAccountCustom) as ApiAccountCustom
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountCustom from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountCustom:
+		var obj := ApiAccountCustom.new()
+		var v : Variant
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _id != null:
+			out["id"] = _id
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountCustom, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountCustom:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountCustom = p_other
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountCustom which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountCustom:
+		var obj := ApiAccountCustom.new(_ex)
+		obj._id = _id
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_id)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "id: %s, " % _id
 		var map_string : String = ""
+		output += "id: %s, " % _id
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send a device to the server. Used with authenticate/link/unlink and user.
@@ -368,32 +1351,142 @@ class ApiAccountDevice extends NakamaAsyncResult:
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountDevice from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_id = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountDevice:
+		var obj := ApiAccountDevice.new()
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountDevice:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountDevice", p_dict), ApiAccountDevice) as ApiAccountDevice
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountDevice from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountDevice:
+		var obj := ApiAccountDevice.new()
+		var v : Variant
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _id != null:
+			out["id"] = _id
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountDevice, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountDevice:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountDevice = p_other
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountDevice which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountDevice:
+		var obj := ApiAccountDevice.new(_ex)
+		obj._id = _id
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_id)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "id: %s, " % _id
 		var map_string : String = ""
+		output += "id: %s, " % _id
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send an email with password to the server. Used with authenticate/link/unlink.
@@ -410,39 +1503,172 @@ class ApiAccountEmail extends NakamaAsyncResult:
 	var email : String:
 		get:
 			return "" if not _email is String else String(_email)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for email: %s" % [v])
+				return
+			v = str(v)
+			_email = v
 
 	var _password
 	## A password for the user account.
 	var password : String:
 		get:
 			return "" if not _password is String else String(_password)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for password: %s" % [v])
+				return
+			v = str(v)
+			_password = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountEmail from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_email = null, # : String
+		p_password = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountEmail:
+		var obj := ApiAccountEmail.new()
+		if p_email != null:
+			obj.email = str(p_email) if p_email is int else p_email
+		if p_password != null:
+			obj.password = str(p_password) if p_password is int else p_password
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountEmail:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountEmail", p_dict), ApiAccountEmail) as ApiAccountEmail
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountEmail from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountEmail:
+		var obj := ApiAccountEmail.new()
+		var v : Variant
+		v = p_dict.get("email")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._email = v
+		v = p_dict.get("password")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._password = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _email != null:
+			out["email"] = _email
+		if _password != null:
+			out["password"] = _password
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountEmail, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountEmail:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountEmail = p_other
+		if typeof(_email) != typeof(other._email):
+			return false
+		if _email != other._email:
+			return false
+		if typeof(_password) != typeof(other._password):
+			return false
+		if _password != other._password:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountEmail which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountEmail:
+		var obj := ApiAccountEmail.new(_ex)
+		obj._email = _email
+		obj._password = _password
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_email)
+		values.append(_password)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "email: %s, " % _email
 		output += "password: %s, " % _password
-		var map_string : String = ""
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send a Facebook token to the server. Used with authenticate/link/unlink.
@@ -458,32 +1684,142 @@ class ApiAccountFacebook extends NakamaAsyncResult:
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountFacebook from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_token = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountFacebook:
+		var obj := ApiAccountFacebook.new()
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountFacebook:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountFacebook", p_dict), ApiAccountFacebook) as ApiAccountFacebook
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountFacebook from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountFacebook:
+		var obj := ApiAccountFacebook.new()
+		var v : Variant
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _token != null:
+			out["token"] = _token
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountFacebook, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountFacebook:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountFacebook = p_other
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountFacebook which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountFacebook:
+		var obj := ApiAccountFacebook.new(_ex)
+		obj._token = _token
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_token)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "token: %s, " % _token
 		var map_string : String = ""
+		output += "token: %s, " % _token
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send a Facebook Instant Game token to the server. Used with authenticate/link/unlink.
@@ -498,32 +1834,142 @@ class ApiAccountFacebookInstantGame extends NakamaAsyncResult:
 	var signed_player_info : String:
 		get:
 			return "" if not _signed_player_info is String else String(_signed_player_info)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for signed_player_info: %s" % [v])
+				return
+			v = str(v)
+			_signed_player_info = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountFacebookInstantGame from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_signed_player_info = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountFacebookInstantGame:
+		var obj := ApiAccountFacebookInstantGame.new()
+		if p_signed_player_info != null:
+			obj.signed_player_info = str(p_signed_player_info) if p_signed_player_info is int else p_signed_player_info
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountFacebookInstantGame:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountFacebookInstantGame", p_dict), ApiAccountFacebookInstantGame) as ApiAccountFacebookInstantGame
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountFacebookInstantGame from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountFacebookInstantGame:
+		var obj := ApiAccountFacebookInstantGame.new()
+		var v : Variant
+		v = p_dict.get("signed_player_info")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._signed_player_info = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _signed_player_info != null:
+			out["signed_player_info"] = _signed_player_info
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountFacebookInstantGame, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountFacebookInstantGame:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountFacebookInstantGame = p_other
+		if typeof(_signed_player_info) != typeof(other._signed_player_info):
+			return false
+		if _signed_player_info != other._signed_player_info:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountFacebookInstantGame which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountFacebookInstantGame:
+		var obj := ApiAccountFacebookInstantGame.new(_ex)
+		obj._signed_player_info = _signed_player_info
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_signed_player_info)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "signed_player_info: %s, " % _signed_player_info
 		var map_string : String = ""
+		output += "signed_player_info: %s, " % _signed_player_info
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send Apple's Game Center account credentials to the server. Used with authenticate/link/unlink.
@@ -544,67 +1990,292 @@ class ApiAccountGameCenter extends NakamaAsyncResult:
 	var bundle_id : String:
 		get:
 			return "" if not _bundle_id is String else String(_bundle_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for bundle_id: %s" % [v])
+				return
+			v = str(v)
+			_bundle_id = v
 
 	var _player_id
 	## Player ID (generated by GameCenter).
 	var player_id : String:
 		get:
 			return "" if not _player_id is String else String(_player_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for player_id: %s" % [v])
+				return
+			v = str(v)
+			_player_id = v
 
 	var _public_key_url
 	## The URL for the public encryption key.
 	var public_key_url : String:
 		get:
 			return "" if not _public_key_url is String else String(_public_key_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for public_key_url: %s" % [v])
+				return
+			v = str(v)
+			_public_key_url = v
 
 	var _salt
 	## A random "NSString" used to compute the hash and keep it randomized.
 	var salt : String:
 		get:
 			return "" if not _salt is String else String(_salt)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for salt: %s" % [v])
+				return
+			v = str(v)
+			_salt = v
 
 	var _signature
 	## The verification signature data generated.
 	var signature : String:
 		get:
 			return "" if not _signature is String else String(_signature)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for signature: %s" % [v])
+				return
+			v = str(v)
+			_signature = v
 
 	var _timestamp_seconds
 	## Time since UNIX epoch when the signature was created.
 	var timestamp_seconds : String:
 		get:
 			return "" if not _timestamp_seconds is String else String(_timestamp_seconds)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for timestamp_seconds: %s" % [v])
+				return
+			v = str(v)
+			_timestamp_seconds = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountGameCenter from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_bundle_id = null, # : String
+		p_player_id = null, # : String
+		p_public_key_url = null, # : String
+		p_salt = null, # : String
+		p_signature = null, # : String
+		p_timestamp_seconds = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountGameCenter:
+		var obj := ApiAccountGameCenter.new()
+		if p_bundle_id != null:
+			obj.bundle_id = str(p_bundle_id) if p_bundle_id is int else p_bundle_id
+		if p_player_id != null:
+			obj.player_id = str(p_player_id) if p_player_id is int else p_player_id
+		if p_public_key_url != null:
+			obj.public_key_url = str(p_public_key_url) if p_public_key_url is int else p_public_key_url
+		if p_salt != null:
+			obj.salt = str(p_salt) if p_salt is int else p_salt
+		if p_signature != null:
+			obj.signature = str(p_signature) if p_signature is int else p_signature
+		if p_timestamp_seconds != null:
+			obj.timestamp_seconds = str(p_timestamp_seconds) if p_timestamp_seconds is int else p_timestamp_seconds
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountGameCenter:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountGameCenter", p_dict), ApiAccountGameCenter) as ApiAccountGameCenter
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountGameCenter from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountGameCenter:
+		var obj := ApiAccountGameCenter.new()
+		var v : Variant
+		v = p_dict.get("bundle_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._bundle_id = v
+		v = p_dict.get("player_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._player_id = v
+		v = p_dict.get("public_key_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._public_key_url = v
+		v = p_dict.get("salt")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._salt = v
+		v = p_dict.get("signature")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._signature = v
+		v = p_dict.get("timestamp_seconds")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._timestamp_seconds = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _bundle_id != null:
+			out["bundle_id"] = _bundle_id
+		if _player_id != null:
+			out["player_id"] = _player_id
+		if _public_key_url != null:
+			out["public_key_url"] = _public_key_url
+		if _salt != null:
+			out["salt"] = _salt
+		if _signature != null:
+			out["signature"] = _signature
+		if _timestamp_seconds != null:
+			out["timestamp_seconds"] = _timestamp_seconds
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountGameCenter, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountGameCenter:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountGameCenter = p_other
+		if typeof(_bundle_id) != typeof(other._bundle_id):
+			return false
+		if _bundle_id != other._bundle_id:
+			return false
+		if typeof(_player_id) != typeof(other._player_id):
+			return false
+		if _player_id != other._player_id:
+			return false
+		if typeof(_public_key_url) != typeof(other._public_key_url):
+			return false
+		if _public_key_url != other._public_key_url:
+			return false
+		if typeof(_salt) != typeof(other._salt):
+			return false
+		if _salt != other._salt:
+			return false
+		if typeof(_signature) != typeof(other._signature):
+			return false
+		if _signature != other._signature:
+			return false
+		if typeof(_timestamp_seconds) != typeof(other._timestamp_seconds):
+			return false
+		if _timestamp_seconds != other._timestamp_seconds:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountGameCenter which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountGameCenter:
+		var obj := ApiAccountGameCenter.new(_ex)
+		obj._bundle_id = _bundle_id
+		obj._player_id = _player_id
+		obj._public_key_url = _public_key_url
+		obj._salt = _salt
+		obj._signature = _signature
+		obj._timestamp_seconds = _timestamp_seconds
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_bundle_id)
+		values.append(_player_id)
+		values.append(_public_key_url)
+		values.append(_salt)
+		values.append(_signature)
+		values.append(_timestamp_seconds)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "bundle_id: %s, " % _bundle_id
 		output += "player_id: %s, " % _player_id
 		output += "public_key_url: %s, " % _public_key_url
 		output += "salt: %s, " % _salt
 		output += "signature: %s, " % _signature
 		output += "timestamp_seconds: %s, " % _timestamp_seconds
-		var map_string : String = ""
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send a Google token to the server. Used with authenticate/link/unlink.
@@ -620,32 +2291,142 @@ class ApiAccountGoogle extends NakamaAsyncResult:
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountGoogle from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_token = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountGoogle:
+		var obj := ApiAccountGoogle.new()
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountGoogle:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountGoogle", p_dict), ApiAccountGoogle) as ApiAccountGoogle
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountGoogle from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountGoogle:
+		var obj := ApiAccountGoogle.new()
+		var v : Variant
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _token != null:
+			out["token"] = _token
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountGoogle, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountGoogle:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountGoogle = p_other
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountGoogle which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountGoogle:
+		var obj := ApiAccountGoogle.new(_ex)
+		obj._token = _token
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_token)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "token: %s, " % _token
 		var map_string : String = ""
+		output += "token: %s, " % _token
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## Send a Steam token to the server. Used with authenticate/link/unlink.
@@ -661,32 +2442,142 @@ class ApiAccountSteam extends NakamaAsyncResult:
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiAccountSteam from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_token = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiAccountSteam:
+		var obj := ApiAccountSteam.new()
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiAccountSteam:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiAccountSteam", p_dict), ApiAccountSteam) as ApiAccountSteam
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiAccountSteam from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiAccountSteam:
+		var obj := ApiAccountSteam.new()
+		var v : Variant
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _token != null:
+			out["token"] = _token
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiAccountSteam, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiAccountSteam:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiAccountSteam = p_other
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiAccountSteam which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiAccountSteam:
+		var obj := ApiAccountSteam.new(_ex)
+		obj._token = _token
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_token)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "token: %s, " % _token
 		var map_string : String = ""
+		output += "token: %s, " % _token
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## A message sent on a channel.
@@ -713,92 +2604,443 @@ class ApiChannelMessage extends NakamaAsyncResult:
 	var channel_id : String:
 		get:
 			return "" if not _channel_id is String else String(_channel_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for channel_id: %s" % [v])
+				return
+			v = str(v)
+			_channel_id = v
 
 	var _code
 	## The code representing a message type or category.
 	var code : int:
 		get:
 			return 0 if not _code is int else int(_code)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for code: %s" % [v])
+				return
+			v = int(v)
+			_code = v
 
 	var _content
 	## The content payload.
 	var content : String:
 		get:
 			return "" if not _content is String else String(_content)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for content: %s" % [v])
+				return
+			v = str(v)
+			_content = v
 
 	var _create_time
 	## The UNIX time when the message was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _group_id
 	## The ID of the group, or an empty string if this message was not sent through a group channel.
 	var group_id : String:
 		get:
 			return "" if not _group_id is String else String(_group_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for group_id: %s" % [v])
+				return
+			v = str(v)
+			_group_id = v
 
 	var _message_id
 	## The unique ID of this message.
 	var message_id : String:
 		get:
 			return "" if not _message_id is String else String(_message_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for message_id: %s" % [v])
+				return
+			v = str(v)
+			_message_id = v
 
 	var _persistent
 	## True if the message was persisted to the channel's history, false otherwise.
 	var persistent : bool:
 		get:
 			return false if not _persistent is bool else bool(_persistent)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persistent: %s" % [v])
+				return
+			_persistent = v
 
 	var _room_name
 	## The name of the chat room, or an empty string if this message was not sent through a chat room.
 	var room_name : String:
 		get:
 			return "" if not _room_name is String else String(_room_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for room_name: %s" % [v])
+				return
+			v = str(v)
+			_room_name = v
 
 	var _sender_id
 	## Message sender, usually a user ID.
 	var sender_id : String:
 		get:
 			return "" if not _sender_id is String else String(_sender_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for sender_id: %s" % [v])
+				return
+			v = str(v)
+			_sender_id = v
 
-	# The UNIX time when the message was last updated.
 	var _update_time
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _user_id_one
 	## The ID of the first DM user, or an empty string if this message was not sent through a DM chat.
 	var user_id_one : String:
 		get:
 			return "" if not _user_id_one is String else String(_user_id_one)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id_one: %s" % [v])
+				return
+			v = str(v)
+			_user_id_one = v
 
 	var _user_id_two
 	## The ID of the second DM user, or an empty string if this message was not sent through a DM chat.
 	var user_id_two : String:
 		get:
 			return "" if not _user_id_two is String else String(_user_id_two)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id_two: %s" % [v])
+				return
+			v = str(v)
+			_user_id_two = v
 
 	var _username
 	## The username of the message sender, if any.
 	var username : String:
 		get:
 			return "" if not _username is String else String(_username)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for username: %s" % [v])
+				return
+			v = str(v)
+			_username = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiChannelMessage from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_channel_id = null, # : String
+		p_code = null, # : int
+		p_content = null, # : String
+		p_create_time = null, # : String
+		p_group_id = null, # : String
+		p_message_id = null, # : String
+		p_persistent = null, # : bool
+		p_room_name = null, # : String
+		p_sender_id = null, # : String
+		p_update_time = null, # : String
+		p_user_id_one = null, # : String
+		p_user_id_two = null, # : String
+		p_username = null, # : String
+	) -> ApiChannelMessage:
+		var obj := ApiChannelMessage.new()
+		if p_channel_id != null:
+			obj.channel_id = str(p_channel_id) if p_channel_id is int else p_channel_id
+		if p_code != null:
+			obj.code = p_code
+		if p_content != null:
+			obj.content = str(p_content) if p_content is int else p_content
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_group_id != null:
+			obj.group_id = str(p_group_id) if p_group_id is int else p_group_id
+		if p_message_id != null:
+			obj.message_id = str(p_message_id) if p_message_id is int else p_message_id
+		if p_persistent != null:
+			obj.persistent = p_persistent
+		if p_room_name != null:
+			obj.room_name = str(p_room_name) if p_room_name is int else p_room_name
+		if p_sender_id != null:
+			obj.sender_id = str(p_sender_id) if p_sender_id is int else p_sender_id
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_user_id_one != null:
+			obj.user_id_one = str(p_user_id_one) if p_user_id_one is int else p_user_id_one
+		if p_user_id_two != null:
+			obj.user_id_two = str(p_user_id_two) if p_user_id_two is int else p_user_id_two
+		if p_username != null:
+			obj.username = str(p_username) if p_username is int else p_username
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiChannelMessage:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiChannelMessage", p_dict), ApiChannelMessage) as ApiChannelMessage
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiChannelMessage from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiChannelMessage:
+		var obj := ApiChannelMessage.new()
+		var v : Variant
+		v = p_dict.get("channel_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._channel_id = v
+		v = p_dict.get("code")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._code = v
+		v = p_dict.get("content")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._content = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("group_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._group_id = v
+		v = p_dict.get("message_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._message_id = v
+		v = p_dict.get("persistent")
+		if v is bool:
+			obj._persistent = v
+		v = p_dict.get("room_name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._room_name = v
+		v = p_dict.get("sender_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._sender_id = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("user_id_one")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id_one = v
+		v = p_dict.get("user_id_two")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id_two = v
+		v = p_dict.get("username")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._username = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _channel_id != null:
+			out["channel_id"] = _channel_id
+		if _code != null:
+			out["code"] = _code
+		if _content != null:
+			out["content"] = _content
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _group_id != null:
+			out["group_id"] = _group_id
+		if _message_id != null:
+			out["message_id"] = _message_id
+		if _persistent != null:
+			out["persistent"] = _persistent
+		if _room_name != null:
+			out["room_name"] = _room_name
+		if _sender_id != null:
+			out["sender_id"] = _sender_id
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _user_id_one != null:
+			out["user_id_one"] = _user_id_one
+		if _user_id_two != null:
+			out["user_id_two"] = _user_id_two
+		if _username != null:
+			out["username"] = _username
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiChannelMessage, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiChannelMessage:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiChannelMessage = p_other
+		if typeof(_channel_id) != typeof(other._channel_id):
+			return false
+		if _channel_id != other._channel_id:
+			return false
+		if typeof(_code) != typeof(other._code):
+			return false
+		if _code != other._code:
+			return false
+		if typeof(_content) != typeof(other._content):
+			return false
+		if _content != other._content:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_group_id) != typeof(other._group_id):
+			return false
+		if _group_id != other._group_id:
+			return false
+		if typeof(_message_id) != typeof(other._message_id):
+			return false
+		if _message_id != other._message_id:
+			return false
+		if typeof(_persistent) != typeof(other._persistent):
+			return false
+		if _persistent != other._persistent:
+			return false
+		if typeof(_room_name) != typeof(other._room_name):
+			return false
+		if _room_name != other._room_name:
+			return false
+		if typeof(_sender_id) != typeof(other._sender_id):
+			return false
+		if _sender_id != other._sender_id:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_user_id_one) != typeof(other._user_id_one):
+			return false
+		if _user_id_one != other._user_id_one:
+			return false
+		if typeof(_user_id_two) != typeof(other._user_id_two):
+			return false
+		if _user_id_two != other._user_id_two:
+			return false
+		if typeof(_username) != typeof(other._username):
+			return false
+		if _username != other._username:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiChannelMessage which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiChannelMessage:
+		var obj := ApiChannelMessage.new(_ex)
+		obj._channel_id = _channel_id
+		obj._code = _code
+		obj._content = _content
+		obj._create_time = _create_time
+		obj._group_id = _group_id
+		obj._message_id = _message_id
+		obj._persistent = _persistent
+		obj._room_name = _room_name
+		obj._sender_id = _sender_id
+		obj._update_time = _update_time
+		obj._user_id_one = _user_id_one
+		obj._user_id_two = _user_id_two
+		obj._username = _username
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_channel_id)
+		values.append(_code)
+		values.append(_content)
+		values.append(_create_time)
+		values.append(_group_id)
+		values.append(_message_id)
+		values.append(_persistent)
+		values.append(_room_name)
+		values.append(_sender_id)
+		values.append(_update_time)
+		values.append(_user_id_one)
+		values.append(_user_id_two)
+		values.append(_username)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "channel_id: %s, " % _channel_id
 		output += "code: %s, " % _code
 		output += "content: %s, " % _content
@@ -812,6 +3054,7 @@ class ApiChannelMessage extends NakamaAsyncResult:
 		output += "user_id_one: %s, " % _user_id_one
 		output += "user_id_two: %s, " % _user_id_two
 		output += "username: %s, " % _username
+		output += map_string
 		return output
 
 ## A list of channel messages, usually a result of a list operation.
@@ -829,42 +3072,255 @@ class ApiChannelMessageList extends NakamaAsyncResult:
 	var cacheable_cursor : String:
 		get:
 			return "" if not _cacheable_cursor is String else String(_cacheable_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cacheable_cursor: %s" % [v])
+				return
+			v = str(v)
+			_cacheable_cursor = v
 
 	var _messages
 	## A list of messages.
 	var messages : Array:
 		get:
+			_materialize_messages()
 			return Array() if not _messages is Array else Array(_messages)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiChannelMessage._from_dict(raw))
+				elif e is ApiChannelMessage:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiChannelMessage value for an element of messages: %s" % [e])
+					return
+			_messages = arr
+			_messages_lazy = false
+
+	## True while _messages still holds the raw dictionaries received from the server.
+	var _messages_lazy := false
+
+	## The number of elements in messages, without deserializing them.
+	func get_messages_count() -> int:
+		if not _messages is Array:
+			return 0
+		var arr : Array = _messages
+		return arr.size()
+
+	## Return element p_index of messages, deserializing only that element, or null when there is none.
+	func get_messages_at(p_index : int) -> ApiChannelMessage:
+		if not _messages is Array:
+			return null
+		var arr : Array = _messages
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiChannelMessage._from_dict(raw)
+		var e : ApiChannelMessage = arr[p_index]
+		return e
+
+	func _materialize_messages() -> void:
+		if not _messages_lazy:
+			return
+		for i in get_messages_count():
+			get_messages_at(i)
+		_messages_lazy = false
 
 	var _next_cursor
 	## The cursor to send when retrieving the next page, if any.
 	var next_cursor : String:
 		get:
 			return "" if not _next_cursor is String else String(_next_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for next_cursor: %s" % [v])
+				return
+			v = str(v)
+			_next_cursor = v
 
 	var _prev_cursor
 	## The cursor to send when retrieving the previous page, if any.
 	var prev_cursor : String:
 		get:
 			return "" if not _prev_cursor is String else String(_prev_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for prev_cursor: %s" % [v])
+				return
+			v = str(v)
+			_prev_cursor = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiChannelMessageList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cacheable_cursor = null, # : String
+		p_messages = null, # : Array
+		p_next_cursor = null, # : String
+		p_prev_cursor = null, # : String
+	) -> ApiChannelMessageList:
+		var obj := ApiChannelMessageList.new()
+		if p_cacheable_cursor != null:
+			obj.cacheable_cursor = str(p_cacheable_cursor) if p_cacheable_cursor is int else p_cacheable_cursor
+		if p_messages != null:
+			obj.messages = p_messages
+		if p_next_cursor != null:
+			obj.next_cursor = str(p_next_cursor) if p_next_cursor is int else p_next_cursor
+		if p_prev_cursor != null:
+			obj.prev_cursor = str(p_prev_cursor) if p_prev_cursor is int else p_prev_cursor
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiChannelMessageList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiChannelMessageList", p_dict), ApiChannelMessageList) as ApiChannelMessageList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiChannelMessageList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiChannelMessageList:
+		var obj := ApiChannelMessageList.new()
+		var v : Variant
+		v = p_dict.get("cacheable_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cacheable_cursor = v
+		v = p_dict.get("messages")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._messages = arr
+			obj._messages_lazy = true
+		v = p_dict.get("next_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._next_cursor = v
+		v = p_dict.get("prev_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._prev_cursor = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cacheable_cursor != null:
+			out["cacheable_cursor"] = _cacheable_cursor
+		if _messages is Array:
+			var arr := []
+			for e in _messages:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiChannelMessage:
+					var item : ApiChannelMessage = e
+					arr.append(item._to_dict())
+			out["messages"] = arr
+		if _next_cursor != null:
+			out["next_cursor"] = _next_cursor
+		if _prev_cursor != null:
+			out["prev_cursor"] = _prev_cursor
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiChannelMessageList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiChannelMessageList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiChannelMessageList = p_other
+		if typeof(_cacheable_cursor) != typeof(other._cacheable_cursor):
+			return false
+		if _cacheable_cursor != other._cacheable_cursor:
+			return false
+		if typeof(_messages) != typeof(other._messages):
+			return false
+		if _messages != null:
+			if get_messages_count() != other.get_messages_count():
+				return false
+			for i in get_messages_count():
+				var e : ApiChannelMessage = get_messages_at(i)
+				if e == null or not e.equals(other.get_messages_at(i)):
+					return false
+		if typeof(_next_cursor) != typeof(other._next_cursor):
+			return false
+		if _next_cursor != other._next_cursor:
+			return false
+		if typeof(_prev_cursor) != typeof(other._prev_cursor):
+			return false
+		if _prev_cursor != other._prev_cursor:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiChannelMessageList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiChannelMessageList:
+		var obj := ApiChannelMessageList.new(_ex)
+		obj._cacheable_cursor = _cacheable_cursor
+		if _messages is Array:
+			var arr := []
+			for e in _messages:
+				if e is ApiChannelMessage:
+					var item : ApiChannelMessage = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._messages = arr
+			obj._messages_lazy = _messages_lazy
+		obj._next_cursor = _next_cursor
+		obj._prev_cursor = _prev_cursor
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cacheable_cursor)
+		if _messages is Array:
+			var hashes := []
+			for i in get_messages_count():
+				var e : ApiChannelMessage = get_messages_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_next_cursor)
+		values.append(_prev_cursor)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cacheable_cursor: %s, " % _cacheable_cursor
-		output += "messages: %s, " % [_messages]
+		output += "messages: %s, " % [messages]
 		output += "next_cursor: %s, " % _next_cursor
 		output += "prev_cursor: %s, " % _prev_cursor
+		output += map_string
 		return output
 
 ## Create a group with the current user as owner.
@@ -884,56 +3340,248 @@ class ApiCreateGroupRequest extends NakamaAsyncResult:
 	var avatar_url : String:
 		get:
 			return "" if not _avatar_url is String else String(_avatar_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for avatar_url: %s" % [v])
+				return
+			v = str(v)
+			_avatar_url = v
 
 	var _description
 	## A description for the group.
 	var description : String:
 		get:
 			return "" if not _description is String else String(_description)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for description: %s" % [v])
+				return
+			v = str(v)
+			_description = v
 
 	var _lang_tag
 	## The language expected to be a tag which follows the BCP-47 spec.
 	var lang_tag : String:
 		get:
 			return "" if not _lang_tag is String else String(_lang_tag)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for lang_tag: %s" % [v])
+				return
+			v = str(v)
+			_lang_tag = v
 
 	var _max_count
 	## Maximum number of group members.
 	var max_count : int:
 		get:
 			return 0 if not _max_count is int else int(_max_count)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for max_count: %s" % [v])
+				return
+			v = int(v)
+			_max_count = v
 
 	var _name
 	## A unique name for the group.
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
 
 	var _open
 	## Mark a group as open or not where only admins can accept members.
 	var open : bool:
 		get:
 			return false if not _open is bool else bool(_open)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for open: %s" % [v])
+				return
+			_open = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiCreateGroupRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_avatar_url = null, # : String
+		p_description = null, # : String
+		p_lang_tag = null, # : String
+		p_max_count = null, # : int
+		p_name = null, # : String
+		p_open = null, # : bool
+	) -> ApiCreateGroupRequest:
+		var obj := ApiCreateGroupRequest.new()
+		if p_avatar_url != null:
+			obj.avatar_url = str(p_avatar_url) if p_avatar_url is int else p_avatar_url
+		if p_description != null:
+			obj.description = str(p_description) if p_description is int else p_description
+		if p_lang_tag != null:
+			obj.lang_tag = str(p_lang_tag) if p_lang_tag is int else p_lang_tag
+		if p_max_count != null:
+			obj.max_count = p_max_count
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_open != null:
+			obj.open = p_open
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiCreateGroupRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiCreateGroupRequest", p_dict), ApiCreateGroupRequest) as ApiCreateGroupRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiCreateGroupRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiCreateGroupRequest:
+		var obj := ApiCreateGroupRequest.new()
+		var v : Variant
+		v = p_dict.get("avatar_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._avatar_url = v
+		v = p_dict.get("description")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._description = v
+		v = p_dict.get("lang_tag")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._lang_tag = v
+		v = p_dict.get("max_count")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._max_count = v
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("open")
+		if v is bool:
+			obj._open = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _avatar_url != null:
+			out["avatar_url"] = _avatar_url
+		if _description != null:
+			out["description"] = _description
+		if _lang_tag != null:
+			out["lang_tag"] = _lang_tag
+		if _max_count != null:
+			out["max_count"] = _max_count
+		if _name != null:
+			out["name"] = _name
+		if _open != null:
+			out["open"] = _open
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiCreateGroupRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiCreateGroupRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiCreateGroupRequest = p_other
+		if typeof(_avatar_url) != typeof(other._avatar_url):
+			return false
+		if _avatar_url != other._avatar_url:
+			return false
+		if typeof(_description) != typeof(other._description):
+			return false
+		if _description != other._description:
+			return false
+		if typeof(_lang_tag) != typeof(other._lang_tag):
+			return false
+		if _lang_tag != other._lang_tag:
+			return false
+		if typeof(_max_count) != typeof(other._max_count):
+			return false
+		if _max_count != other._max_count:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_open) != typeof(other._open):
+			return false
+		if _open != other._open:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiCreateGroupRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiCreateGroupRequest:
+		var obj := ApiCreateGroupRequest.new(_ex)
+		obj._avatar_url = _avatar_url
+		obj._description = _description
+		obj._lang_tag = _lang_tag
+		obj._max_count = _max_count
+		obj._name = _name
+		obj._open = _open
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_avatar_url)
+		values.append(_description)
+		values.append(_lang_tag)
+		values.append(_max_count)
+		values.append(_name)
+		values.append(_open)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "avatar_url: %s, " % _avatar_url
 		output += "description: %s, " % _description
 		output += "lang_tag: %s, " % _lang_tag
 		output += "max_count: %s, " % _max_count
 		output += "name: %s, " % _name
 		output += "open: %s, " % _open
+		output += map_string
 		return output
 
 ## Storage objects to delete.
@@ -950,35 +3598,154 @@ class ApiDeleteStorageObjectId extends NakamaAsyncResult:
 	var collection : String:
 		get:
 			return "" if not _collection is String else String(_collection)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for collection: %s" % [v])
+				return
+			v = str(v)
+			_collection = v
 
 	var _key
 	## The key of the object within the collection.
 	var key : String:
 		get:
 			return "" if not _key is String else String(_key)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for key: %s" % [v])
+				return
+			v = str(v)
+			_key = v
 
-	## The version hash of the object.
 	var _version
 	var version : String:
 		get:
 			return "" if not _version is String else String(_version)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for version: %s" % [v])
+				return
+			v = str(v)
+			_version = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiDeleteStorageObjectId from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_collection = null, # : String
+		p_key = null, # : String
+		p_version = null, # : String
+	) -> ApiDeleteStorageObjectId:
+		var obj := ApiDeleteStorageObjectId.new()
+		if p_collection != null:
+			obj.collection = str(p_collection) if p_collection is int else p_collection
+		if p_key != null:
+			obj.key = str(p_key) if p_key is int else p_key
+		if p_version != null:
+			obj.version = str(p_version) if p_version is int else p_version
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiDeleteStorageObjectId:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiDeleteStorageObjectId", p_dict), ApiDeleteStorageObjectId) as ApiDeleteStorageObjectId
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiDeleteStorageObjectId from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiDeleteStorageObjectId:
+		var obj := ApiDeleteStorageObjectId.new()
+		var v : Variant
+		v = p_dict.get("collection")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._collection = v
+		v = p_dict.get("key")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._key = v
+		v = p_dict.get("version")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._version = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _collection != null:
+			out["collection"] = _collection
+		if _key != null:
+			out["key"] = _key
+		if _version != null:
+			out["version"] = _version
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiDeleteStorageObjectId, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiDeleteStorageObjectId:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiDeleteStorageObjectId = p_other
+		if typeof(_collection) != typeof(other._collection):
+			return false
+		if _collection != other._collection:
+			return false
+		if typeof(_key) != typeof(other._key):
+			return false
+		if _key != other._key:
+			return false
+		if typeof(_version) != typeof(other._version):
+			return false
+		if _version != other._version:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiDeleteStorageObjectId which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiDeleteStorageObjectId:
+		var obj := ApiDeleteStorageObjectId.new(_ex)
+		obj._collection = _collection
+		obj._key = _key
+		obj._version = _version
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_collection)
+		values.append(_key)
+		values.append(_version)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "collection: %s, " % _collection
 		output += "key: %s, " % _key
 		output += "version: %s, " % _version
+		output += map_string
 		return output
 
 ## Batch delete storage objects.
@@ -992,22 +3759,166 @@ class ApiDeleteStorageObjectsRequest extends NakamaAsyncResult:
 	## Batch of storage objects.
 	var object_ids : Array:
 		get:
+			_materialize_object_ids()
 			return Array() if not _object_ids is Array else Array(_object_ids)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiDeleteStorageObjectId._from_dict(raw))
+				elif e is ApiDeleteStorageObjectId:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiDeleteStorageObjectId value for an element of object_ids: %s" % [e])
+					return
+			_object_ids = arr
+			_object_ids_lazy = false
+
+	## True while _object_ids still holds the raw dictionaries received from the server.
+	var _object_ids_lazy := false
+
+	## The number of elements in object_ids, without deserializing them.
+	func get_object_ids_count() -> int:
+		if not _object_ids is Array:
+			return 0
+		var arr : Array = _object_ids
+		return arr.size()
+
+	## Return element p_index of object_ids, deserializing only that element, or null when there is none.
+	func get_object_ids_at(p_index : int) -> ApiDeleteStorageObjectId:
+		if not _object_ids is Array:
+			return null
+		var arr : Array = _object_ids
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiDeleteStorageObjectId._from_dict(raw)
+		var e : ApiDeleteStorageObjectId = arr[p_index]
+		return e
+
+	func _materialize_object_ids() -> void:
+		if not _object_ids_lazy:
+			return
+		for i in get_object_ids_count():
+			get_object_ids_at(i)
+		_object_ids_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiDeleteStorageObjectsRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_object_ids = null, # : Array
+	) -> ApiDeleteStorageObjectsRequest:
+		var obj := ApiDeleteStorageObjectsRequest.new()
+		if p_object_ids != null:
+			obj.object_ids = p_object_ids
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiDeleteStorageObjectsRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiDeleteStorageObjectsRequest", p_dict), ApiDeleteStorageObjectsRequest) as ApiDeleteStorageObjectsRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiDeleteStorageObjectsRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiDeleteStorageObjectsRequest:
+		var obj := ApiDeleteStorageObjectsRequest.new()
+		var v : Variant
+		v = p_dict.get("object_ids")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._object_ids = arr
+			obj._object_ids_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _object_ids is Array:
+			var arr := []
+			for e in _object_ids:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiDeleteStorageObjectId:
+					var item : ApiDeleteStorageObjectId = e
+					arr.append(item._to_dict())
+			out["object_ids"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiDeleteStorageObjectsRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiDeleteStorageObjectsRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiDeleteStorageObjectsRequest = p_other
+		if typeof(_object_ids) != typeof(other._object_ids):
+			return false
+		if _object_ids != null:
+			if get_object_ids_count() != other.get_object_ids_count():
+				return false
+			for i in get_object_ids_count():
+				var e : ApiDeleteStorageObjectId = get_object_ids_at(i)
+				if e == null or not e.equals(other.get_object_ids_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiDeleteStorageObjectsRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiDeleteStorageObjectsRequest:
+		var obj := ApiDeleteStorageObjectsRequest.new(_ex)
+		if _object_ids is Array:
+			var arr := []
+			for e in _object_ids:
+				if e is ApiDeleteStorageObjectId:
+					var item : ApiDeleteStorageObjectId = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._object_ids = arr
+			obj._object_ids_lazy = _object_ids_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _object_ids is Array:
+			var hashes := []
+			for i in get_object_ids_count():
+				var e : ApiDeleteStorageObjectId = get_object_ids_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "object_ids: %s, " % [_object_ids]
+		var map_string : String = ""
+		output += "object_ids: %s, " % [object_ids]
+		output += map_string
 		return output
 
 ## Represents an event to be passed through the server to registered event handlers.
@@ -1025,46 +3936,199 @@ class ApiEvent extends NakamaAsyncResult:
 	var external : bool:
 		get:
 			return false if not _external is bool else bool(_external)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for external: %s" % [v])
+				return
+			_external = v
 
 	var _name
 	## An event name, type, category, or identifier.
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
 
 	var _properties
 	## Arbitrary event property values.
 	var properties : Dictionary:
 		get:
 			return Dictionary() if not _properties is Dictionary else _properties.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for properties: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of properties: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_properties = map
 
 	var _timestamp
 	## The time when the event was triggered.
 	var timestamp : String:
 		get:
 			return "" if not _timestamp is String else String(_timestamp)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for timestamp: %s" % [v])
+				return
+			v = str(v)
+			_timestamp = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiEvent from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_external = null, # : bool
+		p_name = null, # : String
+		p_properties = null, # : Dictionary
+		p_timestamp = null, # : String
+	) -> ApiEvent:
+		var obj := ApiEvent.new()
+		if p_external != null:
+			obj.external = p_external
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_properties != null:
+			obj.properties = p_properties
+		if p_timestamp != null:
+			obj.timestamp = str(p_timestamp) if p_timestamp is int else p_timestamp
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiEvent:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiEvent", p_dict), ApiEvent) as ApiEvent
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiEvent from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiEvent:
+		var obj := ApiEvent.new()
+		var v : Variant
+		v = p_dict.get("external")
+		if v is bool:
+			obj._external = v
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("properties")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._properties = map
+		v = p_dict.get("timestamp")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._timestamp = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _external != null:
+			out["external"] = _external
+		if _name != null:
+			out["name"] = _name
+		if _properties is Dictionary:
+			var map := {}
+			for k in _properties:
+				if _properties[k] is String:
+					map[k] = _properties[k]
+			out["properties"] = map
+		if _timestamp != null:
+			out["timestamp"] = _timestamp
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiEvent, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiEvent:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiEvent = p_other
+		if typeof(_external) != typeof(other._external):
+			return false
+		if _external != other._external:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_properties) != typeof(other._properties):
+			return false
+		if _properties != other._properties:
+			return false
+		if typeof(_timestamp) != typeof(other._timestamp):
+			return false
+		if _timestamp != other._timestamp:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiEvent which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiEvent:
+		var obj := ApiEvent.new(_ex)
+		obj._external = _external
+		obj._name = _name
+		if _properties is Dictionary:
+			var map : Dictionary = _properties
+			obj._properties = map.duplicate(true)
+		obj._timestamp = _timestamp
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_external)
+		values.append(_name)
+		values.append(_hashable(_properties))
+		values.append(_timestamp)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "external: %s, " % _external
 		output += "name: %s, " % _name
-		var map_string : String = ""
 		if typeof(_properties) == TYPE_DICTIONARY:
 			for k in _properties:
 				map_string += "{%s=%s}, " % [k, _properties[k]]
 		output += "properties: [%s], " % map_string
+		map_string = ""
 		output += "timestamp: %s, " % _timestamp
+		output += map_string
 		return output
 
 ## A friend of a user.
@@ -1081,35 +4145,156 @@ class ApiFriend extends NakamaAsyncResult:
 	var state : int:
 		get:
 			return 0 if not _state is int else int(_state)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for state: %s" % [v])
+				return
+			v = int(v)
+			_state = v
 
 	var _update_time
 	## Time of the latest relationship update.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _user
 	## The user object.
 	var user : ApiUser:
 		get:
 			return _user as ApiUser
+		set(p_value):
+			_user = p_value
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiFriend from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_state = null, # : int
+		p_update_time = null, # : String
+		p_user = null, # : ApiUser
+	) -> ApiFriend:
+		var obj := ApiFriend.new()
+		if p_state != null:
+			obj.state = p_state
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_user != null:
+			obj.user = p_user
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiFriend:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiFriend", p_dict), ApiFriend) as ApiFriend
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiFriend from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiFriend:
+		var obj := ApiFriend.new()
+		var v : Variant
+		v = p_dict.get("state")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._state = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("user")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._user = ApiUser._from_dict(raw)
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _state != null:
+			out["state"] = _state
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _user is Object:
+			out["user"] = _user._to_dict()
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiFriend, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiFriend:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiFriend = p_other
+		if typeof(_state) != typeof(other._state):
+			return false
+		if _state != other._state:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_user) != typeof(other._user):
+			return false
+		if _user != null and not _user.equals(other._user):
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiFriend which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiFriend:
+		var obj := ApiFriend.new(_ex)
+		obj._state = _state
+		obj._update_time = _update_time
+		if _user != null:
+			obj._user = _user.duplicate_deep()
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_state)
+		values.append(_update_time)
+		values.append(_user.hash() if _user != null else null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "state: %s, " % _state
 		output += "update_time: %s, " % _update_time
 		output += "user: %s, " % _user
+		output += map_string
 		return output
 
 ## A collection of zero or more friends of the user.
@@ -1125,28 +4310,195 @@ class ApiFriendList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _friends
 	## The Friend objects.
 	var friends : Array:
 		get:
+			_materialize_friends()
 			return Array() if not _friends is Array else Array(_friends)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiFriend._from_dict(raw))
+				elif e is ApiFriend:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiFriend value for an element of friends: %s" % [e])
+					return
+			_friends = arr
+			_friends_lazy = false
+
+	## True while _friends still holds the raw dictionaries received from the server.
+	var _friends_lazy := false
+
+	## The number of elements in friends, without deserializing them.
+	func get_friends_count() -> int:
+		if not _friends is Array:
+			return 0
+		var arr : Array = _friends
+		return arr.size()
+
+	## Return element p_index of friends, deserializing only that element, or null when there is none.
+	func get_friends_at(p_index : int) -> ApiFriend:
+		if not _friends is Array:
+			return null
+		var arr : Array = _friends
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiFriend._from_dict(raw)
+		var e : ApiFriend = arr[p_index]
+		return e
+
+	func _materialize_friends() -> void:
+		if not _friends_lazy:
+			return
+		for i in get_friends_count():
+			get_friends_at(i)
+		_friends_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiFriendList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_friends = null, # : Array
+	) -> ApiFriendList:
+		var obj := ApiFriendList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_friends != null:
+			obj.friends = p_friends
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiFriendList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiFriendList", p_dict), ApiFriendList) as ApiFriendList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiFriendList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiFriendList:
+		var obj := ApiFriendList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("friends")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._friends = arr
+			obj._friends_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _friends is Array:
+			var arr := []
+			for e in _friends:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiFriend:
+					var item : ApiFriend = e
+					arr.append(item._to_dict())
+			out["friends"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiFriendList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiFriendList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiFriendList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_friends) != typeof(other._friends):
+			return false
+		if _friends != null:
+			if get_friends_count() != other.get_friends_count():
+				return false
+			for i in get_friends_count():
+				var e : ApiFriend = get_friends_at(i)
+				if e == null or not e.equals(other.get_friends_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiFriendList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiFriendList:
+		var obj := ApiFriendList.new(_ex)
+		obj._cursor = _cursor
+		if _friends is Array:
+			var arr := []
+			for e in _friends:
+				if e is ApiFriend:
+					var item : ApiFriend = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._friends = arr
+			obj._friends_lazy = _friends_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		if _friends is Array:
+			var hashes := []
+			for i in get_friends_count():
+				var e : ApiFriend = get_friends_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
-		output += "friends: %s, " % [_friends]
+		output += "friends: %s, " % [friends]
+		output += map_string
 		return output
 
 ## A group in the server.
@@ -1172,86 +4524,420 @@ class ApiGroup extends NakamaAsyncResult:
 	var avatar_url : String:
 		get:
 			return "" if not _avatar_url is String else String(_avatar_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for avatar_url: %s" % [v])
+				return
+			v = str(v)
+			_avatar_url = v
 
 	var _create_time
 	## The UNIX time when the group was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _creator_id
 	## The id of the user who created the group.
 	var creator_id : String:
 		get:
 			return "" if not _creator_id is String else String(_creator_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for creator_id: %s" % [v])
+				return
+			v = str(v)
+			_creator_id = v
 
 	var _description
 	## A description for the group.
 	var description : String:
 		get:
 			return "" if not _description is String else String(_description)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for description: %s" % [v])
+				return
+			v = str(v)
+			_description = v
 
 	var _edge_count
 	## The current count of all members in the group.
 	var edge_count : int:
 		get:
 			return 0 if not _edge_count is int else int(_edge_count)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for edge_count: %s" % [v])
+				return
+			v = int(v)
+			_edge_count = v
 
 	var _id
 	## The id of a group.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _lang_tag
 	## The language expected to be a tag which follows the BCP-47 spec.
 	var lang_tag : String:
 		get:
 			return "" if not _lang_tag is String else String(_lang_tag)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for lang_tag: %s" % [v])
+				return
+			v = str(v)
+			_lang_tag = v
 
-	## The maximum number of members allowed.
 	var _max_count
 	var max_count : int:
 		get:
 			return 0 if not _max_count is int else int(_max_count)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for max_count: %s" % [v])
+				return
+			v = int(v)
+			_max_count = v
 
 	var _metadata
 	## Additional information stored as a JSON object.
 	var metadata : String:
 		get:
 			return "" if not _metadata is String else String(_metadata)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for metadata: %s" % [v])
+				return
+			v = str(v)
+			_metadata = v
 
 	var _name
 	## The unique name of the group.
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
 
 	var _open
 	## Anyone can join open groups, otherwise only admins can accept members.
 	var open : bool:
 		get:
 			return false if not _open is bool else bool(_open)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for open: %s" % [v])
+				return
+			_open = v
 
 	var _update_time
 	## The UNIX time when the group was last updated.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiGroup from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_avatar_url = null, # : String
+		p_create_time = null, # : String
+		p_creator_id = null, # : String
+		p_description = null, # : String
+		p_edge_count = null, # : int
+		p_id = null, # : String
+		p_lang_tag = null, # : String
+		p_max_count = null, # : int
+		p_metadata = null, # : String
+		p_name = null, # : String
+		p_open = null, # : bool
+		p_update_time = null, # : String
+	) -> ApiGroup:
+		var obj := ApiGroup.new()
+		if p_avatar_url != null:
+			obj.avatar_url = str(p_avatar_url) if p_avatar_url is int else p_avatar_url
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_creator_id != null:
+			obj.creator_id = str(p_creator_id) if p_creator_id is int else p_creator_id
+		if p_description != null:
+			obj.description = str(p_description) if p_description is int else p_description
+		if p_edge_count != null:
+			obj.edge_count = p_edge_count
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_lang_tag != null:
+			obj.lang_tag = str(p_lang_tag) if p_lang_tag is int else p_lang_tag
+		if p_max_count != null:
+			obj.max_count = p_max_count
+		if p_metadata != null:
+			obj.metadata = str(p_metadata) if p_metadata is int else p_metadata
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_open != null:
+			obj.open = p_open
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiGroup:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiGroup", p_dict), ApiGroup) as ApiGroup
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiGroup from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiGroup:
+		var obj := ApiGroup.new()
+		var v : Variant
+		v = p_dict.get("avatar_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._avatar_url = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("creator_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._creator_id = v
+		v = p_dict.get("description")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._description = v
+		v = p_dict.get("edge_count")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._edge_count = v
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("lang_tag")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._lang_tag = v
+		v = p_dict.get("max_count")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._max_count = v
+		v = p_dict.get("metadata")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._metadata = v
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("open")
+		if v is bool:
+			obj._open = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _avatar_url != null:
+			out["avatar_url"] = _avatar_url
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _creator_id != null:
+			out["creator_id"] = _creator_id
+		if _description != null:
+			out["description"] = _description
+		if _edge_count != null:
+			out["edge_count"] = _edge_count
+		if _id != null:
+			out["id"] = _id
+		if _lang_tag != null:
+			out["lang_tag"] = _lang_tag
+		if _max_count != null:
+			out["max_count"] = _max_count
+		if _metadata != null:
+			out["metadata"] = _metadata
+		if _name != null:
+			out["name"] = _name
+		if _open != null:
+			out["open"] = _open
+		if _update_time != null:
+			out["update_time"] = _update_time
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiGroup, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiGroup:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiGroup = p_other
+		if typeof(_avatar_url) != typeof(other._avatar_url):
+			return false
+		if _avatar_url != other._avatar_url:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_creator_id) != typeof(other._creator_id):
+			return false
+		if _creator_id != other._creator_id:
+			return false
+		if typeof(_description) != typeof(other._description):
+			return false
+		if _description != other._description:
+			return false
+		if typeof(_edge_count) != typeof(other._edge_count):
+			return false
+		if _edge_count != other._edge_count:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_lang_tag) != typeof(other._lang_tag):
+			return false
+		if _lang_tag != other._lang_tag:
+			return false
+		if typeof(_max_count) != typeof(other._max_count):
+			return false
+		if _max_count != other._max_count:
+			return false
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_open) != typeof(other._open):
+			return false
+		if _open != other._open:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiGroup which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiGroup:
+		var obj := ApiGroup.new(_ex)
+		obj._avatar_url = _avatar_url
+		obj._create_time = _create_time
+		obj._creator_id = _creator_id
+		obj._description = _description
+		obj._edge_count = _edge_count
+		obj._id = _id
+		obj._lang_tag = _lang_tag
+		obj._max_count = _max_count
+		obj._metadata = _metadata
+		obj._name = _name
+		obj._open = _open
+		obj._update_time = _update_time
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_avatar_url)
+		values.append(_create_time)
+		values.append(_creator_id)
+		values.append(_description)
+		values.append(_edge_count)
+		values.append(_id)
+		values.append(_lang_tag)
+		values.append(_max_count)
+		values.append(_metadata)
+		values.append(_name)
+		values.append(_open)
+		values.append(_update_time)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "avatar_url: %s, " % _avatar_url
 		output += "create_time: %s, " % _create_time
 		output += "creator_id: %s, " % _creator_id
@@ -1264,6 +4950,7 @@ class ApiGroup extends NakamaAsyncResult:
 		output += "name: %s, " % _name
 		output += "open: %s, " % _open
 		output += "update_time: %s, " % _update_time
+		output += map_string
 		return output
 
 ## One or more groups returned from a listing operation.
@@ -1279,28 +4966,195 @@ class ApiGroupList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _groups
 	## One or more groups.
 	var groups : Array:
 		get:
+			_materialize_groups()
 			return Array() if not _groups is Array else Array(_groups)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiGroup._from_dict(raw))
+				elif e is ApiGroup:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiGroup value for an element of groups: %s" % [e])
+					return
+			_groups = arr
+			_groups_lazy = false
+
+	## True while _groups still holds the raw dictionaries received from the server.
+	var _groups_lazy := false
+
+	## The number of elements in groups, without deserializing them.
+	func get_groups_count() -> int:
+		if not _groups is Array:
+			return 0
+		var arr : Array = _groups
+		return arr.size()
+
+	## Return element p_index of groups, deserializing only that element, or null when there is none.
+	func get_groups_at(p_index : int) -> ApiGroup:
+		if not _groups is Array:
+			return null
+		var arr : Array = _groups
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiGroup._from_dict(raw)
+		var e : ApiGroup = arr[p_index]
+		return e
+
+	func _materialize_groups() -> void:
+		if not _groups_lazy:
+			return
+		for i in get_groups_count():
+			get_groups_at(i)
+		_groups_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiGroupList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_groups = null, # : Array
+	) -> ApiGroupList:
+		var obj := ApiGroupList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_groups != null:
+			obj.groups = p_groups
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiGroupList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiGroupList", p_dict), ApiGroupList) as ApiGroupList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiGroupList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiGroupList:
+		var obj := ApiGroupList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("groups")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._groups = arr
+			obj._groups_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _groups is Array:
+			var arr := []
+			for e in _groups:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiGroup:
+					var item : ApiGroup = e
+					arr.append(item._to_dict())
+			out["groups"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiGroupList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiGroupList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiGroupList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_groups) != typeof(other._groups):
+			return false
+		if _groups != null:
+			if get_groups_count() != other.get_groups_count():
+				return false
+			for i in get_groups_count():
+				var e : ApiGroup = get_groups_at(i)
+				if e == null or not e.equals(other.get_groups_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiGroupList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiGroupList:
+		var obj := ApiGroupList.new(_ex)
+		obj._cursor = _cursor
+		if _groups is Array:
+			var arr := []
+			for e in _groups:
+				if e is ApiGroup:
+					var item : ApiGroup = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._groups = arr
+			obj._groups_lazy = _groups_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		if _groups is Array:
+			var hashes := []
+			for i in get_groups_count():
+				var e : ApiGroup = get_groups_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
-		output += "groups: %s, " % [_groups]
+		output += "groups: %s, " % [groups]
+		output += map_string
 		return output
 
 ## A list of users belonging to a group, along with their role.
@@ -1316,28 +5170,195 @@ class ApiGroupUserList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _group_users
 	## User-role pairs for a group.
 	var group_users : Array:
 		get:
+			_materialize_group_users()
 			return Array() if not _group_users is Array else Array(_group_users)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(GroupUserListGroupUser._from_dict(raw))
+				elif e is GroupUserListGroupUser:
+					arr.append(e)
+				else:
+					push_error("Invalid GroupUserListGroupUser value for an element of group_users: %s" % [e])
+					return
+			_group_users = arr
+			_group_users_lazy = false
+
+	## True while _group_users still holds the raw dictionaries received from the server.
+	var _group_users_lazy := false
+
+	## The number of elements in group_users, without deserializing them.
+	func get_group_users_count() -> int:
+		if not _group_users is Array:
+			return 0
+		var arr : Array = _group_users
+		return arr.size()
+
+	## Return element p_index of group_users, deserializing only that element, or null when there is none.
+	func get_group_users_at(p_index : int) -> GroupUserListGroupUser:
+		if not _group_users is Array:
+			return null
+		var arr : Array = _group_users
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = GroupUserListGroupUser._from_dict(raw)
+		var e : GroupUserListGroupUser = arr[p_index]
+		return e
+
+	func _materialize_group_users() -> void:
+		if not _group_users_lazy:
+			return
+		for i in get_group_users_count():
+			get_group_users_at(i)
+		_group_users_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiGroupUserList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_group_users = null, # : Array
+	) -> ApiGroupUserList:
+		var obj := ApiGroupUserList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_group_users != null:
+			obj.group_users = p_group_users
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiGroupUserList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiGroupUserList", p_dict), ApiGroupUserList) as ApiGroupUserList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiGroupUserList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiGroupUserList:
+		var obj := ApiGroupUserList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("group_users")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._group_users = arr
+			obj._group_users_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _group_users is Array:
+			var arr := []
+			for e in _group_users:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is GroupUserListGroupUser:
+					var item : GroupUserListGroupUser = e
+					arr.append(item._to_dict())
+			out["group_users"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiGroupUserList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiGroupUserList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiGroupUserList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_group_users) != typeof(other._group_users):
+			return false
+		if _group_users != null:
+			if get_group_users_count() != other.get_group_users_count():
+				return false
+			for i in get_group_users_count():
+				var e : GroupUserListGroupUser = get_group_users_at(i)
+				if e == null or not e.equals(other.get_group_users_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiGroupUserList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiGroupUserList:
+		var obj := ApiGroupUserList.new(_ex)
+		obj._cursor = _cursor
+		if _group_users is Array:
+			var arr := []
+			for e in _group_users:
+				if e is GroupUserListGroupUser:
+					var item : GroupUserListGroupUser = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._group_users = arr
+			obj._group_users_lazy = _group_users_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		if _group_users is Array:
+			var hashes := []
+			for i in get_group_users_count():
+				var e : GroupUserListGroupUser = get_group_users_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
-		output += "group_users: %s, " % [_group_users]
+		output += "group_users: %s, " % [group_users]
+		output += map_string
 		return output
 
 ## Represents a complete leaderboard record with all scores and associated metadata.
@@ -1363,86 +5384,424 @@ class ApiLeaderboardRecord extends NakamaAsyncResult:
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _expiry_time
 	## The UNIX time when the leaderboard record expires.
 	var expiry_time : String:
 		get:
 			return "" if not _expiry_time is String else String(_expiry_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for expiry_time: %s" % [v])
+				return
+			v = str(v)
+			_expiry_time = v
 
 	var _leaderboard_id
 	## The ID of the leaderboard this score belongs to.
 	var leaderboard_id : String:
 		get:
 			return "" if not _leaderboard_id is String else String(_leaderboard_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for leaderboard_id: %s" % [v])
+				return
+			v = str(v)
+			_leaderboard_id = v
 
 	var _max_num_score
 	## The maximum number of score updates allowed by the owner.
 	var max_num_score : int:
 		get:
 			return 0 if not _max_num_score is int else int(_max_num_score)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for max_num_score: %s" % [v])
+				return
+			v = int(v)
+			_max_num_score = v
 
 	var _metadata
 	## Metadata.
 	var metadata : String:
 		get:
 			return "" if not _metadata is String else String(_metadata)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for metadata: %s" % [v])
+				return
+			v = str(v)
+			_metadata = v
 
 	var _num_score
 	## The number of submissions to this score record.
 	var num_score : int:
 		get:
 			return 0 if not _num_score is int else int(_num_score)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for num_score: %s" % [v])
+				return
+			v = int(v)
+			_num_score = v
 
 	var _owner_id
 	## The ID of the score owner, usually a user or group.
 	var owner_id : String:
 		get:
 			return "" if not _owner_id is String else String(_owner_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for owner_id: %s" % [v])
+				return
+			v = str(v)
+			_owner_id = v
 
 	var _rank
 	## The rank of this record.
 	var rank : String:
 		get:
 			return "" if not _rank is String else String(_rank)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for rank: %s" % [v])
+				return
+			v = str(v)
+			_rank = v
 
 	var _score
 	## The score value.
 	var score : String:
 		get:
 			return "" if not _score is String else String(_score)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for score: %s" % [v])
+				return
+			v = str(v)
+			_score = v
 
 	var _subscore
 	## An optional subscore value.
 	var subscore : String:
 		get:
 			return "" if not _subscore is String else String(_subscore)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for subscore: %s" % [v])
+				return
+			v = str(v)
+			_subscore = v
 
 	var _update_time
 	## The UNIX time when the leaderboard record was updated.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _username
 	## The username of the score owner, if the owner is a user.
 	var username : String:
 		get:
 			return "" if not _username is String else String(_username)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for username: %s" % [v])
+				return
+			v = str(v)
+			_username = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiLeaderboardRecord from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_create_time = null, # : String
+		p_expiry_time = null, # : String
+		p_leaderboard_id = null, # : String
+		p_max_num_score = null, # : int
+		p_metadata = null, # : String
+		p_num_score = null, # : int
+		p_owner_id = null, # : String
+		p_rank = null, # : String
+		p_score = null, # : String
+		p_subscore = null, # : String
+		p_update_time = null, # : String
+		p_username = null, # : String
+	) -> ApiLeaderboardRecord:
+		var obj := ApiLeaderboardRecord.new()
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_expiry_time != null:
+			obj.expiry_time = str(p_expiry_time) if p_expiry_time is int else p_expiry_time
+		if p_leaderboard_id != null:
+			obj.leaderboard_id = str(p_leaderboard_id) if p_leaderboard_id is int else p_leaderboard_id
+		if p_max_num_score != null:
+			obj.max_num_score = p_max_num_score
+		if p_metadata != null:
+			obj.metadata = str(p_metadata) if p_metadata is int else p_metadata
+		if p_num_score != null:
+			obj.num_score = p_num_score
+		if p_owner_id != null:
+			obj.owner_id = str(p_owner_id) if p_owner_id is int else p_owner_id
+		if p_rank != null:
+			obj.rank = str(p_rank) if p_rank is int else p_rank
+		if p_score != null:
+			obj.score = str(p_score) if p_score is int else p_score
+		if p_subscore != null:
+			obj.subscore = str(p_subscore) if p_subscore is int else p_subscore
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_username != null:
+			obj.username = str(p_username) if p_username is int else p_username
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiLeaderboardRecord:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiLeaderboardRecord", p_dict), ApiLeaderboardRecord) as ApiLeaderboardRecord
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiLeaderboardRecord from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiLeaderboardRecord:
+		var obj := ApiLeaderboardRecord.new()
+		var v : Variant
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("expiry_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._expiry_time = v
+		v = p_dict.get("leaderboard_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._leaderboard_id = v
+		v = p_dict.get("max_num_score")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._max_num_score = v
+		v = p_dict.get("metadata")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._metadata = v
+		v = p_dict.get("num_score")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._num_score = v
+		v = p_dict.get("owner_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._owner_id = v
+		v = p_dict.get("rank")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._rank = v
+		v = p_dict.get("score")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._score = v
+		v = p_dict.get("subscore")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._subscore = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("username")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._username = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _expiry_time != null:
+			out["expiry_time"] = _expiry_time
+		if _leaderboard_id != null:
+			out["leaderboard_id"] = _leaderboard_id
+		if _max_num_score != null:
+			out["max_num_score"] = _max_num_score
+		if _metadata != null:
+			out["metadata"] = _metadata
+		if _num_score != null:
+			out["num_score"] = _num_score
+		if _owner_id != null:
+			out["owner_id"] = _owner_id
+		if _rank != null:
+			out["rank"] = _rank
+		if _score != null:
+			out["score"] = _score
+		if _subscore != null:
+			out["subscore"] = _subscore
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _username != null:
+			out["username"] = _username
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiLeaderboardRecord, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiLeaderboardRecord:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiLeaderboardRecord = p_other
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_expiry_time) != typeof(other._expiry_time):
+			return false
+		if _expiry_time != other._expiry_time:
+			return false
+		if typeof(_leaderboard_id) != typeof(other._leaderboard_id):
+			return false
+		if _leaderboard_id != other._leaderboard_id:
+			return false
+		if typeof(_max_num_score) != typeof(other._max_num_score):
+			return false
+		if _max_num_score != other._max_num_score:
+			return false
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_num_score) != typeof(other._num_score):
+			return false
+		if _num_score != other._num_score:
+			return false
+		if typeof(_owner_id) != typeof(other._owner_id):
+			return false
+		if _owner_id != other._owner_id:
+			return false
+		if typeof(_rank) != typeof(other._rank):
+			return false
+		if _rank != other._rank:
+			return false
+		if typeof(_score) != typeof(other._score):
+			return false
+		if _score != other._score:
+			return false
+		if typeof(_subscore) != typeof(other._subscore):
+			return false
+		if _subscore != other._subscore:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_username) != typeof(other._username):
+			return false
+		if _username != other._username:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiLeaderboardRecord which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiLeaderboardRecord:
+		var obj := ApiLeaderboardRecord.new(_ex)
+		obj._create_time = _create_time
+		obj._expiry_time = _expiry_time
+		obj._leaderboard_id = _leaderboard_id
+		obj._max_num_score = _max_num_score
+		obj._metadata = _metadata
+		obj._num_score = _num_score
+		obj._owner_id = _owner_id
+		obj._rank = _rank
+		obj._score = _score
+		obj._subscore = _subscore
+		obj._update_time = _update_time
+		obj._username = _username
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_create_time)
+		values.append(_expiry_time)
+		values.append(_leaderboard_id)
+		values.append(_max_num_score)
+		values.append(_metadata)
+		values.append(_num_score)
+		values.append(_owner_id)
+		values.append(_rank)
+		values.append(_score)
+		values.append(_subscore)
+		values.append(_update_time)
+		values.append(_username)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "create_time: %s, " % _create_time
 		output += "expiry_time: %s, " % _expiry_time
 		output += "leaderboard_id: %s, " % _leaderboard_id
@@ -1455,6 +5814,7 @@ class ApiLeaderboardRecord extends NakamaAsyncResult:
 		output += "subscore: %s, " % _subscore
 		output += "update_time: %s, " % _update_time
 		output += "username: %s, " % _username
+		output += map_string
 		return output
 
 ## A set of leaderboard records, may be part of a leaderboard records page or a batch of individual records.
@@ -1472,42 +5832,325 @@ class ApiLeaderboardRecordList extends NakamaAsyncResult:
 	var next_cursor : String:
 		get:
 			return "" if not _next_cursor is String else String(_next_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for next_cursor: %s" % [v])
+				return
+			v = str(v)
+			_next_cursor = v
 
 	var _owner_records
 	## A batched set of leaderboard records belonging to specified owners.
 	var owner_records : Array:
 		get:
+			_materialize_owner_records()
 			return Array() if not _owner_records is Array else Array(_owner_records)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiLeaderboardRecord._from_dict(raw))
+				elif e is ApiLeaderboardRecord:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiLeaderboardRecord value for an element of owner_records: %s" % [e])
+					return
+			_owner_records = arr
+			_owner_records_lazy = false
+
+	## True while _owner_records still holds the raw dictionaries received from the server.
+	var _owner_records_lazy := false
+
+	## The number of elements in owner_records, without deserializing them.
+	func get_owner_records_count() -> int:
+		if not _owner_records is Array:
+			return 0
+		var arr : Array = _owner_records
+		return arr.size()
+
+	## Return element p_index of owner_records, deserializing only that element, or null when there is none.
+	func get_owner_records_at(p_index : int) -> ApiLeaderboardRecord:
+		if not _owner_records is Array:
+			return null
+		var arr : Array = _owner_records
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiLeaderboardRecord._from_dict(raw)
+		var e : ApiLeaderboardRecord = arr[p_index]
+		return e
+
+	func _materialize_owner_records() -> void:
+		if not _owner_records_lazy:
+			return
+		for i in get_owner_records_count():
+			get_owner_records_at(i)
+		_owner_records_lazy = false
 
 	var _prev_cursor
 	## The cursor to send when retrieving the previous page, if any.
 	var prev_cursor : String:
 		get:
 			return "" if not _prev_cursor is String else String(_prev_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for prev_cursor: %s" % [v])
+				return
+			v = str(v)
+			_prev_cursor = v
 
 	var _records
 	## A list of leaderboard records.
 	var records : Array:
 		get:
+			_materialize_records()
 			return Array() if not _records is Array else Array(_records)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiLeaderboardRecord._from_dict(raw))
+				elif e is ApiLeaderboardRecord:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiLeaderboardRecord value for an element of records: %s" % [e])
+					return
+			_records = arr
+			_records_lazy = false
+
+	## True while _records still holds the raw dictionaries received from the server.
+	var _records_lazy := false
+
+	## The number of elements in records, without deserializing them.
+	func get_records_count() -> int:
+		if not _records is Array:
+			return 0
+		var arr : Array = _records
+		return arr.size()
+
+	## Return element p_index of records, deserializing only that element, or null when there is none.
+	func get_records_at(p_index : int) -> ApiLeaderboardRecord:
+		if not _records is Array:
+			return null
+		var arr : Array = _records
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiLeaderboardRecord._from_dict(raw)
+		var e : ApiLeaderboardRecord = arr[p_index]
+		return e
+
+	func _materialize_records() -> void:
+		if not _records_lazy:
+			return
+		for i in get_records_count():
+			get_records_at(i)
+		_records_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiLeaderboardRecordList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_next_cursor = null, # : String
+		p_owner_records = null, # : Array
+		p_prev_cursor = null, # : String
+		p_records = null, # : Array
+	) -> ApiLeaderboardRecordList:
+		var obj := ApiLeaderboardRecordList.new()
+		if p_next_cursor != null:
+			obj.next_cursor = str(p_next_cursor) if p_next_cursor is int else p_next_cursor
+		if p_owner_records != null:
+			obj.owner_records = p_owner_records
+		if p_prev_cursor != null:
+			obj.prev_cursor = str(p_prev_cursor) if p_prev_cursor is int else p_prev_cursor
+		if p_records != null:
+			obj.records = p_records
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiLeaderboardRecordList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiLeaderboardRecordList", p_dict), ApiLeaderboardRecordList) as ApiLeaderboardRecordList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiLeaderboardRecordList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiLeaderboardRecordList:
+		var obj := ApiLeaderboardRecordList.new()
+		var v : Variant
+		v = p_dict.get("next_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._next_cursor = v
+		v = p_dict.get("owner_records")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._owner_records = arr
+			obj._owner_records_lazy = true
+		v = p_dict.get("prev_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._prev_cursor = v
+		v = p_dict.get("records")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._records = arr
+			obj._records_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _next_cursor != null:
+			out["next_cursor"] = _next_cursor
+		if _owner_records is Array:
+			var arr := []
+			for e in _owner_records:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item._to_dict())
+			out["owner_records"] = arr
+		if _prev_cursor != null:
+			out["prev_cursor"] = _prev_cursor
+		if _records is Array:
+			var arr := []
+			for e in _records:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item._to_dict())
+			out["records"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiLeaderboardRecordList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiLeaderboardRecordList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiLeaderboardRecordList = p_other
+		if typeof(_next_cursor) != typeof(other._next_cursor):
+			return false
+		if _next_cursor != other._next_cursor:
+			return false
+		if typeof(_owner_records) != typeof(other._owner_records):
+			return false
+		if _owner_records != null:
+			if get_owner_records_count() != other.get_owner_records_count():
+				return false
+			for i in get_owner_records_count():
+				var e : ApiLeaderboardRecord = get_owner_records_at(i)
+				if e == null or not e.equals(other.get_owner_records_at(i)):
+					return false
+		if typeof(_prev_cursor) != typeof(other._prev_cursor):
+			return false
+		if _prev_cursor != other._prev_cursor:
+			return false
+		if typeof(_records) != typeof(other._records):
+			return false
+		if _records != null:
+			if get_records_count() != other.get_records_count():
+				return false
+			for i in get_records_count():
+				var e : ApiLeaderboardRecord = get_records_at(i)
+				if e == null or not e.equals(other.get_records_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiLeaderboardRecordList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiLeaderboardRecordList:
+		var obj := ApiLeaderboardRecordList.new(_ex)
+		obj._next_cursor = _next_cursor
+		if _owner_records is Array:
+			var arr := []
+			for e in _owner_records:
+				if e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._owner_records = arr
+			obj._owner_records_lazy = _owner_records_lazy
+		obj._prev_cursor = _prev_cursor
+		if _records is Array:
+			var arr := []
+			for e in _records:
+				if e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._records = arr
+			obj._records_lazy = _records_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_next_cursor)
+		if _owner_records is Array:
+			var hashes := []
+			for i in get_owner_records_count():
+				var e : ApiLeaderboardRecord = get_owner_records_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_prev_cursor)
+		if _records is Array:
+			var hashes := []
+			for i in get_records_count():
+				var e : ApiLeaderboardRecord = get_records_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "next_cursor: %s, " % _next_cursor
-		output += "owner_records: %s, " % [_owner_records]
+		output += "owner_records: %s, " % [owner_records]
 		output += "prev_cursor: %s, " % _prev_cursor
-		output += "records: %s, " % [_records]
+		output += "records: %s, " % [records]
+		output += map_string
 		return output
 
 ## Link Steam to the current user's account.
@@ -1523,28 +6166,117 @@ class ApiLinkSteamRequest extends NakamaAsyncResult:
 	var account : ApiAccountSteam:
 		get:
 			return _account as ApiAccountSteam
+		set(p_value):
+			_account = p_value
 
 	var _sync
 	## Import Steam friends for the user.
 	var sync : bool:
 		get:
 			return false if not _sync is bool else bool(_sync)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for sync: %s" % [v])
+				return
+			_sync = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiLinkSteamRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_account = null, # : ApiAccountSteam
+		p_sync = null, # : bool
+	) -> ApiLinkSteamRequest:
+		var obj := ApiLinkSteamRequest.new()
+		if p_account != null:
+			obj.account = p_account
+		if p_sync != null:
+			obj.sync = p_sync
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiLinkSteamRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiLinkSteamRequest", p_dict), ApiLinkSteamRequest) as ApiLinkSteamRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiLinkSteamRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiLinkSteamRequest:
+		var obj := ApiLinkSteamRequest.new()
+		var v : Variant
+		v = p_dict.get("account")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._account = ApiAccountSteam._from_dict(raw)
+		v = p_dict.get("sync")
+		if v is bool:
+			obj._sync = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _account is Object:
+			out["account"] = _account._to_dict()
+		if _sync != null:
+			out["sync"] = _sync
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiLinkSteamRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiLinkSteamRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiLinkSteamRequest = p_other
+		if typeof(_account) != typeof(other._account):
+			return false
+		if _account != null and not _account.equals(other._account):
+			return false
+		if typeof(_sync) != typeof(other._sync):
+			return false
+		if _sync != other._sync:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiLinkSteamRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiLinkSteamRequest:
+		var obj := ApiLinkSteamRequest.new(_ex)
+		if _account != null:
+			obj._account = _account.duplicate_deep()
+		obj._sync = _sync
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_account.hash() if _account != null else null)
+		values.append(_sync)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "account: %s, " % _account
 		output += "sync: %s, " % _sync
+		output += map_string
 		return output
 
 ## List user subscriptions.
@@ -1559,27 +6291,130 @@ class ApiListSubscriptionsRequest extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _limit
 	var limit : int:
 		get:
 			return 0 if not _limit is int else int(_limit)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for limit: %s" % [v])
+				return
+			v = int(v)
+			_limit = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiListSubscriptionsRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_limit = null, # : int
+	) -> ApiListSubscriptionsRequest:
+		var obj := ApiListSubscriptionsRequest.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_limit != null:
+			obj.limit = p_limit
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiListSubscriptionsRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiListSubscriptionsRequest", p_dict), ApiListSubscriptionsRequest) as ApiListSubscriptionsRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiListSubscriptionsRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiListSubscriptionsRequest:
+		var obj := ApiListSubscriptionsRequest.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("limit")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._limit = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _limit != null:
+			out["limit"] = _limit
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiListSubscriptionsRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiListSubscriptionsRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiListSubscriptionsRequest = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_limit) != typeof(other._limit):
+			return false
+		if _limit != other._limit:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiListSubscriptionsRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiListSubscriptionsRequest:
+		var obj := ApiListSubscriptionsRequest.new(_ex)
+		obj._cursor = _cursor
+		obj._limit = _limit
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		values.append(_limit)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
 		output += "limit: %s, " % _limit
+		output += map_string
 		return output
 
 ## Represents a realtime match.
@@ -1599,54 +6434,252 @@ class ApiMatch extends NakamaAsyncResult:
 	var authoritative : bool:
 		get:
 			return false if not _authoritative is bool else bool(_authoritative)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for authoritative: %s" % [v])
+				return
+			_authoritative = v
 
 	var _handler_name
 	var handler_name : String:
 		get:
 			return "" if not _handler_name is String else String(_handler_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for handler_name: %s" % [v])
+				return
+			v = str(v)
+			_handler_name = v
 
 	var _label
 	## Match label, if any.
 	var label : String:
 		get:
 			return "" if not _label is String else String(_label)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for label: %s" % [v])
+				return
+			v = str(v)
+			_label = v
 
 	var _match_id
 	## The ID of the match, can be used to join.
 	var match_id : String:
 		get:
 			return "" if not _match_id is String else String(_match_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for match_id: %s" % [v])
+				return
+			v = str(v)
+			_match_id = v
 
 	var _size
 	## Current number of users in the match.
 	var size : int:
 		get:
 			return 0 if not _size is int else int(_size)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for size: %s" % [v])
+				return
+			v = int(v)
+			_size = v
 
 	var _tick_rate
 	var tick_rate : int:
 		get:
 			return 0 if not _tick_rate is int else int(_tick_rate)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for tick_rate: %s" % [v])
+				return
+			v = int(v)
+			_tick_rate = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiMatch from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_authoritative = null, # : bool
+		p_handler_name = null, # : String
+		p_label = null, # : String
+		p_match_id = null, # : String
+		p_size = null, # : int
+		p_tick_rate = null, # : int
+	) -> ApiMatch:
+		var obj := ApiMatch.new()
+		if p_authoritative != null:
+			obj.authoritative = p_authoritative
+		if p_handler_name != null:
+			obj.handler_name = str(p_handler_name) if p_handler_name is int else p_handler_name
+		if p_label != null:
+			obj.label = str(p_label) if p_label is int else p_label
+		if p_match_id != null:
+			obj.match_id = str(p_match_id) if p_match_id is int else p_match_id
+		if p_size != null:
+			obj.size = p_size
+		if p_tick_rate != null:
+			obj.tick_rate = p_tick_rate
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiMatch:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiMatch", p_dict), ApiMatch) as ApiMatch
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiMatch from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiMatch:
+		var obj := ApiMatch.new()
+		var v : Variant
+		v = p_dict.get("authoritative")
+		if v is bool:
+			obj._authoritative = v
+		v = p_dict.get("handler_name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._handler_name = v
+		v = p_dict.get("label")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._label = v
+		v = p_dict.get("match_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._match_id = v
+		v = p_dict.get("size")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._size = v
+		v = p_dict.get("tick_rate")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._tick_rate = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _authoritative != null:
+			out["authoritative"] = _authoritative
+		if _handler_name != null:
+			out["handler_name"] = _handler_name
+		if _label != null:
+			out["label"] = _label
+		if _match_id != null:
+			out["match_id"] = _match_id
+		if _size != null:
+			out["size"] = _size
+		if _tick_rate != null:
+			out["tick_rate"] = _tick_rate
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiMatch, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiMatch:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiMatch = p_other
+		if typeof(_authoritative) != typeof(other._authoritative):
+			return false
+		if _authoritative != other._authoritative:
+			return false
+		if typeof(_handler_name) != typeof(other._handler_name):
+			return false
+		if _handler_name != other._handler_name:
+			return false
+		if typeof(_label) != typeof(other._label):
+			return false
+		if _label != other._label:
+			return false
+		if typeof(_match_id) != typeof(other._match_id):
+			return false
+		if _match_id != other._match_id:
+			return false
+		if typeof(_size) != typeof(other._size):
+			return false
+		if _size != other._size:
+			return false
+		if typeof(_tick_rate) != typeof(other._tick_rate):
+			return false
+		if _tick_rate != other._tick_rate:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiMatch which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiMatch:
+		var obj := ApiMatch.new(_ex)
+		obj._authoritative = _authoritative
+		obj._handler_name = _handler_name
+		obj._label = _label
+		obj._match_id = _match_id
+		obj._size = _size
+		obj._tick_rate = _tick_rate
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_authoritative)
+		values.append(_handler_name)
+		values.append(_label)
+		values.append(_match_id)
+		values.append(_size)
+		values.append(_tick_rate)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "authoritative: %s, " % _authoritative
 		output += "handler_name: %s, " % _handler_name
 		output += "label: %s, " % _label
 		output += "match_id: %s, " % _match_id
 		output += "size: %s, " % _size
 		output += "tick_rate: %s, " % _tick_rate
+		output += map_string
 		return output
 
 ## A list of realtime matches.
@@ -1660,22 +6693,166 @@ class ApiMatchList extends NakamaAsyncResult:
 	## A number of matches corresponding to a list operation.
 	var matches : Array:
 		get:
+			_materialize_matches()
 			return Array() if not _matches is Array else Array(_matches)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiMatch._from_dict(raw))
+				elif e is ApiMatch:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiMatch value for an element of matches: %s" % [e])
+					return
+			_matches = arr
+			_matches_lazy = false
+
+	## True while _matches still holds the raw dictionaries received from the server.
+	var _matches_lazy := false
+
+	## The number of elements in matches, without deserializing them.
+	func get_matches_count() -> int:
+		if not _matches is Array:
+			return 0
+		var arr : Array = _matches
+		return arr.size()
+
+	## Return element p_index of matches, deserializing only that element, or null when there is none.
+	func get_matches_at(p_index : int) -> ApiMatch:
+		if not _matches is Array:
+			return null
+		var arr : Array = _matches
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiMatch._from_dict(raw)
+		var e : ApiMatch = arr[p_index]
+		return e
+
+	func _materialize_matches() -> void:
+		if not _matches_lazy:
+			return
+		for i in get_matches_count():
+			get_matches_at(i)
+		_matches_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiMatchList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_matches = null, # : Array
+	) -> ApiMatchList:
+		var obj := ApiMatchList.new()
+		if p_matches != null:
+			obj.matches = p_matches
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiMatchList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiMatchList", p_dict), ApiMatchList) as ApiMatchList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiMatchList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiMatchList:
+		var obj := ApiMatchList.new()
+		var v : Variant
+		v = p_dict.get("matches")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._matches = arr
+			obj._matches_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _matches is Array:
+			var arr := []
+			for e in _matches:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiMatch:
+					var item : ApiMatch = e
+					arr.append(item._to_dict())
+			out["matches"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiMatchList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiMatchList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiMatchList = p_other
+		if typeof(_matches) != typeof(other._matches):
+			return false
+		if _matches != null:
+			if get_matches_count() != other.get_matches_count():
+				return false
+			for i in get_matches_count():
+				var e : ApiMatch = get_matches_at(i)
+				if e == null or not e.equals(other.get_matches_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiMatchList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiMatchList:
+		var obj := ApiMatchList.new(_ex)
+		if _matches is Array:
+			var arr := []
+			for e in _matches:
+				if e is ApiMatch:
+					var item : ApiMatch = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._matches = arr
+			obj._matches_lazy = _matches_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _matches is Array:
+			var hashes := []
+			for i in get_matches_count():
+				var e : ApiMatch = get_matches_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "matches: %s, " % [_matches]
+		var map_string : String = ""
+		output += "matches: %s, " % [matches]
+		output += map_string
 		return output
 
 ## A notification in the server.
@@ -1696,56 +6873,270 @@ class ApiNotification extends NakamaAsyncResult:
 	var code : int:
 		get:
 			return 0 if not _code is int else int(_code)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for code: %s" % [v])
+				return
+			v = int(v)
+			_code = v
 
 	var _content
 	## Content of the notification in JSON.
 	var content : String:
 		get:
 			return "" if not _content is String else String(_content)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for content: %s" % [v])
+				return
+			v = str(v)
+			_content = v
 
 	var _create_time
 	## The UNIX time when the notification was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _id
 	## ID of the Notification.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _persistent
 	## True if this notification was persisted to the database.
 	var persistent : bool:
 		get:
 			return false if not _persistent is bool else bool(_persistent)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persistent: %s" % [v])
+				return
+			_persistent = v
 
 	var _sender_id
 	## ID of the sender, if a user. Otherwise 'null'.
 	var sender_id : String:
 		get:
 			return "" if not _sender_id is String else String(_sender_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for sender_id: %s" % [v])
+				return
+			v = str(v)
+			_sender_id = v
 
 	var _subject
 	## Subject of the notification.
 	var subject : String:
 		get:
 			return "" if not _subject is String else String(_subject)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for subject: %s" % [v])
+				return
+			v = str(v)
+			_subject = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiNotification from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_code = null, # : int
+		p_content = null, # : String
+		p_create_time = null, # : String
+		p_id = null, # : String
+		p_persistent = null, # : bool
+		p_sender_id = null, # : String
+		p_subject = null, # : String
+	) -> ApiNotification:
+		var obj := ApiNotification.new()
+		if p_code != null:
+			obj.code = p_code
+		if p_content != null:
+			obj.content = str(p_content) if p_content is int else p_content
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_persistent != null:
+			obj.persistent = p_persistent
+		if p_sender_id != null:
+			obj.sender_id = str(p_sender_id) if p_sender_id is int else p_sender_id
+		if p_subject != null:
+			obj.subject = str(p_subject) if p_subject is int else p_subject
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiNotification:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiNotification", p_dict), ApiNotification) as ApiNotification
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiNotification from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiNotification:
+		var obj := ApiNotification.new()
+		var v : Variant
+		v = p_dict.get("code")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._code = v
+		v = p_dict.get("content")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._content = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("persistent")
+		if v is bool:
+			obj._persistent = v
+		v = p_dict.get("sender_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._sender_id = v
+		v = p_dict.get("subject")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._subject = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _code != null:
+			out["code"] = _code
+		if _content != null:
+			out["content"] = _content
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _id != null:
+			out["id"] = _id
+		if _persistent != null:
+			out["persistent"] = _persistent
+		if _sender_id != null:
+			out["sender_id"] = _sender_id
+		if _subject != null:
+			out["subject"] = _subject
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiNotification, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiNotification:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiNotification = p_other
+		if typeof(_code) != typeof(other._code):
+			return false
+		if _code != other._code:
+			return false
+		if typeof(_content) != typeof(other._content):
+			return false
+		if _content != other._content:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_persistent) != typeof(other._persistent):
+			return false
+		if _persistent != other._persistent:
+			return false
+		if typeof(_sender_id) != typeof(other._sender_id):
+			return false
+		if _sender_id != other._sender_id:
+			return false
+		if typeof(_subject) != typeof(other._subject):
+			return false
+		if _subject != other._subject:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiNotification which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiNotification:
+		var obj := ApiNotification.new(_ex)
+		obj._code = _code
+		obj._content = _content
+		obj._create_time = _create_time
+		obj._id = _id
+		obj._persistent = _persistent
+		obj._sender_id = _sender_id
+		obj._subject = _subject
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_code)
+		values.append(_content)
+		values.append(_create_time)
+		values.append(_id)
+		values.append(_persistent)
+		values.append(_sender_id)
+		values.append(_subject)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "code: %s, " % _code
 		output += "content: %s, " % _content
 		output += "create_time: %s, " % _create_time
@@ -1753,10 +7144,12 @@ class ApiNotification extends NakamaAsyncResult:
 		output += "persistent: %s, " % _persistent
 		output += "sender_id: %s, " % _sender_id
 		output += "subject: %s, " % _subject
+		output += map_string
 		return output
 
 ## A collection of zero or more notifications.
 class ApiNotificationList extends NakamaAsyncResult:
+
 	const _SCHEMA = {
 		"cacheable_cursor": {"name": "_cacheable_cursor", "type": TYPE_STRING, "required": false},
 		"notifications": {"name": "_notifications", "type": TYPE_ARRAY, "required": false, "content": "ApiNotification"},
@@ -1767,28 +7160,195 @@ class ApiNotificationList extends NakamaAsyncResult:
 	var cacheable_cursor : String:
 		get:
 			return "" if not _cacheable_cursor is String else String(_cacheable_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cacheable_cursor: %s" % [v])
+				return
+			v = str(v)
+			_cacheable_cursor = v
 
 	var _notifications
 	## Collection of notifications.
 	var notifications : Array:
 		get:
+			_materialize_notifications()
 			return Array() if not _notifications is Array else Array(_notifications)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiNotification._from_dict(raw))
+				elif e is ApiNotification:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiNotification value for an element of notifications: %s" % [e])
+					return
+			_notifications = arr
+			_notifications_lazy = false
+
+	## True while _notifications still holds the raw dictionaries received from the server.
+	var _notifications_lazy := false
+
+	## The number of elements in notifications, without deserializing them.
+	func get_notifications_count() -> int:
+		if not _notifications is Array:
+			return 0
+		var arr : Array = _notifications
+		return arr.size()
+
+	## Return element p_index of notifications, deserializing only that element, or null when there is none.
+	func get_notifications_at(p_index : int) -> ApiNotification:
+		if not _notifications is Array:
+			return null
+		var arr : Array = _notifications
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiNotification._from_dict(raw)
+		var e : ApiNotification = arr[p_index]
+		return e
+
+	func _materialize_notifications() -> void:
+		if not _notifications_lazy:
+			return
+		for i in get_notifications_count():
+			get_notifications_at(i)
+		_notifications_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiNotificationList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cacheable_cursor = null, # : String
+		p_notifications = null, # : Array
+	) -> ApiNotificationList:
+		var obj := ApiNotificationList.new()
+		if p_cacheable_cursor != null:
+			obj.cacheable_cursor = str(p_cacheable_cursor) if p_cacheable_cursor is int else p_cacheable_cursor
+		if p_notifications != null:
+			obj.notifications = p_notifications
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiNotificationList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiNotificationList", p_dict), ApiNotificationList) as ApiNotificationList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiNotificationList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiNotificationList:
+		var obj := ApiNotificationList.new()
+		var v : Variant
+		v = p_dict.get("cacheable_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cacheable_cursor = v
+		v = p_dict.get("notifications")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._notifications = arr
+			obj._notifications_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cacheable_cursor != null:
+			out["cacheable_cursor"] = _cacheable_cursor
+		if _notifications is Array:
+			var arr := []
+			for e in _notifications:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiNotification:
+					var item : ApiNotification = e
+					arr.append(item._to_dict())
+			out["notifications"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiNotificationList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiNotificationList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiNotificationList = p_other
+		if typeof(_cacheable_cursor) != typeof(other._cacheable_cursor):
+			return false
+		if _cacheable_cursor != other._cacheable_cursor:
+			return false
+		if typeof(_notifications) != typeof(other._notifications):
+			return false
+		if _notifications != null:
+			if get_notifications_count() != other.get_notifications_count():
+				return false
+			for i in get_notifications_count():
+				var e : ApiNotification = get_notifications_at(i)
+				if e == null or not e.equals(other.get_notifications_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiNotificationList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiNotificationList:
+		var obj := ApiNotificationList.new(_ex)
+		obj._cacheable_cursor = _cacheable_cursor
+		if _notifications is Array:
+			var arr := []
+			for e in _notifications:
+				if e is ApiNotification:
+					var item : ApiNotification = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._notifications = arr
+			obj._notifications_lazy = _notifications_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cacheable_cursor)
+		if _notifications is Array:
+			var hashes := []
+			for i in get_notifications_count():
+				var e : ApiNotification = get_notifications_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cacheable_cursor: %s, " % _cacheable_cursor
-		output += "notifications: %s, " % [_notifications]
+		output += "notifications: %s, " % [notifications]
+		output += map_string
 		return output
 
 ## Operator that can be used to override the one set in the leaderboard.
@@ -1819,35 +7379,155 @@ class ApiReadStorageObjectId extends NakamaAsyncResult:
 	var collection : String:
 		get:
 			return "" if not _collection is String else String(_collection)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for collection: %s" % [v])
+				return
+			v = str(v)
+			_collection = v
 
 	var _key
 	## The key of the object within the collection.
 	var key : String:
 		get:
 			return "" if not _key is String else String(_key)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for key: %s" % [v])
+				return
+			v = str(v)
+			_key = v
 
 	var _user_id
 	## The user owner of the object.
 	var user_id : String:
 		get:
 			return "" if not _user_id is String else String(_user_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id: %s" % [v])
+				return
+			v = str(v)
+			_user_id = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiReadStorageObjectId from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_collection = null, # : String
+		p_key = null, # : String
+		p_user_id = null, # : String
+	) -> ApiReadStorageObjectId:
+		var obj := ApiReadStorageObjectId.new()
+		if p_collection != null:
+			obj.collection = str(p_collection) if p_collection is int else p_collection
+		if p_key != null:
+			obj.key = str(p_key) if p_key is int else p_key
+		if p_user_id != null:
+			obj.user_id = str(p_user_id) if p_user_id is int else p_user_id
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiReadStorageObjectId:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiReadStorageObjectId", p_dict), ApiReadStorageObjectId) as ApiReadStorageObjectId
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiReadStorageObjectId from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiReadStorageObjectId:
+		var obj := ApiReadStorageObjectId.new()
+		var v : Variant
+		v = p_dict.get("collection")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._collection = v
+		v = p_dict.get("key")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._key = v
+		v = p_dict.get("user_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _collection != null:
+			out["collection"] = _collection
+		if _key != null:
+			out["key"] = _key
+		if _user_id != null:
+			out["user_id"] = _user_id
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiReadStorageObjectId, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiReadStorageObjectId:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiReadStorageObjectId = p_other
+		if typeof(_collection) != typeof(other._collection):
+			return false
+		if _collection != other._collection:
+			return false
+		if typeof(_key) != typeof(other._key):
+			return false
+		if _key != other._key:
+			return false
+		if typeof(_user_id) != typeof(other._user_id):
+			return false
+		if _user_id != other._user_id:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiReadStorageObjectId which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiReadStorageObjectId:
+		var obj := ApiReadStorageObjectId.new(_ex)
+		obj._collection = _collection
+		obj._key = _key
+		obj._user_id = _user_id
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_collection)
+		values.append(_key)
+		values.append(_user_id)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "collection: %s, " % _collection
 		output += "key: %s, " % _key
 		output += "user_id: %s, " % _user_id
+		output += map_string
 		return output
 
 ## Batch get storage objects.
@@ -1861,22 +7541,166 @@ class ApiReadStorageObjectsRequest extends NakamaAsyncResult:
 	## Batch of storage objects.
 	var object_ids : Array:
 		get:
+			_materialize_object_ids()
 			return Array() if not _object_ids is Array else Array(_object_ids)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiReadStorageObjectId._from_dict(raw))
+				elif e is ApiReadStorageObjectId:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiReadStorageObjectId value for an element of object_ids: %s" % [e])
+					return
+			_object_ids = arr
+			_object_ids_lazy = false
+
+	## True while _object_ids still holds the raw dictionaries received from the server.
+	var _object_ids_lazy := false
+
+	## The number of elements in object_ids, without deserializing them.
+	func get_object_ids_count() -> int:
+		if not _object_ids is Array:
+			return 0
+		var arr : Array = _object_ids
+		return arr.size()
+
+	## Return element p_index of object_ids, deserializing only that element, or null when there is none.
+	func get_object_ids_at(p_index : int) -> ApiReadStorageObjectId:
+		if not _object_ids is Array:
+			return null
+		var arr : Array = _object_ids
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiReadStorageObjectId._from_dict(raw)
+		var e : ApiReadStorageObjectId = arr[p_index]
+		return e
+
+	func _materialize_object_ids() -> void:
+		if not _object_ids_lazy:
+			return
+		for i in get_object_ids_count():
+			get_object_ids_at(i)
+		_object_ids_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiReadStorageObjectsRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_object_ids = null, # : Array
+	) -> ApiReadStorageObjectsRequest:
+		var obj := ApiReadStorageObjectsRequest.new()
+		if p_object_ids != null:
+			obj.object_ids = p_object_ids
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiReadStorageObjectsRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiReadStorageObjectsRequest", p_dict), ApiReadStorageObjectsRequest) as ApiReadStorageObjectsRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiReadStorageObjectsRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiReadStorageObjectsRequest:
+		var obj := ApiReadStorageObjectsRequest.new()
+		var v : Variant
+		v = p_dict.get("object_ids")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._object_ids = arr
+			obj._object_ids_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _object_ids is Array:
+			var arr := []
+			for e in _object_ids:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiReadStorageObjectId:
+					var item : ApiReadStorageObjectId = e
+					arr.append(item._to_dict())
+			out["object_ids"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiReadStorageObjectsRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiReadStorageObjectsRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiReadStorageObjectsRequest = p_other
+		if typeof(_object_ids) != typeof(other._object_ids):
+			return false
+		if _object_ids != null:
+			if get_object_ids_count() != other.get_object_ids_count():
+				return false
+			for i in get_object_ids_count():
+				var e : ApiReadStorageObjectId = get_object_ids_at(i)
+				if e == null or not e.equals(other.get_object_ids_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiReadStorageObjectsRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiReadStorageObjectsRequest:
+		var obj := ApiReadStorageObjectsRequest.new(_ex)
+		if _object_ids is Array:
+			var arr := []
+			for e in _object_ids:
+				if e is ApiReadStorageObjectId:
+					var item : ApiReadStorageObjectId = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._object_ids = arr
+			obj._object_ids_lazy = _object_ids_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _object_ids is Array:
+			var hashes := []
+			for i in get_object_ids_count():
+				var e : ApiReadStorageObjectId = get_object_ids_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "object_ids: %s, " % [_object_ids]
+		var map_string : String = ""
+		output += "object_ids: %s, " % [object_ids]
+		output += map_string
 		return output
 
 ## Execute an Lua function on the server.
@@ -1893,35 +7717,155 @@ class ApiRpc extends NakamaAsyncResult:
 	var http_key : String:
 		get:
 			return "" if not _http_key is String else String(_http_key)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for http_key: %s" % [v])
+				return
+			v = str(v)
+			_http_key = v
 
 	var _id
 	## The identifier of the function.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _payload
 	## The payload of the function which must be a JSON object.
 	var payload : String:
 		get:
 			return "" if not _payload is String else String(_payload)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for payload: %s" % [v])
+				return
+			v = str(v)
+			_payload = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiRpc from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_http_key = null, # : String
+		p_id = null, # : String
+		p_payload = null, # : String
+	) -> ApiRpc:
+		var obj := ApiRpc.new()
+		if p_http_key != null:
+			obj.http_key = str(p_http_key) if p_http_key is int else p_http_key
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_payload != null:
+			obj.payload = str(p_payload) if p_payload is int else p_payload
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiRpc:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiRpc", p_dict), ApiRpc) as ApiRpc
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiRpc from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiRpc:
+		var obj := ApiRpc.new()
+		var v : Variant
+		v = p_dict.get("http_key")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._http_key = v
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("payload")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._payload = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _http_key != null:
+			out["http_key"] = _http_key
+		if _id != null:
+			out["id"] = _id
+		if _payload != null:
+			out["payload"] = _payload
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiRpc, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiRpc:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiRpc = p_other
+		if typeof(_http_key) != typeof(other._http_key):
+			return false
+		if _http_key != other._http_key:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_payload) != typeof(other._payload):
+			return false
+		if _payload != other._payload:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiRpc which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiRpc:
+		var obj := ApiRpc.new(_ex)
+		obj._http_key = _http_key
+		obj._id = _id
+		obj._payload = _payload
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_http_key)
+		values.append(_id)
+		values.append(_payload)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "http_key: %s, " % _http_key
 		output += "id: %s, " % _id
 		output += "payload: %s, " % _payload
+		output += map_string
 		return output
 
 ## A user's session used to authenticate messages.
@@ -1938,35 +7882,152 @@ class ApiSession extends NakamaAsyncResult:
 	var created : bool:
 		get:
 			return false if not _created is bool else bool(_created)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for created: %s" % [v])
+				return
+			_created = v
 
 	var _refresh_token
 	## Refresh token that can be used for session token renewal.
 	var refresh_token : String:
 		get:
 			return "" if not _refresh_token is String else String(_refresh_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refresh_token: %s" % [v])
+				return
+			v = str(v)
+			_refresh_token = v
 
 	var _token
 	## Authentication credentials.
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiSession from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_created = null, # : bool
+		p_refresh_token = null, # : String
+		p_token = null, # : String
+	) -> ApiSession:
+		var obj := ApiSession.new()
+		if p_created != null:
+			obj.created = p_created
+		if p_refresh_token != null:
+			obj.refresh_token = str(p_refresh_token) if p_refresh_token is int else p_refresh_token
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiSession:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiSession", p_dict), ApiSession) as ApiSession
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiSession from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiSession:
+		var obj := ApiSession.new()
+		var v : Variant
+		v = p_dict.get("created")
+		if v is bool:
+			obj._created = v
+		v = p_dict.get("refresh_token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refresh_token = v
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _created != null:
+			out["created"] = _created
+		if _refresh_token != null:
+			out["refresh_token"] = _refresh_token
+		if _token != null:
+			out["token"] = _token
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiSession, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiSession:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiSession = p_other
+		if typeof(_created) != typeof(other._created):
+			return false
+		if _created != other._created:
+			return false
+		if typeof(_refresh_token) != typeof(other._refresh_token):
+			return false
+		if _refresh_token != other._refresh_token:
+			return false
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiSession which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiSession:
+		var obj := ApiSession.new(_ex)
+		obj._created = _created
+		obj._refresh_token = _refresh_token
+		obj._token = _token
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_created)
+		values.append(_refresh_token)
+		values.append(_token)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "created: %s, " % _created
 		output += "refresh_token: %s, " % _refresh_token
 		output += "token: %s, " % _token
+		output += map_string
 		return output
 
 ## Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
@@ -1982,28 +8043,125 @@ class ApiSessionLogoutRequest extends NakamaAsyncResult:
 	var refresh_token : String:
 		get:
 			return "" if not _refresh_token is String else String(_refresh_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refresh_token: %s" % [v])
+				return
+			v = str(v)
+			_refresh_token = v
 
 	var _token
 	## Session token to log out.
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiSessionLogoutRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_refresh_token = null, # : String
+		p_token = null, # : String
+	) -> ApiSessionLogoutRequest:
+		var obj := ApiSessionLogoutRequest.new()
+		if p_refresh_token != null:
+			obj.refresh_token = str(p_refresh_token) if p_refresh_token is int else p_refresh_token
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiSessionLogoutRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiSessionLogoutRequest", p_dict), ApiSessionLogoutRequest) as ApiSessionLogoutRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiSessionLogoutRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiSessionLogoutRequest:
+		var obj := ApiSessionLogoutRequest.new()
+		var v : Variant
+		v = p_dict.get("refresh_token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refresh_token = v
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _refresh_token != null:
+			out["refresh_token"] = _refresh_token
+		if _token != null:
+			out["token"] = _token
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiSessionLogoutRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiSessionLogoutRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiSessionLogoutRequest = p_other
+		if typeof(_refresh_token) != typeof(other._refresh_token):
+			return false
+		if _refresh_token != other._refresh_token:
+			return false
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiSessionLogoutRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiSessionLogoutRequest:
+		var obj := ApiSessionLogoutRequest.new(_ex)
+		obj._refresh_token = _refresh_token
+		obj._token = _token
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_refresh_token)
+		values.append(_token)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "refresh_token: %s, " % _refresh_token
 		output += "token: %s, " % _token
+		output += map_string
 		return output
 
 ## Authenticate against the server with a refresh token.
@@ -2019,32 +8177,142 @@ class ApiSessionRefreshRequest extends NakamaAsyncResult:
 	var token : String:
 		get:
 			return "" if not _token is String else String(_token)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for token: %s" % [v])
+				return
+			v = str(v)
+			_token = v
 
 	var _vars
 	## Extra information that will be bundled in the session token.
 	var vars : Dictionary:
 		get:
 			return Dictionary() if not _vars is Dictionary else _vars.duplicate()
+		set(p_value):
+			var map := {}
+			for k in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for vars: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				if not (v is String or v is StringName or v is int):
+					push_error("Invalid String value for an element of vars: %s" % [v])
+					return
+				v = str(v)
+				map[str(k)] = v
+			_vars = map
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiSessionRefreshRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_token = null, # : String
+		p_vars = null, # : Dictionary
+	) -> ApiSessionRefreshRequest:
+		var obj := ApiSessionRefreshRequest.new()
+		if p_token != null:
+			obj.token = str(p_token) if p_token is int else p_token
+		if p_vars != null:
+			obj.vars = p_vars
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiSessionRefreshRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiSessionRefreshRequest", p_dict), ApiSessionRefreshRequest) as ApiSessionRefreshRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiSessionRefreshRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiSessionRefreshRequest:
+		var obj := ApiSessionRefreshRequest.new()
+		var v : Variant
+		v = p_dict.get("token")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._token = v
+		v = p_dict.get("vars")
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = str(v[k])
+			obj._vars = map
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _token != null:
+			out["token"] = _token
+		if _vars is Dictionary:
+			var map := {}
+			for k in _vars:
+				if _vars[k] is String:
+					map[k] = _vars[k]
+			out["vars"] = map
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiSessionRefreshRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiSessionRefreshRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiSessionRefreshRequest = p_other
+		if typeof(_token) != typeof(other._token):
+			return false
+		if _token != other._token:
+			return false
+		if typeof(_vars) != typeof(other._vars):
+			return false
+		if _vars != other._vars:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiSessionRefreshRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiSessionRefreshRequest:
+		var obj := ApiSessionRefreshRequest.new(_ex)
+		obj._token = _token
+		if _vars is Dictionary:
+			var map : Dictionary = _vars
+			obj._vars = map.duplicate(true)
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_token)
+		values.append(_hashable(_vars))
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "token: %s, " % _token
 		var map_string : String = ""
+		output += "token: %s, " % _token
 		if typeof(_vars) == TYPE_DICTIONARY:
 			for k in _vars:
 				map_string += "{%s=%s}, " % [k, _vars[k]]
 		output += "vars: [%s], " % map_string
+		map_string = ""
+		output += map_string
 		return output
 
 ## An object within the storage engine.
@@ -2067,68 +8335,337 @@ class ApiStorageObject extends NakamaAsyncResult:
 	var collection : String:
 		get:
 			return "" if not _collection is String else String(_collection)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for collection: %s" % [v])
+				return
+			v = str(v)
+			_collection = v
 
 	var _create_time
 	## The UNIX time when the object was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _key
 	## The key of the object within the collection.
 	var key : String:
 		get:
 			return "" if not _key is String else String(_key)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for key: %s" % [v])
+				return
+			v = str(v)
+			_key = v
 
 	var _permission_read
 	## The read access permissions for the object.
 	var permission_read : int:
 		get:
 			return 0 if not _permission_read is int else int(_permission_read)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for permission_read: %s" % [v])
+				return
+			v = int(v)
+			_permission_read = v
 
 	var _permission_write
 	## The write access permissions for the object.
 	var permission_write : int:
 		get:
 			return 0 if not _permission_write is int else int(_permission_write)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for permission_write: %s" % [v])
+				return
+			v = int(v)
+			_permission_write = v
 
 	var _update_time
 	## The UNIX time when the object was last updated.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _user_id
 	## The user owner of the object.
 	var user_id : String:
 		get:
 			return "" if not _user_id is String else String(_user_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id: %s" % [v])
+				return
+			v = str(v)
+			_user_id = v
 
 	var _value
 	## The value of the object.
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
 
 	var _version
 	## The version hash of the object.
 	var version : String:
 		get:
 			return "" if not _version is String else String(_version)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for version: %s" % [v])
+				return
+			v = str(v)
+			_version = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiStorageObject from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_collection = null, # : String
+		p_create_time = null, # : String
+		p_key = null, # : String
+		p_permission_read = null, # : int
+		p_permission_write = null, # : int
+		p_update_time = null, # : String
+		p_user_id = null, # : String
+		p_value = null, # : String
+		p_version = null, # : String
+	) -> ApiStorageObject:
+		var obj := ApiStorageObject.new()
+		if p_collection != null:
+			obj.collection = str(p_collection) if p_collection is int else p_collection
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_key != null:
+			obj.key = str(p_key) if p_key is int else p_key
+		if p_permission_read != null:
+			obj.permission_read = p_permission_read
+		if p_permission_write != null:
+			obj.permission_write = p_permission_write
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_user_id != null:
+			obj.user_id = str(p_user_id) if p_user_id is int else p_user_id
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		if p_version != null:
+			obj.version = str(p_version) if p_version is int else p_version
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiStorageObject:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiStorageObject", p_dict), ApiStorageObject) as ApiStorageObject
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiStorageObject from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiStorageObject:
+		var obj := ApiStorageObject.new()
+		var v : Variant
+		v = p_dict.get("collection")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._collection = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("key")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._key = v
+		v = p_dict.get("permission_read")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._permission_read = v
+		v = p_dict.get("permission_write")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._permission_write = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("user_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		v = p_dict.get("version")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._version = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _collection != null:
+			out["collection"] = _collection
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _key != null:
+			out["key"] = _key
+		if _permission_read != null:
+			out["permission_read"] = _permission_read
+		if _permission_write != null:
+			out["permission_write"] = _permission_write
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _user_id != null:
+			out["user_id"] = _user_id
+		if _value != null:
+			out["value"] = _value
+		if _version != null:
+			out["version"] = _version
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiStorageObject, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiStorageObject:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiStorageObject = p_other
+		if typeof(_collection) != typeof(other._collection):
+			return false
+		if _collection != other._collection:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_key) != typeof(other._key):
+			return false
+		if _key != other._key:
+			return false
+		if typeof(_permission_read) != typeof(other._permission_read):
+			return false
+		if _permission_read != other._permission_read:
+			return false
+		if typeof(_permission_write) != typeof(other._permission_write):
+			return false
+		if _permission_write != other._permission_write:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_user_id) != typeof(other._user_id):
+			return false
+		if _user_id != other._user_id:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if typeof(_version) != typeof(other._version):
+			return false
+		if _version != other._version:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiStorageObject which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiStorageObject:
+		var obj := ApiStorageObject.new(_ex)
+		obj._collection = _collection
+		obj._create_time = _create_time
+		obj._key = _key
+		obj._permission_read = _permission_read
+		obj._permission_write = _permission_write
+		obj._update_time = _update_time
+		obj._user_id = _user_id
+		obj._value = _value
+		obj._version = _version
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_collection)
+		values.append(_create_time)
+		values.append(_key)
+		values.append(_permission_read)
+		values.append(_permission_write)
+		values.append(_update_time)
+		values.append(_user_id)
+		values.append(_value)
+		values.append(_version)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "collection: %s, " % _collection
 		output += "create_time: %s, " % _create_time
 		output += "key: %s, " % _key
@@ -2138,6 +8675,7 @@ class ApiStorageObject extends NakamaAsyncResult:
 		output += "user_id: %s, " % _user_id
 		output += "value: %s, " % _value
 		output += "version: %s, " % _version
+		output += map_string
 		return output
 
 ## A storage acknowledgement.
@@ -2155,42 +8693,184 @@ class ApiStorageObjectAck extends NakamaAsyncResult:
 	var collection : String:
 		get:
 			return "" if not _collection is String else String(_collection)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for collection: %s" % [v])
+				return
+			v = str(v)
+			_collection = v
 
 	var _key
 	## The key of the object within the collection.
 	var key : String:
 		get:
 			return "" if not _key is String else String(_key)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for key: %s" % [v])
+				return
+			v = str(v)
+			_key = v
 
 	var _user_id
 	## The owner of the object.
 	var user_id : String:
 		get:
 			return "" if not _user_id is String else String(_user_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id: %s" % [v])
+				return
+			v = str(v)
+			_user_id = v
 
-	# The version hash of the object.
 	var _version
 	var version : String:
 		get:
 			return "" if not _version is String else String(_version)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for version: %s" % [v])
+				return
+			v = str(v)
+			_version = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiStorageObjectAck from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_collection = null, # : String
+		p_key = null, # : String
+		p_user_id = null, # : String
+		p_version = null, # : String
+	) -> ApiStorageObjectAck:
+		var obj := ApiStorageObjectAck.new()
+		if p_collection != null:
+			obj.collection = str(p_collection) if p_collection is int else p_collection
+		if p_key != null:
+			obj.key = str(p_key) if p_key is int else p_key
+		if p_user_id != null:
+			obj.user_id = str(p_user_id) if p_user_id is int else p_user_id
+		if p_version != null:
+			obj.version = str(p_version) if p_version is int else p_version
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiStorageObjectAck:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiStorageObjectAck", p_dict), ApiStorageObjectAck) as ApiStorageObjectAck
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiStorageObjectAck from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiStorageObjectAck:
+		var obj := ApiStorageObjectAck.new()
+		var v : Variant
+		v = p_dict.get("collection")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._collection = v
+		v = p_dict.get("key")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._key = v
+		v = p_dict.get("user_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id = v
+		v = p_dict.get("version")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._version = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _collection != null:
+			out["collection"] = _collection
+		if _key != null:
+			out["key"] = _key
+		if _user_id != null:
+			out["user_id"] = _user_id
+		if _version != null:
+			out["version"] = _version
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiStorageObjectAck, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiStorageObjectAck:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiStorageObjectAck = p_other
+		if typeof(_collection) != typeof(other._collection):
+			return false
+		if _collection != other._collection:
+			return false
+		if typeof(_key) != typeof(other._key):
+			return false
+		if _key != other._key:
+			return false
+		if typeof(_user_id) != typeof(other._user_id):
+			return false
+		if _user_id != other._user_id:
+			return false
+		if typeof(_version) != typeof(other._version):
+			return false
+		if _version != other._version:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiStorageObjectAck which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiStorageObjectAck:
+		var obj := ApiStorageObjectAck.new(_ex)
+		obj._collection = _collection
+		obj._key = _key
+		obj._user_id = _user_id
+		obj._version = _version
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_collection)
+		values.append(_key)
+		values.append(_user_id)
+		values.append(_version)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "collection: %s, " % _collection
 		output += "key: %s, " % _key
 		output += "user_id: %s, " % _user_id
 		output += "version: %s, " % _version
+		output += map_string
 		return output
 
 ## Batch of acknowledgements for the storage object write.
@@ -2204,22 +8884,166 @@ class ApiStorageObjectAcks extends NakamaAsyncResult:
 	## Batch of storage write acknowledgements.
 	var acks : Array:
 		get:
+			_materialize_acks()
 			return Array() if not _acks is Array else Array(_acks)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiStorageObjectAck._from_dict(raw))
+				elif e is ApiStorageObjectAck:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiStorageObjectAck value for an element of acks: %s" % [e])
+					return
+			_acks = arr
+			_acks_lazy = false
+
+	## True while _acks still holds the raw dictionaries received from the server.
+	var _acks_lazy := false
+
+	## The number of elements in acks, without deserializing them.
+	func get_acks_count() -> int:
+		if not _acks is Array:
+			return 0
+		var arr : Array = _acks
+		return arr.size()
+
+	## Return element p_index of acks, deserializing only that element, or null when there is none.
+	func get_acks_at(p_index : int) -> ApiStorageObjectAck:
+		if not _acks is Array:
+			return null
+		var arr : Array = _acks
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiStorageObjectAck._from_dict(raw)
+		var e : ApiStorageObjectAck = arr[p_index]
+		return e
+
+	func _materialize_acks() -> void:
+		if not _acks_lazy:
+			return
+		for i in get_acks_count():
+			get_acks_at(i)
+		_acks_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiStorageObjectAcks from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_acks = null, # : Array
+	) -> ApiStorageObjectAcks:
+		var obj := ApiStorageObjectAcks.new()
+		if p_acks != null:
+			obj.acks = p_acks
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiStorageObjectAcks:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiStorageObjectAcks", p_dict), ApiStorageObjectAcks) as ApiStorageObjectAcks
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiStorageObjectAcks from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiStorageObjectAcks:
+		var obj := ApiStorageObjectAcks.new()
+		var v : Variant
+		v = p_dict.get("acks")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._acks = arr
+			obj._acks_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _acks is Array:
+			var arr := []
+			for e in _acks:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiStorageObjectAck:
+					var item : ApiStorageObjectAck = e
+					arr.append(item._to_dict())
+			out["acks"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiStorageObjectAcks, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiStorageObjectAcks:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiStorageObjectAcks = p_other
+		if typeof(_acks) != typeof(other._acks):
+			return false
+		if _acks != null:
+			if get_acks_count() != other.get_acks_count():
+				return false
+			for i in get_acks_count():
+				var e : ApiStorageObjectAck = get_acks_at(i)
+				if e == null or not e.equals(other.get_acks_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiStorageObjectAcks which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiStorageObjectAcks:
+		var obj := ApiStorageObjectAcks.new(_ex)
+		if _acks is Array:
+			var arr := []
+			for e in _acks:
+				if e is ApiStorageObjectAck:
+					var item : ApiStorageObjectAck = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._acks = arr
+			obj._acks_lazy = _acks_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _acks is Array:
+			var hashes := []
+			for i in get_acks_count():
+				var e : ApiStorageObjectAck = get_acks_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "acks: %s, " % [_acks]
+		var map_string : String = ""
+		output += "acks: %s, " % [acks]
+		output += map_string
 		return output
 
 ## List of storage objects.
@@ -2235,28 +9059,195 @@ class ApiStorageObjectList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _objects
 	## The list of storage objects.
 	var objects : Array:
 		get:
+			_materialize_objects()
 			return Array() if not _objects is Array else Array(_objects)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiStorageObject._from_dict(raw))
+				elif e is ApiStorageObject:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiStorageObject value for an element of objects: %s" % [e])
+					return
+			_objects = arr
+			_objects_lazy = false
+
+	## True while _objects still holds the raw dictionaries received from the server.
+	var _objects_lazy := false
+
+	## The number of elements in objects, without deserializing them.
+	func get_objects_count() -> int:
+		if not _objects is Array:
+			return 0
+		var arr : Array = _objects
+		return arr.size()
+
+	## Return element p_index of objects, deserializing only that element, or null when there is none.
+	func get_objects_at(p_index : int) -> ApiStorageObject:
+		if not _objects is Array:
+			return null
+		var arr : Array = _objects
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiStorageObject._from_dict(raw)
+		var e : ApiStorageObject = arr[p_index]
+		return e
+
+	func _materialize_objects() -> void:
+		if not _objects_lazy:
+			return
+		for i in get_objects_count():
+			get_objects_at(i)
+		_objects_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiStorageObjectList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_objects = null, # : Array
+	) -> ApiStorageObjectList:
+		var obj := ApiStorageObjectList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_objects != null:
+			obj.objects = p_objects
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiStorageObjectList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiStorageObjectList", p_dict), ApiStorageObjectList) as ApiStorageObjectList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiStorageObjectList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiStorageObjectList:
+		var obj := ApiStorageObjectList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("objects")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._objects = arr
+			obj._objects_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _objects is Array:
+			var arr := []
+			for e in _objects:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiStorageObject:
+					var item : ApiStorageObject = e
+					arr.append(item._to_dict())
+			out["objects"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiStorageObjectList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiStorageObjectList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiStorageObjectList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_objects) != typeof(other._objects):
+			return false
+		if _objects != null:
+			if get_objects_count() != other.get_objects_count():
+				return false
+			for i in get_objects_count():
+				var e : ApiStorageObject = get_objects_at(i)
+				if e == null or not e.equals(other.get_objects_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiStorageObjectList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiStorageObjectList:
+		var obj := ApiStorageObjectList.new(_ex)
+		obj._cursor = _cursor
+		if _objects is Array:
+			var arr := []
+			for e in _objects:
+				if e is ApiStorageObject:
+					var item : ApiStorageObject = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._objects = arr
+			obj._objects_lazy = _objects_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		if _objects is Array:
+			var hashes := []
+			for i in get_objects_count():
+				var e : ApiStorageObject = get_objects_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
-		output += "objects: %s, " % [_objects]
+		output += "objects: %s, " % [objects]
+		output += map_string
 		return output
 
 ## Batch of storage objects.
@@ -2270,22 +9261,166 @@ class ApiStorageObjects extends NakamaAsyncResult:
 	## The batch of storage objects.
 	var objects : Array:
 		get:
+			_materialize_objects()
 			return Array() if not _objects is Array else Array(_objects)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiStorageObject._from_dict(raw))
+				elif e is ApiStorageObject:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiStorageObject value for an element of objects: %s" % [e])
+					return
+			_objects = arr
+			_objects_lazy = false
+
+	## True while _objects still holds the raw dictionaries received from the server.
+	var _objects_lazy := false
+
+	## The number of elements in objects, without deserializing them.
+	func get_objects_count() -> int:
+		if not _objects is Array:
+			return 0
+		var arr : Array = _objects
+		return arr.size()
+
+	## Return element p_index of objects, deserializing only that element, or null when there is none.
+	func get_objects_at(p_index : int) -> ApiStorageObject:
+		if not _objects is Array:
+			return null
+		var arr : Array = _objects
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiStorageObject._from_dict(raw)
+		var e : ApiStorageObject = arr[p_index]
+		return e
+
+	func _materialize_objects() -> void:
+		if not _objects_lazy:
+			return
+		for i in get_objects_count():
+			get_objects_at(i)
+		_objects_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiStorageObjects from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_objects = null, # : Array
+	) -> ApiStorageObjects:
+		var obj := ApiStorageObjects.new()
+		if p_objects != null:
+			obj.objects = p_objects
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiStorageObjects:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiStorageObjects", p_dict), ApiStorageObjects) as ApiStorageObjects
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiStorageObjects from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiStorageObjects:
+		var obj := ApiStorageObjects.new()
+		var v : Variant
+		v = p_dict.get("objects")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._objects = arr
+			obj._objects_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _objects is Array:
+			var arr := []
+			for e in _objects:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiStorageObject:
+					var item : ApiStorageObject = e
+					arr.append(item._to_dict())
+			out["objects"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiStorageObjects, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiStorageObjects:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiStorageObjects = p_other
+		if typeof(_objects) != typeof(other._objects):
+			return false
+		if _objects != null:
+			if get_objects_count() != other.get_objects_count():
+				return false
+			for i in get_objects_count():
+				var e : ApiStorageObject = get_objects_at(i)
+				if e == null or not e.equals(other.get_objects_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiStorageObjects which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiStorageObjects:
+		var obj := ApiStorageObjects.new(_ex)
+		if _objects is Array:
+			var arr := []
+			for e in _objects:
+				if e is ApiStorageObject:
+					var item : ApiStorageObject = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._objects = arr
+			obj._objects_lazy = _objects_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _objects is Array:
+			var hashes := []
+			for i in get_objects_count():
+				var e : ApiStorageObject = get_objects_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "objects: %s, " % [_objects]
+		var map_string : String = ""
+		output += "objects: %s, " % [objects]
+		output += map_string
 		return output
 
 ## Environment where a purchase/subscription took place,
@@ -2322,35 +9457,225 @@ class ApiSubscriptionList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _prev_cursor
 	## The cursor to send when retrieving the previous page, if any.
 	var prev_cursor : String:
 		get:
 			return "" if not _prev_cursor is String else String(_prev_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for prev_cursor: %s" % [v])
+				return
+			v = str(v)
+			_prev_cursor = v
 
 	var _validated_subscriptions
 	## Stored validated subscriptions.
 	var validated_subscriptions : Array:
 		get:
+			_materialize_validated_subscriptions()
 			return Array() if not _validated_subscriptions is Array else Array(_validated_subscriptions)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiValidatedSubscription._from_dict(raw))
+				elif e is ApiValidatedSubscription:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiValidatedSubscription value for an element of validated_subscriptions: %s" % [e])
+					return
+			_validated_subscriptions = arr
+			_validated_subscriptions_lazy = false
+
+	## True while _validated_subscriptions still holds the raw dictionaries received from the server.
+	var _validated_subscriptions_lazy := false
+
+	## The number of elements in validated_subscriptions, without deserializing them.
+	func get_validated_subscriptions_count() -> int:
+		if not _validated_subscriptions is Array:
+			return 0
+		var arr : Array = _validated_subscriptions
+		return arr.size()
+
+	## Return element p_index of validated_subscriptions, deserializing only that element, or null when there is none.
+	func get_validated_subscriptions_at(p_index : int) -> ApiValidatedSubscription:
+		if not _validated_subscriptions is Array:
+			return null
+		var arr : Array = _validated_subscriptions
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiValidatedSubscription._from_dict(raw)
+		var e : ApiValidatedSubscription = arr[p_index]
+		return e
+
+	func _materialize_validated_subscriptions() -> void:
+		if not _validated_subscriptions_lazy:
+			return
+		for i in get_validated_subscriptions_count():
+			get_validated_subscriptions_at(i)
+		_validated_subscriptions_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiSubscriptionList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_prev_cursor = null, # : String
+		p_validated_subscriptions = null, # : Array
+	) -> ApiSubscriptionList:
+		var obj := ApiSubscriptionList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_prev_cursor != null:
+			obj.prev_cursor = str(p_prev_cursor) if p_prev_cursor is int else p_prev_cursor
+		if p_validated_subscriptions != null:
+			obj.validated_subscriptions = p_validated_subscriptions
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiSubscriptionList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiSubscriptionList", p_dict), ApiSubscriptionList) as ApiSubscriptionList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiSubscriptionList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiSubscriptionList:
+		var obj := ApiSubscriptionList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("prev_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._prev_cursor = v
+		v = p_dict.get("validated_subscriptions")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._validated_subscriptions = arr
+			obj._validated_subscriptions_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _prev_cursor != null:
+			out["prev_cursor"] = _prev_cursor
+		if _validated_subscriptions is Array:
+			var arr := []
+			for e in _validated_subscriptions:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiValidatedSubscription:
+					var item : ApiValidatedSubscription = e
+					arr.append(item._to_dict())
+			out["validated_subscriptions"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiSubscriptionList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiSubscriptionList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiSubscriptionList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_prev_cursor) != typeof(other._prev_cursor):
+			return false
+		if _prev_cursor != other._prev_cursor:
+			return false
+		if typeof(_validated_subscriptions) != typeof(other._validated_subscriptions):
+			return false
+		if _validated_subscriptions != null:
+			if get_validated_subscriptions_count() != other.get_validated_subscriptions_count():
+				return false
+			for i in get_validated_subscriptions_count():
+				var e : ApiValidatedSubscription = get_validated_subscriptions_at(i)
+				if e == null or not e.equals(other.get_validated_subscriptions_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiSubscriptionList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiSubscriptionList:
+		var obj := ApiSubscriptionList.new(_ex)
+		obj._cursor = _cursor
+		obj._prev_cursor = _prev_cursor
+		if _validated_subscriptions is Array:
+			var arr := []
+			for e in _validated_subscriptions:
+				if e is ApiValidatedSubscription:
+					var item : ApiValidatedSubscription = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._validated_subscriptions = arr
+			obj._validated_subscriptions_lazy = _validated_subscriptions_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		values.append(_prev_cursor)
+		if _validated_subscriptions is Array:
+			var hashes := []
+			for i in get_validated_subscriptions_count():
+				var e : ApiValidatedSubscription = get_validated_subscriptions_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
 		output += "prev_cursor: %s, " % _prev_cursor
-		output += "validated_subscriptions: %s, " % [_validated_subscriptions]
+		output += "validated_subscriptions: %s, " % [validated_subscriptions]
+		output += map_string
 		return output
 
 ## A tournament on the server.
@@ -2384,134 +9709,699 @@ class ApiTournament extends NakamaAsyncResult:
 	var authoritative : bool:
 		get:
 			return false if not _authoritative is bool else bool(_authoritative)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for authoritative: %s" % [v])
+				return
+			_authoritative = v
 
 	var _can_enter
 	## True if the tournament is active and can enter. A computed value.
 	var can_enter : bool:
 		get:
 			return false if not _can_enter is bool else bool(_can_enter)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for can_enter: %s" % [v])
+				return
+			_can_enter = v
 
 	var _category
 	## The category of the tournament. e.g. "vip" could be category 1.
 	var category : int:
 		get:
 			return 0 if not _category is int else int(_category)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for category: %s" % [v])
+				return
+			v = int(v)
+			_category = v
 
 	var _create_time
 	## The UNIX time when the tournament was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _description
 	## The description of the tournament. May be blank.
 	var description : String:
 		get:
 			return "" if not _description is String else String(_description)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for description: %s" % [v])
+				return
+			v = str(v)
+			_description = v
 
 	var _duration
 	## Duration of the tournament in seconds.
 	var duration : int:
 		get:
 			return 0 if not _duration is int else int(_duration)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for duration: %s" % [v])
+				return
+			v = int(v)
+			_duration = v
 
-	# The UNIX time when the tournament stops being active until next reset. A computed value.
 	var _end_active
 	var end_active : int:
 		get:
 			return 0 if not _end_active is int else int(_end_active)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for end_active: %s" % [v])
+				return
+			v = int(v)
+			_end_active = v
 
 	var _end_time
 	## The UNIX time when the tournament will be stopped.
 	var end_time : String:
 		get:
 			return "" if not _end_time is String else String(_end_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for end_time: %s" % [v])
+				return
+			v = str(v)
+			_end_time = v
 
 	var _id
 	## The ID of the tournament.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _max_num_score
 	## The maximum score updates allowed per player for the current tournament.
 	var max_num_score : int:
 		get:
 			return 0 if not _max_num_score is int else int(_max_num_score)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for max_num_score: %s" % [v])
+				return
+			v = int(v)
+			_max_num_score = v
 
 	var _max_size
 	## The maximum number of players for the tournament.
 	var max_size : int:
 		get:
 			return 0 if not _max_size is int else int(_max_size)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for max_size: %s" % [v])
+				return
+			v = int(v)
+			_max_size = v
 
 	var _metadata
 	## Additional information stored as a JSON object.
 	var metadata : String:
 		get:
 			return "" if not _metadata is String else String(_metadata)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for metadata: %s" % [v])
+				return
+			v = str(v)
+			_metadata = v
 
 	var _next_reset
 	## The UNIX time when the tournament is next playable. A computed value.
 	var next_reset : int:
 		get:
 			return 0 if not _next_reset is int else int(_next_reset)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for next_reset: %s" % [v])
+				return
+			v = int(v)
+			_next_reset = v
 
 	var _operator
 	## Operator.
 	var operator : int:
 		get:
 			return ApiOperator.values()[0] if not ApiOperator.values().has(_operator) else _operator
+		set(p_value):
+			if not ApiOperator.values().has(p_value):
+				push_error("Invalid ApiOperator value for operator: %s" % p_value)
+				return
+			_operator = p_value
 
 	var _prev_reset
 	## The UNIX time when the tournament was last reset. A computed value.
 	var prev_reset : int:
 		get:
 			return 0 if not _prev_reset is int else int(_prev_reset)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for prev_reset: %s" % [v])
+				return
+			v = int(v)
+			_prev_reset = v
 
 	var _size
 	## The current number of players in the tournament.
 	var size : int:
 		get:
 			return 0 if not _size is int else int(_size)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for size: %s" % [v])
+				return
+			v = int(v)
+			_size = v
 
 	var _sort_order
 	## ASC (0) or DESC (1) sort mode of scores in the tournament.
 	var sort_order : int:
 		get:
 			return 0 if not _sort_order is int else int(_sort_order)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for sort_order: %s" % [v])
+				return
+			v = int(v)
+			_sort_order = v
 
 	var _start_active
 	## The UNIX time when the tournament start being active. A computed value.
 	var start_active : int:
 		get:
 			return 0 if not _start_active is int else int(_start_active)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for start_active: %s" % [v])
+				return
+			v = int(v)
+			_start_active = v
 
 	var _start_time
 	## The UNIX time when the tournament will start.
 	var start_time : String:
 		get:
 			return "" if not _start_time is String else String(_start_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for start_time: %s" % [v])
+				return
+			v = str(v)
+			_start_time = v
 
 	var _title
 	## The title for the tournament.
 	var title : String:
 		get:
 			return "" if not _title is String else String(_title)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for title: %s" % [v])
+				return
+			v = str(v)
+			_title = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiTournament from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_authoritative = null, # : bool
+		p_can_enter = null, # : bool
+		p_category = null, # : int
+		p_create_time = null, # : String
+		p_description = null, # : String
+		p_duration = null, # : int
+		p_end_active = null, # : int
+		p_end_time = null, # : String
+		p_id = null, # : String
+		p_max_num_score = null, # : int
+		p_max_size = null, # : int
+		p_metadata = null, # : String
+		p_next_reset = null, # : int
+		p_operator = null, # : int
+		p_prev_reset = null, # : int
+		p_size = null, # : int
+		p_sort_order = null, # : int
+		p_start_active = null, # : int
+		p_start_time = null, # : String
+		p_title = null, # : String
+	) -> ApiTournament:
+		var obj := ApiTournament.new()
+		if p_authoritative != null:
+			obj.authoritative = p_authoritative
+		if p_can_enter != null:
+			obj.can_enter = p_can_enter
+		if p_category != null:
+			obj.category = p_category
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_description != null:
+			obj.description = str(p_description) if p_description is int else p_description
+		if p_duration != null:
+			obj.duration = p_duration
+		if p_end_active != null:
+			obj.end_active = p_end_active
+		if p_end_time != null:
+			obj.end_time = str(p_end_time) if p_end_time is int else p_end_time
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_max_num_score != null:
+			obj.max_num_score = p_max_num_score
+		if p_max_size != null:
+			obj.max_size = p_max_size
+		if p_metadata != null:
+			obj.metadata = str(p_metadata) if p_metadata is int else p_metadata
+		if p_next_reset != null:
+			obj.next_reset = p_next_reset
+		if p_operator != null:
+			obj.operator = p_operator
+		if p_prev_reset != null:
+			obj.prev_reset = p_prev_reset
+		if p_size != null:
+			obj.size = p_size
+		if p_sort_order != null:
+			obj.sort_order = p_sort_order
+		if p_start_active != null:
+			obj.start_active = p_start_active
+		if p_start_time != null:
+			obj.start_time = str(p_start_time) if p_start_time is int else p_start_time
+		if p_title != null:
+			obj.title = str(p_title) if p_title is int else p_title
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiTournament:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiTournament", p_dict), ApiTournament) as ApiTournament
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiTournament from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiTournament:
+		var obj := ApiTournament.new()
+		var v : Variant
+		v = p_dict.get("authoritative")
+		if v is bool:
+			obj._authoritative = v
+		v = p_dict.get("can_enter")
+		if v is bool:
+			obj._can_enter = v
+		v = p_dict.get("category")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._category = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("description")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._description = v
+		v = p_dict.get("duration")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._duration = v
+		v = p_dict.get("end_active")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._end_active = v
+		v = p_dict.get("end_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._end_time = v
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("max_num_score")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._max_num_score = v
+		v = p_dict.get("max_size")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._max_size = v
+		v = p_dict.get("metadata")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._metadata = v
+		v = p_dict.get("next_reset")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._next_reset = v
+		v = p_dict.get("operator")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._operator = v
+		v = p_dict.get("prev_reset")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._prev_reset = v
+		v = p_dict.get("size")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._size = v
+		v = p_dict.get("sort_order")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._sort_order = v
+		v = p_dict.get("start_active")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._start_active = v
+		v = p_dict.get("start_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._start_time = v
+		v = p_dict.get("title")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._title = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _authoritative != null:
+			out["authoritative"] = _authoritative
+		if _can_enter != null:
+			out["can_enter"] = _can_enter
+		if _category != null:
+			out["category"] = _category
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _description != null:
+			out["description"] = _description
+		if _duration != null:
+			out["duration"] = _duration
+		if _end_active != null:
+			out["end_active"] = _end_active
+		if _end_time != null:
+			out["end_time"] = _end_time
+		if _id != null:
+			out["id"] = _id
+		if _max_num_score != null:
+			out["max_num_score"] = _max_num_score
+		if _max_size != null:
+			out["max_size"] = _max_size
+		if _metadata != null:
+			out["metadata"] = _metadata
+		if _next_reset != null:
+			out["next_reset"] = _next_reset
+		if _operator != null:
+			out["operator"] = _operator
+		if _prev_reset != null:
+			out["prev_reset"] = _prev_reset
+		if _size != null:
+			out["size"] = _size
+		if _sort_order != null:
+			out["sort_order"] = _sort_order
+		if _start_active != null:
+			out["start_active"] = _start_active
+		if _start_time != null:
+			out["start_time"] = _start_time
+		if _title != null:
+			out["title"] = _title
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiTournament, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiTournament:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiTournament = p_other
+		if typeof(_authoritative) != typeof(other._authoritative):
+			return false
+		if _authoritative != other._authoritative:
+			return false
+		if typeof(_can_enter) != typeof(other._can_enter):
+			return false
+		if _can_enter != other._can_enter:
+			return false
+		if typeof(_category) != typeof(other._category):
+			return false
+		if _category != other._category:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_description) != typeof(other._description):
+			return false
+		if _description != other._description:
+			return false
+		if typeof(_duration) != typeof(other._duration):
+			return false
+		if _duration != other._duration:
+			return false
+		if typeof(_end_active) != typeof(other._end_active):
+			return false
+		if _end_active != other._end_active:
+			return false
+		if typeof(_end_time) != typeof(other._end_time):
+			return false
+		if _end_time != other._end_time:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_max_num_score) != typeof(other._max_num_score):
+			return false
+		if _max_num_score != other._max_num_score:
+			return false
+		if typeof(_max_size) != typeof(other._max_size):
+			return false
+		if _max_size != other._max_size:
+			return false
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_next_reset) != typeof(other._next_reset):
+			return false
+		if _next_reset != other._next_reset:
+			return false
+		if typeof(_operator) != typeof(other._operator):
+			return false
+		if _operator != other._operator:
+			return false
+		if typeof(_prev_reset) != typeof(other._prev_reset):
+			return false
+		if _prev_reset != other._prev_reset:
+			return false
+		if typeof(_size) != typeof(other._size):
+			return false
+		if _size != other._size:
+			return false
+		if typeof(_sort_order) != typeof(other._sort_order):
+			return false
+		if _sort_order != other._sort_order:
+			return false
+		if typeof(_start_active) != typeof(other._start_active):
+			return false
+		if _start_active != other._start_active:
+			return false
+		if typeof(_start_time) != typeof(other._start_time):
+			return false
+		if _start_time != other._start_time:
+			return false
+		if typeof(_title) != typeof(other._title):
+			return false
+		if _title != other._title:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiTournament which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiTournament:
+		var obj := ApiTournament.new(_ex)
+		obj._authoritative = _authoritative
+		obj._can_enter = _can_enter
+		obj._category = _category
+		obj._create_time = _create_time
+		obj._description = _description
+		obj._duration = _duration
+		obj._end_active = _end_active
+		obj._end_time = _end_time
+		obj._id = _id
+		obj._max_num_score = _max_num_score
+		obj._max_size = _max_size
+		obj._metadata = _metadata
+		obj._next_reset = _next_reset
+		obj._operator = _operator
+		obj._prev_reset = _prev_reset
+		obj._size = _size
+		obj._sort_order = _sort_order
+		obj._start_active = _start_active
+		obj._start_time = _start_time
+		obj._title = _title
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_authoritative)
+		values.append(_can_enter)
+		values.append(_category)
+		values.append(_create_time)
+		values.append(_description)
+		values.append(_duration)
+		values.append(_end_active)
+		values.append(_end_time)
+		values.append(_id)
+		values.append(_max_num_score)
+		values.append(_max_size)
+		values.append(_metadata)
+		values.append(_next_reset)
+		values.append(_operator)
+		values.append(_prev_reset)
+		values.append(_size)
+		values.append(_sort_order)
+		values.append(_start_active)
+		values.append(_start_time)
+		values.append(_title)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "authoritative: %s, " % _authoritative
 		output += "can_enter: %s, " % _can_enter
 		output += "category: %s, " % _category
@@ -2532,6 +10422,7 @@ class ApiTournament extends NakamaAsyncResult:
 		output += "start_active: %s, " % _start_active
 		output += "start_time: %s, " % _start_time
 		output += "title: %s, " % _title
+		output += map_string
 		return output
 
 ## A list of tournaments.
@@ -2547,28 +10438,195 @@ class ApiTournamentList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _tournaments
 	## The list of tournaments returned.
 	var tournaments : Array:
 		get:
+			_materialize_tournaments()
 			return Array() if not _tournaments is Array else Array(_tournaments)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiTournament._from_dict(raw))
+				elif e is ApiTournament:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiTournament value for an element of tournaments: %s" % [e])
+					return
+			_tournaments = arr
+			_tournaments_lazy = false
+
+	## True while _tournaments still holds the raw dictionaries received from the server.
+	var _tournaments_lazy := false
+
+	## The number of elements in tournaments, without deserializing them.
+	func get_tournaments_count() -> int:
+		if not _tournaments is Array:
+			return 0
+		var arr : Array = _tournaments
+		return arr.size()
+
+	## Return element p_index of tournaments, deserializing only that element, or null when there is none.
+	func get_tournaments_at(p_index : int) -> ApiTournament:
+		if not _tournaments is Array:
+			return null
+		var arr : Array = _tournaments
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiTournament._from_dict(raw)
+		var e : ApiTournament = arr[p_index]
+		return e
+
+	func _materialize_tournaments() -> void:
+		if not _tournaments_lazy:
+			return
+		for i in get_tournaments_count():
+			get_tournaments_at(i)
+		_tournaments_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiTournamentList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_tournaments = null, # : Array
+	) -> ApiTournamentList:
+		var obj := ApiTournamentList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_tournaments != null:
+			obj.tournaments = p_tournaments
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiTournamentList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiTournamentList", p_dict), ApiTournamentList) as ApiTournamentList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiTournamentList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiTournamentList:
+		var obj := ApiTournamentList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("tournaments")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._tournaments = arr
+			obj._tournaments_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _tournaments is Array:
+			var arr := []
+			for e in _tournaments:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiTournament:
+					var item : ApiTournament = e
+					arr.append(item._to_dict())
+			out["tournaments"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiTournamentList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiTournamentList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiTournamentList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_tournaments) != typeof(other._tournaments):
+			return false
+		if _tournaments != null:
+			if get_tournaments_count() != other.get_tournaments_count():
+				return false
+			for i in get_tournaments_count():
+				var e : ApiTournament = get_tournaments_at(i)
+				if e == null or not e.equals(other.get_tournaments_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiTournamentList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiTournamentList:
+		var obj := ApiTournamentList.new(_ex)
+		obj._cursor = _cursor
+		if _tournaments is Array:
+			var arr := []
+			for e in _tournaments:
+				if e is ApiTournament:
+					var item : ApiTournament = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._tournaments = arr
+			obj._tournaments_lazy = _tournaments_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		if _tournaments is Array:
+			var hashes := []
+			for i in get_tournaments_count():
+				var e : ApiTournament = get_tournaments_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
-		output += "tournaments: %s, " % [_tournaments]
+		output += "tournaments: %s, " % [tournaments]
+		output += map_string
 		return output
 
 ## A set of tournament records which may be part of a tournament records page or a batch of individual records.
@@ -2586,42 +10644,325 @@ class ApiTournamentRecordList extends NakamaAsyncResult:
 	var next_cursor : String:
 		get:
 			return "" if not _next_cursor is String else String(_next_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for next_cursor: %s" % [v])
+				return
+			v = str(v)
+			_next_cursor = v
 
 	var _owner_records
 	## A batched set of tournament records belonging to specified owners.
 	var owner_records : Array:
 		get:
+			_materialize_owner_records()
 			return Array() if not _owner_records is Array else Array(_owner_records)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiLeaderboardRecord._from_dict(raw))
+				elif e is ApiLeaderboardRecord:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiLeaderboardRecord value for an element of owner_records: %s" % [e])
+					return
+			_owner_records = arr
+			_owner_records_lazy = false
+
+	## True while _owner_records still holds the raw dictionaries received from the server.
+	var _owner_records_lazy := false
+
+	## The number of elements in owner_records, without deserializing them.
+	func get_owner_records_count() -> int:
+		if not _owner_records is Array:
+			return 0
+		var arr : Array = _owner_records
+		return arr.size()
+
+	## Return element p_index of owner_records, deserializing only that element, or null when there is none.
+	func get_owner_records_at(p_index : int) -> ApiLeaderboardRecord:
+		if not _owner_records is Array:
+			return null
+		var arr : Array = _owner_records
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiLeaderboardRecord._from_dict(raw)
+		var e : ApiLeaderboardRecord = arr[p_index]
+		return e
+
+	func _materialize_owner_records() -> void:
+		if not _owner_records_lazy:
+			return
+		for i in get_owner_records_count():
+			get_owner_records_at(i)
+		_owner_records_lazy = false
 
 	var _prev_cursor
 	## The cursor to send when retrieving the previous page (optional).
 	var prev_cursor : String:
 		get:
 			return "" if not _prev_cursor is String else String(_prev_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for prev_cursor: %s" % [v])
+				return
+			v = str(v)
+			_prev_cursor = v
 
 	var _records
 	## A list of tournament records.
 	var records : Array:
 		get:
+			_materialize_records()
 			return Array() if not _records is Array else Array(_records)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiLeaderboardRecord._from_dict(raw))
+				elif e is ApiLeaderboardRecord:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiLeaderboardRecord value for an element of records: %s" % [e])
+					return
+			_records = arr
+			_records_lazy = false
+
+	## True while _records still holds the raw dictionaries received from the server.
+	var _records_lazy := false
+
+	## The number of elements in records, without deserializing them.
+	func get_records_count() -> int:
+		if not _records is Array:
+			return 0
+		var arr : Array = _records
+		return arr.size()
+
+	## Return element p_index of records, deserializing only that element, or null when there is none.
+	func get_records_at(p_index : int) -> ApiLeaderboardRecord:
+		if not _records is Array:
+			return null
+		var arr : Array = _records
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiLeaderboardRecord._from_dict(raw)
+		var e : ApiLeaderboardRecord = arr[p_index]
+		return e
+
+	func _materialize_records() -> void:
+		if not _records_lazy:
+			return
+		for i in get_records_count():
+			get_records_at(i)
+		_records_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiTournamentRecordList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_next_cursor = null, # : String
+		p_owner_records = null, # : Array
+		p_prev_cursor = null, # : String
+		p_records = null, # : Array
+	) -> ApiTournamentRecordList:
+		var obj := ApiTournamentRecordList.new()
+		if p_next_cursor != null:
+			obj.next_cursor = str(p_next_cursor) if p_next_cursor is int else p_next_cursor
+		if p_owner_records != null:
+			obj.owner_records = p_owner_records
+		if p_prev_cursor != null:
+			obj.prev_cursor = str(p_prev_cursor) if p_prev_cursor is int else p_prev_cursor
+		if p_records != null:
+			obj.records = p_records
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiTournamentRecordList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiTournamentRecordList", p_dict), ApiTournamentRecordList) as ApiTournamentRecordList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiTournamentRecordList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiTournamentRecordList:
+		var obj := ApiTournamentRecordList.new()
+		var v : Variant
+		v = p_dict.get("next_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._next_cursor = v
+		v = p_dict.get("owner_records")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._owner_records = arr
+			obj._owner_records_lazy = true
+		v = p_dict.get("prev_cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._prev_cursor = v
+		v = p_dict.get("records")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._records = arr
+			obj._records_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _next_cursor != null:
+			out["next_cursor"] = _next_cursor
+		if _owner_records is Array:
+			var arr := []
+			for e in _owner_records:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item._to_dict())
+			out["owner_records"] = arr
+		if _prev_cursor != null:
+			out["prev_cursor"] = _prev_cursor
+		if _records is Array:
+			var arr := []
+			for e in _records:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item._to_dict())
+			out["records"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiTournamentRecordList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiTournamentRecordList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiTournamentRecordList = p_other
+		if typeof(_next_cursor) != typeof(other._next_cursor):
+			return false
+		if _next_cursor != other._next_cursor:
+			return false
+		if typeof(_owner_records) != typeof(other._owner_records):
+			return false
+		if _owner_records != null:
+			if get_owner_records_count() != other.get_owner_records_count():
+				return false
+			for i in get_owner_records_count():
+				var e : ApiLeaderboardRecord = get_owner_records_at(i)
+				if e == null or not e.equals(other.get_owner_records_at(i)):
+					return false
+		if typeof(_prev_cursor) != typeof(other._prev_cursor):
+			return false
+		if _prev_cursor != other._prev_cursor:
+			return false
+		if typeof(_records) != typeof(other._records):
+			return false
+		if _records != null:
+			if get_records_count() != other.get_records_count():
+				return false
+			for i in get_records_count():
+				var e : ApiLeaderboardRecord = get_records_at(i)
+				if e == null or not e.equals(other.get_records_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiTournamentRecordList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiTournamentRecordList:
+		var obj := ApiTournamentRecordList.new(_ex)
+		obj._next_cursor = _next_cursor
+		if _owner_records is Array:
+			var arr := []
+			for e in _owner_records:
+				if e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._owner_records = arr
+			obj._owner_records_lazy = _owner_records_lazy
+		obj._prev_cursor = _prev_cursor
+		if _records is Array:
+			var arr := []
+			for e in _records:
+				if e is ApiLeaderboardRecord:
+					var item : ApiLeaderboardRecord = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._records = arr
+			obj._records_lazy = _records_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_next_cursor)
+		if _owner_records is Array:
+			var hashes := []
+			for i in get_owner_records_count():
+				var e : ApiLeaderboardRecord = get_owner_records_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_prev_cursor)
+		if _records is Array:
+			var hashes := []
+			for i in get_records_count():
+				var e : ApiLeaderboardRecord = get_records_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "next_cursor: %s, " % _next_cursor
-		output += "owner_records: %s, " % [_owner_records]
+		output += "owner_records: %s, " % [owner_records]
 		output += "prev_cursor: %s, " % _prev_cursor
-		output += "records: %s, " % [_records]
+		output += "records: %s, " % [records]
+		output += map_string
 		return output
 
 ## Update a user's account details.
@@ -2641,56 +10982,245 @@ class ApiUpdateAccountRequest extends NakamaAsyncResult:
 	var avatar_url : String:
 		get:
 			return "" if not _avatar_url is String else String(_avatar_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for avatar_url: %s" % [v])
+				return
+			v = str(v)
+			_avatar_url = v
 
 	var _display_name
 	## The display name of the user.
 	var display_name : String:
 		get:
 			return "" if not _display_name is String else String(_display_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for display_name: %s" % [v])
+				return
+			v = str(v)
+			_display_name = v
 
 	var _lang_tag
 	## The language expected to be a tag which follows the BCP-47 spec.
 	var lang_tag : String:
 		get:
 			return "" if not _lang_tag is String else String(_lang_tag)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for lang_tag: %s" % [v])
+				return
+			v = str(v)
+			_lang_tag = v
 
 	var _location
 	## The location set by the user.
 	var location : String:
 		get:
 			return "" if not _location is String else String(_location)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for location: %s" % [v])
+				return
+			v = str(v)
+			_location = v
 
 	var _timezone
 	## The timezone set by the user.
 	var timezone : String:
 		get:
 			return "" if not _timezone is String else String(_timezone)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for timezone: %s" % [v])
+				return
+			v = str(v)
+			_timezone = v
 
 	var _username
 	## The username of the user's account.
 	var username : String:
 		get:
 			return "" if not _username is String else String(_username)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for username: %s" % [v])
+				return
+			v = str(v)
+			_username = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiUpdateAccountRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_avatar_url = null, # : String
+		p_display_name = null, # : String
+		p_lang_tag = null, # : String
+		p_location = null, # : String
+		p_timezone = null, # : String
+		p_username = null, # : String
+	) -> ApiUpdateAccountRequest:
+		var obj := ApiUpdateAccountRequest.new()
+		if p_avatar_url != null:
+			obj.avatar_url = str(p_avatar_url) if p_avatar_url is int else p_avatar_url
+		if p_display_name != null:
+			obj.display_name = str(p_display_name) if p_display_name is int else p_display_name
+		if p_lang_tag != null:
+			obj.lang_tag = str(p_lang_tag) if p_lang_tag is int else p_lang_tag
+		if p_location != null:
+			obj.location = str(p_location) if p_location is int else p_location
+		if p_timezone != null:
+			obj.timezone = str(p_timezone) if p_timezone is int else p_timezone
+		if p_username != null:
+			obj.username = str(p_username) if p_username is int else p_username
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiUpdateAccountRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiUpdateAccountRequest", p_dict), ApiUpdateAccountRequest) as ApiUpdateAccountRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiUpdateAccountRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiUpdateAccountRequest:
+		var obj := ApiUpdateAccountRequest.new()
+		var v : Variant
+		v = p_dict.get("avatar_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._avatar_url = v
+		v = p_dict.get("display_name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._display_name = v
+		v = p_dict.get("lang_tag")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._lang_tag = v
+		v = p_dict.get("location")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._location = v
+		v = p_dict.get("timezone")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._timezone = v
+		v = p_dict.get("username")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._username = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _avatar_url != null:
+			out["avatar_url"] = _avatar_url
+		if _display_name != null:
+			out["display_name"] = _display_name
+		if _lang_tag != null:
+			out["lang_tag"] = _lang_tag
+		if _location != null:
+			out["location"] = _location
+		if _timezone != null:
+			out["timezone"] = _timezone
+		if _username != null:
+			out["username"] = _username
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiUpdateAccountRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiUpdateAccountRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiUpdateAccountRequest = p_other
+		if typeof(_avatar_url) != typeof(other._avatar_url):
+			return false
+		if _avatar_url != other._avatar_url:
+			return false
+		if typeof(_display_name) != typeof(other._display_name):
+			return false
+		if _display_name != other._display_name:
+			return false
+		if typeof(_lang_tag) != typeof(other._lang_tag):
+			return false
+		if _lang_tag != other._lang_tag:
+			return false
+		if typeof(_location) != typeof(other._location):
+			return false
+		if _location != other._location:
+			return false
+		if typeof(_timezone) != typeof(other._timezone):
+			return false
+		if _timezone != other._timezone:
+			return false
+		if typeof(_username) != typeof(other._username):
+			return false
+		if _username != other._username:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiUpdateAccountRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiUpdateAccountRequest:
+		var obj := ApiUpdateAccountRequest.new(_ex)
+		obj._avatar_url = _avatar_url
+		obj._display_name = _display_name
+		obj._lang_tag = _lang_tag
+		obj._location = _location
+		obj._timezone = _timezone
+		obj._username = _username
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_avatar_url)
+		values.append(_display_name)
+		values.append(_lang_tag)
+		values.append(_location)
+		values.append(_timezone)
+		values.append(_username)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "avatar_url: %s, " % _avatar_url
 		output += "display_name: %s, " % _display_name
 		output += "lang_tag: %s, " % _lang_tag
 		output += "location: %s, " % _location
 		output += "timezone: %s, " % _timezone
 		output += "username: %s, " % _username
+		output += map_string
 		return output
 
 ## Update fields in a given group.
@@ -2710,56 +11240,242 @@ class ApiUpdateGroupRequest extends NakamaAsyncResult:
 	var avatar_url : String:
 		get:
 			return "" if not _avatar_url is String else String(_avatar_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for avatar_url: %s" % [v])
+				return
+			v = str(v)
+			_avatar_url = v
 
 	var _description
 	## Description string.
 	var description : String:
 		get:
 			return "" if not _description is String else String(_description)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for description: %s" % [v])
+				return
+			v = str(v)
+			_description = v
 
 	var _group_id
 	## The ID of the group to update.
 	var group_id : String:
 		get:
 			return "" if not _group_id is String else String(_group_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for group_id: %s" % [v])
+				return
+			v = str(v)
+			_group_id = v
 
 	var _lang_tag
 	## Lang tag.
 	var lang_tag : String:
 		get:
 			return "" if not _lang_tag is String else String(_lang_tag)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for lang_tag: %s" % [v])
+				return
+			v = str(v)
+			_lang_tag = v
 
 	var _name
 	## Name.
 	var name : String:
 		get:
 			return "" if not _name is String else String(_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for name: %s" % [v])
+				return
+			v = str(v)
+			_name = v
 
 	var _open
 	## Open is true if anyone should be allowed to join, or false if joins must be approved by a group admin.
 	var open : bool:
 		get:
 			return false if not _open is bool else bool(_open)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for open: %s" % [v])
+				return
+			_open = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiUpdateGroupRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_avatar_url = null, # : String
+		p_description = null, # : String
+		p_group_id = null, # : String
+		p_lang_tag = null, # : String
+		p_name = null, # : String
+		p_open = null, # : bool
+	) -> ApiUpdateGroupRequest:
+		var obj := ApiUpdateGroupRequest.new()
+		if p_avatar_url != null:
+			obj.avatar_url = str(p_avatar_url) if p_avatar_url is int else p_avatar_url
+		if p_description != null:
+			obj.description = str(p_description) if p_description is int else p_description
+		if p_group_id != null:
+			obj.group_id = str(p_group_id) if p_group_id is int else p_group_id
+		if p_lang_tag != null:
+			obj.lang_tag = str(p_lang_tag) if p_lang_tag is int else p_lang_tag
+		if p_name != null:
+			obj.name = str(p_name) if p_name is int else p_name
+		if p_open != null:
+			obj.open = p_open
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiUpdateGroupRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiUpdateGroupRequest", p_dict), ApiUpdateGroupRequest) as ApiUpdateGroupRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiUpdateGroupRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiUpdateGroupRequest:
+		var obj := ApiUpdateGroupRequest.new()
+		var v : Variant
+		v = p_dict.get("avatar_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._avatar_url = v
+		v = p_dict.get("description")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._description = v
+		v = p_dict.get("group_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._group_id = v
+		v = p_dict.get("lang_tag")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._lang_tag = v
+		v = p_dict.get("name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._name = v
+		v = p_dict.get("open")
+		if v is bool:
+			obj._open = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _avatar_url != null:
+			out["avatar_url"] = _avatar_url
+		if _description != null:
+			out["description"] = _description
+		if _group_id != null:
+			out["group_id"] = _group_id
+		if _lang_tag != null:
+			out["lang_tag"] = _lang_tag
+		if _name != null:
+			out["name"] = _name
+		if _open != null:
+			out["open"] = _open
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiUpdateGroupRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiUpdateGroupRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiUpdateGroupRequest = p_other
+		if typeof(_avatar_url) != typeof(other._avatar_url):
+			return false
+		if _avatar_url != other._avatar_url:
+			return false
+		if typeof(_description) != typeof(other._description):
+			return false
+		if _description != other._description:
+			return false
+		if typeof(_group_id) != typeof(other._group_id):
+			return false
+		if _group_id != other._group_id:
+			return false
+		if typeof(_lang_tag) != typeof(other._lang_tag):
+			return false
+		if _lang_tag != other._lang_tag:
+			return false
+		if typeof(_name) != typeof(other._name):
+			return false
+		if _name != other._name:
+			return false
+		if typeof(_open) != typeof(other._open):
+			return false
+		if _open != other._open:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiUpdateGroupRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiUpdateGroupRequest:
+		var obj := ApiUpdateGroupRequest.new(_ex)
+		obj._avatar_url = _avatar_url
+		obj._description = _description
+		obj._group_id = _group_id
+		obj._lang_tag = _lang_tag
+		obj._name = _name
+		obj._open = _open
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_avatar_url)
+		values.append(_description)
+		values.append(_group_id)
+		values.append(_lang_tag)
+		values.append(_name)
+		values.append(_open)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "avatar_url: %s, " % _avatar_url
 		output += "description: %s, " % _description
 		output += "group_id: %s, " % _group_id
 		output += "lang_tag: %s, " % _lang_tag
 		output += "name: %s, " % _name
 		output += "open: %s, " % _open
+		output += map_string
 		return output
 
 ## A user in the server.
@@ -2791,122 +11507,589 @@ class ApiUser extends NakamaAsyncResult:
 	var apple_id : String:
 		get:
 			return "" if not _apple_id is String else String(_apple_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for apple_id: %s" % [v])
+				return
+			v = str(v)
+			_apple_id = v
 
 	var _avatar_url
 	## A URL for an avatar image.
 	var avatar_url : String:
 		get:
 			return "" if not _avatar_url is String else String(_avatar_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for avatar_url: %s" % [v])
+				return
+			v = str(v)
+			_avatar_url = v
 
 	var _create_time
 	## The UNIX time when the user was created.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _display_name
 	## The display name of the user.
 	var display_name : String:
 		get:
 			return "" if not _display_name is String else String(_display_name)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for display_name: %s" % [v])
+				return
+			v = str(v)
+			_display_name = v
 
 	var _edge_count
 	## Number of related edges to this user.
 	var edge_count : int:
 		get:
 			return 0 if not _edge_count is int else int(_edge_count)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for edge_count: %s" % [v])
+				return
+			v = int(v)
+			_edge_count = v
 
 	var _facebook_id
 	## The Facebook id in the user's account.
 	var facebook_id : String:
 		get:
 			return "" if not _facebook_id is String else String(_facebook_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for facebook_id: %s" % [v])
+				return
+			v = str(v)
+			_facebook_id = v
 
 	var _facebook_instant_game_id
 	## The Facebook Instant Game ID in the user's account.
 	var facebook_instant_game_id : String:
 		get:
 			return "" if not _facebook_instant_game_id is String else String(_facebook_instant_game_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for facebook_instant_game_id: %s" % [v])
+				return
+			v = str(v)
+			_facebook_instant_game_id = v
 
 	var _gamecenter_id
 	## The Apple Game Center in of the user's account.
 	var gamecenter_id : String:
 		get:
 			return "" if not _gamecenter_id is String else String(_gamecenter_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for gamecenter_id: %s" % [v])
+				return
+			v = str(v)
+			_gamecenter_id = v
 
 	var _google_id
 	## The Google id in the user's account.
 	var google_id : String:
 		get:
 			return "" if not _google_id is String else String(_google_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for google_id: %s" % [v])
+				return
+			v = str(v)
+			_google_id = v
 
 	var _id
 	## The id of the user's account.
 	var id : String:
 		get:
 			return "" if not _id is String else String(_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for id: %s" % [v])
+				return
+			v = str(v)
+			_id = v
 
 	var _lang_tag
 	## The language expected to be a tag which follows the BCP-47 spec.
 	var lang_tag : String:
 		get:
 			return "" if not _lang_tag is String else String(_lang_tag)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for lang_tag: %s" % [v])
+				return
+			v = str(v)
+			_lang_tag = v
 
 	var _location
 	## The location set by the user.
 	var location : String:
 		get:
 			return "" if not _location is String else String(_location)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for location: %s" % [v])
+				return
+			v = str(v)
+			_location = v
 
 	var _metadata
 	## Additional information stored as a JSON object.
 	var metadata : String:
 		get:
 			return "" if not _metadata is String else String(_metadata)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for metadata: %s" % [v])
+				return
+			v = str(v)
+			_metadata = v
 
 	var _online
 	## Indicates whether the user is currently online.
 	var online : bool:
 		get:
 			return false if not _online is bool else bool(_online)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for online: %s" % [v])
+				return
+			_online = v
 
 	var _steam_id
 	## The Steam id in the user's account.
 	var steam_id : String:
 		get:
 			return "" if not _steam_id is String else String(_steam_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for steam_id: %s" % [v])
+				return
+			v = str(v)
+			_steam_id = v
 
 	var _timezone
 	## The timezone set by the user.
 	var timezone : String:
 		get:
 			return "" if not _timezone is String else String(_timezone)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for timezone: %s" % [v])
+				return
+			v = str(v)
+			_timezone = v
 
 	var _update_time
 	## The UNIX time when the user was last updated.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _username
 	## The username of the user's account.
 	var username : String:
 		get:
 			return "" if not _username is String else String(_username)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for username: %s" % [v])
+				return
+			v = str(v)
+			_username = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiUser from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_apple_id = null, # : String
+		p_avatar_url = null, # : String
+		p_create_time = null, # : String
+		p_display_name = null, # : String
+		p_edge_count = null, # : int
+		p_facebook_id = null, # : String
+		p_facebook_instant_game_id = null, # : String
+		p_gamecenter_id = null, # : String
+		p_google_id = null, # : String
+		p_id = null, # : String
+		p_lang_tag = null, # : String
+		p_location = null, # : String
+		p_metadata = null, # : String
+		p_online = null, # : bool
+		p_steam_id = null, # : String
+		p_timezone = null, # : String
+		p_update_time = null, # : String
+		p_username = null, # : String
+	) -> ApiUser:
+		var obj := ApiUser.new()
+		if p_apple_id != null:
+			obj.apple_id = str(p_apple_id) if p_apple_id is int else p_apple_id
+		if p_avatar_url != null:
+			obj.avatar_url = str(p_avatar_url) if p_avatar_url is int else p_avatar_url
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_display_name != null:
+			obj.display_name = str(p_display_name) if p_display_name is int else p_display_name
+		if p_edge_count != null:
+			obj.edge_count = p_edge_count
+		if p_facebook_id != null:
+			obj.facebook_id = str(p_facebook_id) if p_facebook_id is int else p_facebook_id
+		if p_facebook_instant_game_id != null:
+			obj.facebook_instant_game_id = str(p_facebook_instant_game_id) if p_facebook_instant_game_id is int else p_facebook_instant_game_id
+		if p_gamecenter_id != null:
+			obj.gamecenter_id = str(p_gamecenter_id) if p_gamecenter_id is int else p_gamecenter_id
+		if p_google_id != null:
+			obj.google_id = str(p_google_id) if p_google_id is int else p_google_id
+		if p_id != null:
+			obj.id = str(p_id) if p_id is int else p_id
+		if p_lang_tag != null:
+			obj.lang_tag = str(p_lang_tag) if p_lang_tag is int else p_lang_tag
+		if p_location != null:
+			obj.location = str(p_location) if p_location is int else p_location
+		if p_metadata != null:
+			obj.metadata = str(p_metadata) if p_metadata is int else p_metadata
+		if p_online != null:
+			obj.online = p_online
+		if p_steam_id != null:
+			obj.steam_id = str(p_steam_id) if p_steam_id is int else p_steam_id
+		if p_timezone != null:
+			obj.timezone = str(p_timezone) if p_timezone is int else p_timezone
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_username != null:
+			obj.username = str(p_username) if p_username is int else p_username
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiUser:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiUser", p_dict), ApiUser) as ApiUser
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiUser from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiUser:
+		var obj := ApiUser.new()
+		var v : Variant
+		v = p_dict.get("apple_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._apple_id = v
+		v = p_dict.get("avatar_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._avatar_url = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("display_name")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._display_name = v
+		v = p_dict.get("edge_count")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._edge_count = v
+		v = p_dict.get("facebook_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._facebook_id = v
+		v = p_dict.get("facebook_instant_game_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._facebook_instant_game_id = v
+		v = p_dict.get("gamecenter_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._gamecenter_id = v
+		v = p_dict.get("google_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._google_id = v
+		v = p_dict.get("id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._id = v
+		v = p_dict.get("lang_tag")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._lang_tag = v
+		v = p_dict.get("location")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._location = v
+		v = p_dict.get("metadata")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._metadata = v
+		v = p_dict.get("online")
+		if v is bool:
+			obj._online = v
+		v = p_dict.get("steam_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._steam_id = v
+		v = p_dict.get("timezone")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._timezone = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("username")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._username = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _apple_id != null:
+			out["apple_id"] = _apple_id
+		if _avatar_url != null:
+			out["avatar_url"] = _avatar_url
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _display_name != null:
+			out["display_name"] = _display_name
+		if _edge_count != null:
+			out["edge_count"] = _edge_count
+		if _facebook_id != null:
+			out["facebook_id"] = _facebook_id
+		if _facebook_instant_game_id != null:
+			out["facebook_instant_game_id"] = _facebook_instant_game_id
+		if _gamecenter_id != null:
+			out["gamecenter_id"] = _gamecenter_id
+		if _google_id != null:
+			out["google_id"] = _google_id
+		if _id != null:
+			out["id"] = _id
+		if _lang_tag != null:
+			out["lang_tag"] = _lang_tag
+		if _location != null:
+			out["location"] = _location
+		if _metadata != null:
+			out["metadata"] = _metadata
+		if _online != null:
+			out["online"] = _online
+		if _steam_id != null:
+			out["steam_id"] = _steam_id
+		if _timezone != null:
+			out["timezone"] = _timezone
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _username != null:
+			out["username"] = _username
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiUser, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiUser:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiUser = p_other
+		if typeof(_apple_id) != typeof(other._apple_id):
+			return false
+		if _apple_id != other._apple_id:
+			return false
+		if typeof(_avatar_url) != typeof(other._avatar_url):
+			return false
+		if _avatar_url != other._avatar_url:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_display_name) != typeof(other._display_name):
+			return false
+		if _display_name != other._display_name:
+			return false
+		if typeof(_edge_count) != typeof(other._edge_count):
+			return false
+		if _edge_count != other._edge_count:
+			return false
+		if typeof(_facebook_id) != typeof(other._facebook_id):
+			return false
+		if _facebook_id != other._facebook_id:
+			return false
+		if typeof(_facebook_instant_game_id) != typeof(other._facebook_instant_game_id):
+			return false
+		if _facebook_instant_game_id != other._facebook_instant_game_id:
+			return false
+		if typeof(_gamecenter_id) != typeof(other._gamecenter_id):
+			return false
+		if _gamecenter_id != other._gamecenter_id:
+			return false
+		if typeof(_google_id) != typeof(other._google_id):
+			return false
+		if _google_id != other._google_id:
+			return false
+		if typeof(_id) != typeof(other._id):
+			return false
+		if _id != other._id:
+			return false
+		if typeof(_lang_tag) != typeof(other._lang_tag):
+			return false
+		if _lang_tag != other._lang_tag:
+			return false
+		if typeof(_location) != typeof(other._location):
+			return false
+		if _location != other._location:
+			return false
+		if typeof(_metadata) != typeof(other._metadata):
+			return false
+		if _metadata != other._metadata:
+			return false
+		if typeof(_online) != typeof(other._online):
+			return false
+		if _online != other._online:
+			return false
+		if typeof(_steam_id) != typeof(other._steam_id):
+			return false
+		if _steam_id != other._steam_id:
+			return false
+		if typeof(_timezone) != typeof(other._timezone):
+			return false
+		if _timezone != other._timezone:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_username) != typeof(other._username):
+			return false
+		if _username != other._username:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiUser which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiUser:
+		var obj := ApiUser.new(_ex)
+		obj._apple_id = _apple_id
+		obj._avatar_url = _avatar_url
+		obj._create_time = _create_time
+		obj._display_name = _display_name
+		obj._edge_count = _edge_count
+		obj._facebook_id = _facebook_id
+		obj._facebook_instant_game_id = _facebook_instant_game_id
+		obj._gamecenter_id = _gamecenter_id
+		obj._google_id = _google_id
+		obj._id = _id
+		obj._lang_tag = _lang_tag
+		obj._location = _location
+		obj._metadata = _metadata
+		obj._online = _online
+		obj._steam_id = _steam_id
+		obj._timezone = _timezone
+		obj._update_time = _update_time
+		obj._username = _username
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_apple_id)
+		values.append(_avatar_url)
+		values.append(_create_time)
+		values.append(_display_name)
+		values.append(_edge_count)
+		values.append(_facebook_id)
+		values.append(_facebook_instant_game_id)
+		values.append(_gamecenter_id)
+		values.append(_google_id)
+		values.append(_id)
+		values.append(_lang_tag)
+		values.append(_location)
+		values.append(_metadata)
+		values.append(_online)
+		values.append(_steam_id)
+		values.append(_timezone)
+		values.append(_update_time)
+		values.append(_username)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "apple_id: %s, " % _apple_id
 		output += "avatar_url: %s, " % _avatar_url
 		output += "create_time: %s, " % _create_time
@@ -2925,6 +12108,7 @@ class ApiUser extends NakamaAsyncResult:
 		output += "timezone: %s, " % _timezone
 		output += "update_time: %s, " % _update_time
 		output += "username: %s, " % _username
+		output += map_string
 		return output
 
 ## A list of groups belonging to a user, along with the user's role in each group.
@@ -2940,28 +12124,195 @@ class ApiUserGroupList extends NakamaAsyncResult:
 	var cursor : String:
 		get:
 			return "" if not _cursor is String else String(_cursor)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for cursor: %s" % [v])
+				return
+			v = str(v)
+			_cursor = v
 
 	var _user_groups
 	## Group-role pairs for a user.
 	var user_groups : Array:
 		get:
+			_materialize_user_groups()
 			return Array() if not _user_groups is Array else Array(_user_groups)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(UserGroupListUserGroup._from_dict(raw))
+				elif e is UserGroupListUserGroup:
+					arr.append(e)
+				else:
+					push_error("Invalid UserGroupListUserGroup value for an element of user_groups: %s" % [e])
+					return
+			_user_groups = arr
+			_user_groups_lazy = false
+
+	## True while _user_groups still holds the raw dictionaries received from the server.
+	var _user_groups_lazy := false
+
+	## The number of elements in user_groups, without deserializing them.
+	func get_user_groups_count() -> int:
+		if not _user_groups is Array:
+			return 0
+		var arr : Array = _user_groups
+		return arr.size()
+
+	## Return element p_index of user_groups, deserializing only that element, or null when there is none.
+	func get_user_groups_at(p_index : int) -> UserGroupListUserGroup:
+		if not _user_groups is Array:
+			return null
+		var arr : Array = _user_groups
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = UserGroupListUserGroup._from_dict(raw)
+		var e : UserGroupListUserGroup = arr[p_index]
+		return e
+
+	func _materialize_user_groups() -> void:
+		if not _user_groups_lazy:
+			return
+		for i in get_user_groups_count():
+			get_user_groups_at(i)
+		_user_groups_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiUserGroupList from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_cursor = null, # : String
+		p_user_groups = null, # : Array
+	) -> ApiUserGroupList:
+		var obj := ApiUserGroupList.new()
+		if p_cursor != null:
+			obj.cursor = str(p_cursor) if p_cursor is int else p_cursor
+		if p_user_groups != null:
+			obj.user_groups = p_user_groups
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiUserGroupList:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiUserGroupList", p_dict), ApiUserGroupList) as ApiUserGroupList
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiUserGroupList from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiUserGroupList:
+		var obj := ApiUserGroupList.new()
+		var v : Variant
+		v = p_dict.get("cursor")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._cursor = v
+		v = p_dict.get("user_groups")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._user_groups = arr
+			obj._user_groups_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _cursor != null:
+			out["cursor"] = _cursor
+		if _user_groups is Array:
+			var arr := []
+			for e in _user_groups:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is UserGroupListUserGroup:
+					var item : UserGroupListUserGroup = e
+					arr.append(item._to_dict())
+			out["user_groups"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiUserGroupList, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiUserGroupList:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiUserGroupList = p_other
+		if typeof(_cursor) != typeof(other._cursor):
+			return false
+		if _cursor != other._cursor:
+			return false
+		if typeof(_user_groups) != typeof(other._user_groups):
+			return false
+		if _user_groups != null:
+			if get_user_groups_count() != other.get_user_groups_count():
+				return false
+			for i in get_user_groups_count():
+				var e : UserGroupListUserGroup = get_user_groups_at(i)
+				if e == null or not e.equals(other.get_user_groups_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiUserGroupList which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiUserGroupList:
+		var obj := ApiUserGroupList.new(_ex)
+		obj._cursor = _cursor
+		if _user_groups is Array:
+			var arr := []
+			for e in _user_groups:
+				if e is UserGroupListUserGroup:
+					var item : UserGroupListUserGroup = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._user_groups = arr
+			obj._user_groups_lazy = _user_groups_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_cursor)
+		if _user_groups is Array:
+			var hashes := []
+			for i in get_user_groups_count():
+				var e : UserGroupListUserGroup = get_user_groups_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "cursor: %s, " % _cursor
-		output += "user_groups: %s, " % [_user_groups]
+		output += "user_groups: %s, " % [user_groups]
+		output += map_string
 		return output
 
 ## A collection of zero or more users.
@@ -2975,22 +12326,166 @@ class ApiUsers extends NakamaAsyncResult:
 	## The User objects.
 	var users : Array:
 		get:
+			_materialize_users()
 			return Array() if not _users is Array else Array(_users)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiUser._from_dict(raw))
+				elif e is ApiUser:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiUser value for an element of users: %s" % [e])
+					return
+			_users = arr
+			_users_lazy = false
+
+	## True while _users still holds the raw dictionaries received from the server.
+	var _users_lazy := false
+
+	## The number of elements in users, without deserializing them.
+	func get_users_count() -> int:
+		if not _users is Array:
+			return 0
+		var arr : Array = _users
+		return arr.size()
+
+	## Return element p_index of users, deserializing only that element, or null when there is none.
+	func get_users_at(p_index : int) -> ApiUser:
+		if not _users is Array:
+			return null
+		var arr : Array = _users
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiUser._from_dict(raw)
+		var e : ApiUser = arr[p_index]
+		return e
+
+	func _materialize_users() -> void:
+		if not _users_lazy:
+			return
+		for i in get_users_count():
+			get_users_at(i)
+		_users_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiUsers from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_users = null, # : Array
+	) -> ApiUsers:
+		var obj := ApiUsers.new()
+		if p_users != null:
+			obj.users = p_users
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiUsers:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiUsers", p_dict), ApiUsers) as ApiUsers
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiUsers from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiUsers:
+		var obj := ApiUsers.new()
+		var v : Variant
+		v = p_dict.get("users")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._users = arr
+			obj._users_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _users is Array:
+			var arr := []
+			for e in _users:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiUser:
+					var item : ApiUser = e
+					arr.append(item._to_dict())
+			out["users"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiUsers, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiUsers:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiUsers = p_other
+		if typeof(_users) != typeof(other._users):
+			return false
+		if _users != null:
+			if get_users_count() != other.get_users_count():
+				return false
+			for i in get_users_count():
+				var e : ApiUser = get_users_at(i)
+				if e == null or not e.equals(other.get_users_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiUsers which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiUsers:
+		var obj := ApiUsers.new(_ex)
+		if _users is Array:
+			var arr := []
+			for e in _users:
+				if e is ApiUser:
+					var item : ApiUser = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._users = arr
+			obj._users_lazy = _users_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _users is Array:
+			var hashes := []
+			for i in get_users_count():
+				var e : ApiUser = get_users_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "users: %s, " % [_users]
+		var map_string : String = ""
+		output += "users: %s, " % [users]
+		output += map_string
 		return output
 
 class ApiValidatePurchaseAppleRequest extends NakamaAsyncResult:
@@ -3000,33 +12495,126 @@ class ApiValidatePurchaseAppleRequest extends NakamaAsyncResult:
 		"receipt": {"name": "_receipt", "type": TYPE_STRING, "required": false},
 	}
 
-	#
 	var _persist
 	var persist : bool:
 		get:
 			return false if not _persist is bool else bool(_persist)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persist: %s" % [v])
+				return
+			_persist = v
 
 	var _receipt
 	## Base64 encoded Apple receipt data payload.
 	var receipt : String:
 		get:
 			return "" if not _receipt is String else String(_receipt)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for receipt: %s" % [v])
+				return
+			v = str(v)
+			_receipt = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidatePurchaseAppleRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_persist = null, # : bool
+		p_receipt = null, # : String
+	) -> ApiValidatePurchaseAppleRequest:
+		var obj := ApiValidatePurchaseAppleRequest.new()
+		if p_persist != null:
+			obj.persist = p_persist
+		if p_receipt != null:
+			obj.receipt = str(p_receipt) if p_receipt is int else p_receipt
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidatePurchaseAppleRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidatePurchaseAppleRequest", p_dict), ApiValidatePurchaseAppleRequest) as ApiValidatePurchaseAppleRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidatePurchaseAppleRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidatePurchaseAppleRequest:
+		var obj := ApiValidatePurchaseAppleRequest.new()
+		var v : Variant
+		v = p_dict.get("persist")
+		if v is bool:
+			obj._persist = v
+		v = p_dict.get("receipt")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._receipt = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _persist != null:
+			out["persist"] = _persist
+		if _receipt != null:
+			out["receipt"] = _receipt
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidatePurchaseAppleRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidatePurchaseAppleRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidatePurchaseAppleRequest = p_other
+		if typeof(_persist) != typeof(other._persist):
+			return false
+		if _persist != other._persist:
+			return false
+		if typeof(_receipt) != typeof(other._receipt):
+			return false
+		if _receipt != other._receipt:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidatePurchaseAppleRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidatePurchaseAppleRequest:
+		var obj := ApiValidatePurchaseAppleRequest.new(_ex)
+		obj._persist = _persist
+		obj._receipt = _receipt
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_persist)
+		values.append(_receipt)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "persist: %s, " % _persist
 		output += "receipt: %s, " % _receipt
+		output += map_string
 		return output
 
 class ApiValidatePurchaseGoogleRequest extends NakamaAsyncResult:
@@ -3040,28 +12628,122 @@ class ApiValidatePurchaseGoogleRequest extends NakamaAsyncResult:
 	var persist : bool:
 		get:
 			return false if not _persist is bool else bool(_persist)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persist: %s" % [v])
+				return
+			_persist = v
 
 	var _purchase
 	## JSON encoded Google purchase payload.
 	var purchase : String:
 		get:
 			return "" if not _purchase is String else String(_purchase)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for purchase: %s" % [v])
+				return
+			v = str(v)
+			_purchase = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidatePurchaseGoogleRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_persist = null, # : bool
+		p_purchase = null, # : String
+	) -> ApiValidatePurchaseGoogleRequest:
+		var obj := ApiValidatePurchaseGoogleRequest.new()
+		if p_persist != null:
+			obj.persist = p_persist
+		if p_purchase != null:
+			obj.purchase = str(p_purchase) if p_purchase is int else p_purchase
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidatePurchaseGoogleRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidatePurchaseGoogleRequest", p_dict), ApiValidatePurchaseGoogleRequest) as ApiValidatePurchaseGoogleRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidatePurchaseGoogleRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidatePurchaseGoogleRequest:
+		var obj := ApiValidatePurchaseGoogleRequest.new()
+		var v : Variant
+		v = p_dict.get("persist")
+		if v is bool:
+			obj._persist = v
+		v = p_dict.get("purchase")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._purchase = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _persist != null:
+			out["persist"] = _persist
+		if _purchase != null:
+			out["purchase"] = _purchase
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidatePurchaseGoogleRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidatePurchaseGoogleRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidatePurchaseGoogleRequest = p_other
+		if typeof(_persist) != typeof(other._persist):
+			return false
+		if _persist != other._persist:
+			return false
+		if typeof(_purchase) != typeof(other._purchase):
+			return false
+		if _purchase != other._purchase:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidatePurchaseGoogleRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidatePurchaseGoogleRequest:
+		var obj := ApiValidatePurchaseGoogleRequest.new(_ex)
+		obj._persist = _persist
+		obj._purchase = _purchase
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_persist)
+		values.append(_purchase)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "persist: %s, " % _persist
 		output += "purchase: %s, " % _purchase
+		output += map_string
 		return output
 
 class ApiValidatePurchaseHuaweiRequest extends NakamaAsyncResult:
@@ -3076,35 +12758,151 @@ class ApiValidatePurchaseHuaweiRequest extends NakamaAsyncResult:
 	var persist : bool:
 		get:
 			return false if not _persist is bool else bool(_persist)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persist: %s" % [v])
+				return
+			_persist = v
 
 	var _purchase
 	## JSON encoded Huawei InAppPurchaseData.
 	var purchase : String:
 		get:
 			return "" if not _purchase is String else String(_purchase)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for purchase: %s" % [v])
+				return
+			v = str(v)
+			_purchase = v
 
-	# InAppPurchaseData signature.
 	var _signature
 	var signature : String:
 		get:
 			return "" if not _signature is String else String(_signature)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for signature: %s" % [v])
+				return
+			v = str(v)
+			_signature = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidatePurchaseHuaweiRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_persist = null, # : bool
+		p_purchase = null, # : String
+		p_signature = null, # : String
+	) -> ApiValidatePurchaseHuaweiRequest:
+		var obj := ApiValidatePurchaseHuaweiRequest.new()
+		if p_persist != null:
+			obj.persist = p_persist
+		if p_purchase != null:
+			obj.purchase = str(p_purchase) if p_purchase is int else p_purchase
+		if p_signature != null:
+			obj.signature = str(p_signature) if p_signature is int else p_signature
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidatePurchaseHuaweiRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidatePurchaseHuaweiRequest", p_dict), ApiValidatePurchaseHuaweiRequest) as ApiValidatePurchaseHuaweiRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidatePurchaseHuaweiRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidatePurchaseHuaweiRequest:
+		var obj := ApiValidatePurchaseHuaweiRequest.new()
+		var v : Variant
+		v = p_dict.get("persist")
+		if v is bool:
+			obj._persist = v
+		v = p_dict.get("purchase")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._purchase = v
+		v = p_dict.get("signature")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._signature = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _persist != null:
+			out["persist"] = _persist
+		if _purchase != null:
+			out["purchase"] = _purchase
+		if _signature != null:
+			out["signature"] = _signature
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidatePurchaseHuaweiRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidatePurchaseHuaweiRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidatePurchaseHuaweiRequest = p_other
+		if typeof(_persist) != typeof(other._persist):
+			return false
+		if _persist != other._persist:
+			return false
+		if typeof(_purchase) != typeof(other._purchase):
+			return false
+		if _purchase != other._purchase:
+			return false
+		if typeof(_signature) != typeof(other._signature):
+			return false
+		if _signature != other._signature:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidatePurchaseHuaweiRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidatePurchaseHuaweiRequest:
+		var obj := ApiValidatePurchaseHuaweiRequest.new(_ex)
+		obj._persist = _persist
+		obj._purchase = _purchase
+		obj._signature = _signature
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_persist)
+		values.append(_purchase)
+		values.append(_signature)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "persist: %s, " % _persist
 		output += "purchase: %s, " % _purchase
 		output += "signature: %s, " % _signature
+		output += map_string
 		return output
 
 ## Validate IAP response.
@@ -3118,22 +12916,166 @@ class ApiValidatePurchaseResponse extends NakamaAsyncResult:
 	## Newly seen validated purchases.
 	var validated_purchases : Array:
 		get:
+			_materialize_validated_purchases()
 			return Array() if not _validated_purchases is Array else Array(_validated_purchases)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiValidatedPurchase._from_dict(raw))
+				elif e is ApiValidatedPurchase:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiValidatedPurchase value for an element of validated_purchases: %s" % [e])
+					return
+			_validated_purchases = arr
+			_validated_purchases_lazy = false
+
+	## True while _validated_purchases still holds the raw dictionaries received from the server.
+	var _validated_purchases_lazy := false
+
+	## The number of elements in validated_purchases, without deserializing them.
+	func get_validated_purchases_count() -> int:
+		if not _validated_purchases is Array:
+			return 0
+		var arr : Array = _validated_purchases
+		return arr.size()
+
+	## Return element p_index of validated_purchases, deserializing only that element, or null when there is none.
+	func get_validated_purchases_at(p_index : int) -> ApiValidatedPurchase:
+		if not _validated_purchases is Array:
+			return null
+		var arr : Array = _validated_purchases
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiValidatedPurchase._from_dict(raw)
+		var e : ApiValidatedPurchase = arr[p_index]
+		return e
+
+	func _materialize_validated_purchases() -> void:
+		if not _validated_purchases_lazy:
+			return
+		for i in get_validated_purchases_count():
+			get_validated_purchases_at(i)
+		_validated_purchases_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidatePurchaseResponse from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_validated_purchases = null, # : Array
+	) -> ApiValidatePurchaseResponse:
+		var obj := ApiValidatePurchaseResponse.new()
+		if p_validated_purchases != null:
+			obj.validated_purchases = p_validated_purchases
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidatePurchaseResponse:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidatePurchaseResponse", p_dict), ApiValidatePurchaseResponse) as ApiValidatePurchaseResponse
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidatePurchaseResponse from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidatePurchaseResponse:
+		var obj := ApiValidatePurchaseResponse.new()
+		var v : Variant
+		v = p_dict.get("validated_purchases")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._validated_purchases = arr
+			obj._validated_purchases_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _validated_purchases is Array:
+			var arr := []
+			for e in _validated_purchases:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiValidatedPurchase:
+					var item : ApiValidatedPurchase = e
+					arr.append(item._to_dict())
+			out["validated_purchases"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidatePurchaseResponse, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidatePurchaseResponse:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidatePurchaseResponse = p_other
+		if typeof(_validated_purchases) != typeof(other._validated_purchases):
+			return false
+		if _validated_purchases != null:
+			if get_validated_purchases_count() != other.get_validated_purchases_count():
+				return false
+			for i in get_validated_purchases_count():
+				var e : ApiValidatedPurchase = get_validated_purchases_at(i)
+				if e == null or not e.equals(other.get_validated_purchases_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidatePurchaseResponse which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidatePurchaseResponse:
+		var obj := ApiValidatePurchaseResponse.new(_ex)
+		if _validated_purchases is Array:
+			var arr := []
+			for e in _validated_purchases:
+				if e is ApiValidatedPurchase:
+					var item : ApiValidatedPurchase = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._validated_purchases = arr
+			obj._validated_purchases_lazy = _validated_purchases_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _validated_purchases is Array:
+			var hashes := []
+			for i in get_validated_purchases_count():
+				var e : ApiValidatedPurchase = get_validated_purchases_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "validated_purchases: %s, " % [_validated_purchases]
+		var map_string : String = ""
+		output += "validated_purchases: %s, " % [validated_purchases]
+		output += map_string
 		return output
 
 class ApiValidateSubscriptionAppleRequest extends NakamaAsyncResult:
@@ -3148,28 +13090,122 @@ class ApiValidateSubscriptionAppleRequest extends NakamaAsyncResult:
 	var persist : bool:
 		get:
 			return false if not _persist is bool else bool(_persist)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persist: %s" % [v])
+				return
+			_persist = v
 
 	var _receipt
 	## Base64 encoded Apple receipt data payload.
 	var receipt : String:
 		get:
 			return "" if not _receipt is String else String(_receipt)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for receipt: %s" % [v])
+				return
+			v = str(v)
+			_receipt = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidateSubscriptionAppleRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_persist = null, # : bool
+		p_receipt = null, # : String
+	) -> ApiValidateSubscriptionAppleRequest:
+		var obj := ApiValidateSubscriptionAppleRequest.new()
+		if p_persist != null:
+			obj.persist = p_persist
+		if p_receipt != null:
+			obj.receipt = str(p_receipt) if p_receipt is int else p_receipt
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidateSubscriptionAppleRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidateSubscriptionAppleRequest", p_dict), ApiValidateSubscriptionAppleRequest) as ApiValidateSubscriptionAppleRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidateSubscriptionAppleRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidateSubscriptionAppleRequest:
+		var obj := ApiValidateSubscriptionAppleRequest.new()
+		var v : Variant
+		v = p_dict.get("persist")
+		if v is bool:
+			obj._persist = v
+		v = p_dict.get("receipt")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._receipt = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _persist != null:
+			out["persist"] = _persist
+		if _receipt != null:
+			out["receipt"] = _receipt
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidateSubscriptionAppleRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidateSubscriptionAppleRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidateSubscriptionAppleRequest = p_other
+		if typeof(_persist) != typeof(other._persist):
+			return false
+		if _persist != other._persist:
+			return false
+		if typeof(_receipt) != typeof(other._receipt):
+			return false
+		if _receipt != other._receipt:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidateSubscriptionAppleRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidateSubscriptionAppleRequest:
+		var obj := ApiValidateSubscriptionAppleRequest.new(_ex)
+		obj._persist = _persist
+		obj._receipt = _receipt
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_persist)
+		values.append(_receipt)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "persist: %s, " % _persist
 		output += "receipt: %s, " % _receipt
+		output += map_string
 		return output
 
 class ApiValidateSubscriptionGoogleRequest extends NakamaAsyncResult:
@@ -3184,28 +13220,122 @@ class ApiValidateSubscriptionGoogleRequest extends NakamaAsyncResult:
 	var persist : bool:
 		get:
 			return false if not _persist is bool else bool(_persist)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for persist: %s" % [v])
+				return
+			_persist = v
 
 	var _receipt
 	## JSON encoded Google purchase payload.
 	var receipt : String:
 		get:
 			return "" if not _receipt is String else String(_receipt)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for receipt: %s" % [v])
+				return
+			v = str(v)
+			_receipt = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidateSubscriptionGoogleRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_persist = null, # : bool
+		p_receipt = null, # : String
+	) -> ApiValidateSubscriptionGoogleRequest:
+		var obj := ApiValidateSubscriptionGoogleRequest.new()
+		if p_persist != null:
+			obj.persist = p_persist
+		if p_receipt != null:
+			obj.receipt = str(p_receipt) if p_receipt is int else p_receipt
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidateSubscriptionGoogleRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidateSubscriptionGoogleRequest", p_dict), ApiValidateSubscriptionGoogleRequest) as ApiValidateSubscriptionGoogleRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidateSubscriptionGoogleRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidateSubscriptionGoogleRequest:
+		var obj := ApiValidateSubscriptionGoogleRequest.new()
+		var v : Variant
+		v = p_dict.get("persist")
+		if v is bool:
+			obj._persist = v
+		v = p_dict.get("receipt")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._receipt = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _persist != null:
+			out["persist"] = _persist
+		if _receipt != null:
+			out["receipt"] = _receipt
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidateSubscriptionGoogleRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidateSubscriptionGoogleRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidateSubscriptionGoogleRequest = p_other
+		if typeof(_persist) != typeof(other._persist):
+			return false
+		if _persist != other._persist:
+			return false
+		if typeof(_receipt) != typeof(other._receipt):
+			return false
+		if _receipt != other._receipt:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidateSubscriptionGoogleRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidateSubscriptionGoogleRequest:
+		var obj := ApiValidateSubscriptionGoogleRequest.new(_ex)
+		obj._persist = _persist
+		obj._receipt = _receipt
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_persist)
+		values.append(_receipt)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "persist: %s, " % _persist
 		output += "receipt: %s, " % _receipt
+		output += map_string
 		return output
 
 ## Validate Subscription response.
@@ -3219,21 +13349,90 @@ class ApiValidateSubscriptionResponse extends NakamaAsyncResult:
 	var validated_subscription : ApiValidatedSubscription:
 		get:
 			return _validated_subscription as ApiValidatedSubscription
+		set(p_value):
+			_validated_subscription = p_value
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidateSubscriptionResponse from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_validated_subscription = null, # : ApiValidatedSubscription
+	) -> ApiValidateSubscriptionResponse:
+		var obj := ApiValidateSubscriptionResponse.new()
+		if p_validated_subscription != null:
+			obj.validated_subscription = p_validated_subscription
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidateSubscriptionResponse:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidateSubscriptionResponse", p_dict), ApiValidateSubscriptionResponse) as ApiValidateSubscriptionResponse
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidateSubscriptionResponse from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidateSubscriptionResponse:
+		var obj := ApiValidateSubscriptionResponse.new()
+		var v : Variant
+		v = p_dict.get("validated_subscription")
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj._validated_subscription = ApiValidatedSubscription._from_dict(raw)
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _validated_subscription is Object:
+			out["validated_subscription"] = _validated_subscription._to_dict()
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidateSubscriptionResponse, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidateSubscriptionResponse:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidateSubscriptionResponse = p_other
+		if typeof(_validated_subscription) != typeof(other._validated_subscription):
+			return false
+		if _validated_subscription != null and not _validated_subscription.equals(other._validated_subscription):
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidateSubscriptionResponse which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidateSubscriptionResponse:
+		var obj := ApiValidateSubscriptionResponse.new(_ex)
+		if _validated_subscription != null:
+			obj._validated_subscription = _validated_subscription.duplicate_deep()
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_validated_subscription.hash() if _validated_subscription != null else null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "validated_subscription: %s, " % _validated_subscription
+		output += map_string
 		return output
 
 ## Validated Purchase stored by Nakama.
@@ -3258,78 +13457,381 @@ class ApiValidatedPurchase extends NakamaAsyncResult:
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _environment
 	## Whether the purchase was done in production or sandbox environment.
 	var environment : int:
 		get:
 			return ApiStoreEnvironment.values()[0] if not ApiStoreEnvironment.values().has(_environment) else _environment
+		set(p_value):
+			if not ApiStoreEnvironment.values().has(p_value):
+				push_error("Invalid ApiStoreEnvironment value for environment: %s" % p_value)
+				return
+			_environment = p_value
 
 	var _product_id
 	## Purchase Product ID.
 	var product_id : String:
 		get:
 			return "" if not _product_id is String else String(_product_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for product_id: %s" % [v])
+				return
+			v = str(v)
+			_product_id = v
 
 	var _provider_response
 	## Raw provider validation response.
 	var provider_response : String:
 		get:
 			return "" if not _provider_response is String else String(_provider_response)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for provider_response: %s" % [v])
+				return
+			v = str(v)
+			_provider_response = v
 
 	var _purchase_time
 	## Timestamp when the purchase was done.
 	var purchase_time : String:
 		get:
 			return "" if not _purchase_time is String else String(_purchase_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for purchase_time: %s" % [v])
+				return
+			v = str(v)
+			_purchase_time = v
 
 	var _refund_time
 	var refund_time : String:
 		get:
 			return "" if not _refund_time is String else String(_refund_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refund_time: %s" % [v])
+				return
+			v = str(v)
+			_refund_time = v
 
 	var _seen_before
 	## Whether the purchase had already been validated by Nakama before.
 	var seen_before : bool:
 		get:
 			return false if not _seen_before is bool else bool(_seen_before)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for seen_before: %s" % [v])
+				return
+			_seen_before = v
 
 	var _store
 	var store : int:
 		get:
 			return ApiStoreProvider.values()[0] if not ApiStoreProvider.values().has(_store) else _store
+		set(p_value):
+			if not ApiStoreProvider.values().has(p_value):
+				push_error("Invalid ApiStoreProvider value for store: %s" % p_value)
+				return
+			_store = p_value
 
 	var _transaction_id
 	## Purchase Transaction ID.
 	var transaction_id : String:
 		get:
 			return "" if not _transaction_id is String else String(_transaction_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for transaction_id: %s" % [v])
+				return
+			v = str(v)
+			_transaction_id = v
 
-	# Timestamp when the receipt validation was updated in DB.
 	var _update_time
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _user_id
 	## Purchase User ID.
 	var user_id : String:
 		get:
 			return "" if not _user_id is String else String(_user_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id: %s" % [v])
+				return
+			v = str(v)
+			_user_id = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidatedPurchase from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_create_time = null, # : String
+		p_environment = null, # : int
+		p_product_id = null, # : String
+		p_provider_response = null, # : String
+		p_purchase_time = null, # : String
+		p_refund_time = null, # : String
+		p_seen_before = null, # : bool
+		p_store = null, # : int
+		p_transaction_id = null, # : String
+		p_update_time = null, # : String
+		p_user_id = null, # : String
+	) -> ApiValidatedPurchase:
+		var obj := ApiValidatedPurchase.new()
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_environment != null:
+			obj.environment = p_environment
+		if p_product_id != null:
+			obj.product_id = str(p_product_id) if p_product_id is int else p_product_id
+		if p_provider_response != null:
+			obj.provider_response = str(p_provider_response) if p_provider_response is int else p_provider_response
+		if p_purchase_time != null:
+			obj.purchase_time = str(p_purchase_time) if p_purchase_time is int else p_purchase_time
+		if p_refund_time != null:
+			obj.refund_time = str(p_refund_time) if p_refund_time is int else p_refund_time
+		if p_seen_before != null:
+			obj.seen_before = p_seen_before
+		if p_store != null:
+			obj.store = p_store
+		if p_transaction_id != null:
+			obj.transaction_id = str(p_transaction_id) if p_transaction_id is int else p_transaction_id
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_user_id != null:
+			obj.user_id = str(p_user_id) if p_user_id is int else p_user_id
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidatedPurchase:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidatedPurchase", p_dict), ApiValidatedPurchase) as ApiValidatedPurchase
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidatedPurchase from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidatedPurchase:
+		var obj := ApiValidatedPurchase.new()
+		var v : Variant
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("environment")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._environment = v
+		v = p_dict.get("product_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._product_id = v
+		v = p_dict.get("provider_response")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._provider_response = v
+		v = p_dict.get("purchase_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._purchase_time = v
+		v = p_dict.get("refund_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refund_time = v
+		v = p_dict.get("seen_before")
+		if v is bool:
+			obj._seen_before = v
+		v = p_dict.get("store")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._store = v
+		v = p_dict.get("transaction_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._transaction_id = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("user_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _environment != null:
+			out["environment"] = _environment
+		if _product_id != null:
+			out["product_id"] = _product_id
+		if _provider_response != null:
+			out["provider_response"] = _provider_response
+		if _purchase_time != null:
+			out["purchase_time"] = _purchase_time
+		if _refund_time != null:
+			out["refund_time"] = _refund_time
+		if _seen_before != null:
+			out["seen_before"] = _seen_before
+		if _store != null:
+			out["store"] = _store
+		if _transaction_id != null:
+			out["transaction_id"] = _transaction_id
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _user_id != null:
+			out["user_id"] = _user_id
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidatedPurchase, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidatedPurchase:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidatedPurchase = p_other
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_environment) != typeof(other._environment):
+			return false
+		if _environment != other._environment:
+			return false
+		if typeof(_product_id) != typeof(other._product_id):
+			return false
+		if _product_id != other._product_id:
+			return false
+		if typeof(_provider_response) != typeof(other._provider_response):
+			return false
+		if _provider_response != other._provider_response:
+			return false
+		if typeof(_purchase_time) != typeof(other._purchase_time):
+			return false
+		if _purchase_time != other._purchase_time:
+			return false
+		if typeof(_refund_time) != typeof(other._refund_time):
+			return false
+		if _refund_time != other._refund_time:
+			return false
+		if typeof(_seen_before) != typeof(other._seen_before):
+			return false
+		if _seen_before != other._seen_before:
+			return false
+		if typeof(_store) != typeof(other._store):
+			return false
+		if _store != other._store:
+			return false
+		if typeof(_transaction_id) != typeof(other._transaction_id):
+			return false
+		if _transaction_id != other._transaction_id:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_user_id) != typeof(other._user_id):
+			return false
+		if _user_id != other._user_id:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidatedPurchase which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidatedPurchase:
+		var obj := ApiValidatedPurchase.new(_ex)
+		obj._create_time = _create_time
+		obj._environment = _environment
+		obj._product_id = _product_id
+		obj._provider_response = _provider_response
+		obj._purchase_time = _purchase_time
+		obj._refund_time = _refund_time
+		obj._seen_before = _seen_before
+		obj._store = _store
+		obj._transaction_id = _transaction_id
+		obj._update_time = _update_time
+		obj._user_id = _user_id
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_create_time)
+		values.append(_environment)
+		values.append(_product_id)
+		values.append(_provider_response)
+		values.append(_purchase_time)
+		values.append(_refund_time)
+		values.append(_seen_before)
+		values.append(_store)
+		values.append(_transaction_id)
+		values.append(_update_time)
+		values.append(_user_id)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "create_time: %s, " % _create_time
 		output += "environment: %s, " % _environment
 		output += "product_id: %s, " % _product_id
@@ -3341,6 +13843,7 @@ class ApiValidatedPurchase extends NakamaAsyncResult:
 		output += "transaction_id: %s, " % _transaction_id
 		output += "update_time: %s, " % _update_time
 		output += "user_id: %s, " % _user_id
+		output += map_string
 		return output
 
 class ApiValidatedSubscription extends NakamaAsyncResult:
@@ -3366,91 +13869,440 @@ class ApiValidatedSubscription extends NakamaAsyncResult:
 	var active : bool:
 		get:
 			return false if not _active is bool else bool(_active)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is bool):
+				push_error("Invalid bool value for active: %s" % [v])
+				return
+			_active = v
 
 	var _create_time
 	## UNIX Timestamp when the receipt validation was stored in DB.
 	var create_time : String:
 		get:
 			return "" if not _create_time is String else String(_create_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for create_time: %s" % [v])
+				return
+			v = str(v)
+			_create_time = v
 
 	var _environment
 	## Whether the purchase was done in production or sandbox environment.
 	var environment : int:
 		get:
 			return ApiStoreEnvironment.values()[0] if not ApiStoreEnvironment.values().has(_environment) else _environment
+		set(p_value):
+			if not ApiStoreEnvironment.values().has(p_value):
+				push_error("Invalid ApiStoreEnvironment value for environment: %s" % p_value)
+				return
+			_environment = p_value
 
 	var _expiry_time
 	## Subscription expiration time. The subscription can still be auto-renewed to extend the expiration time further.
 	var expiry_time : String:
 		get:
 			return "" if not _expiry_time is String else String(_expiry_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for expiry_time: %s" % [v])
+				return
+			v = str(v)
+			_expiry_time = v
 
 	var _original_transaction_id
 	## Purchase Original transaction ID (we only keep track of the original subscription, not subsequent renewals).
 	var original_transaction_id : String:
 		get:
 			return "" if not _original_transaction_id is String else String(_original_transaction_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for original_transaction_id: %s" % [v])
+				return
+			v = str(v)
+			_original_transaction_id = v
 
 	var _product_id
 	## Purchase Product ID.
 	var product_id : String:
 		get:
 			return "" if not _product_id is String else String(_product_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for product_id: %s" % [v])
+				return
+			v = str(v)
+			_product_id = v
 
 	var _provider_notification
 	## Raw provider notification body.
 	var provider_notification : String:
 		get:
 			return "" if not _provider_notification is String else String(_provider_notification)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for provider_notification: %s" % [v])
+				return
+			v = str(v)
+			_provider_notification = v
 
 	var _provider_response
 	## Raw provider validation response body.
 	var provider_response : String:
 		get:
 			return "" if not _provider_response is String else String(_provider_response)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for provider_response: %s" % [v])
+				return
+			v = str(v)
+			_provider_response = v
 
-	# UNIX Timestamp when the purchase was done.
 	var _purchase_time
 	var purchase_time : String:
 		get:
 			return "" if not _purchase_time is String else String(_purchase_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for purchase_time: %s" % [v])
+				return
+			v = str(v)
+			_purchase_time = v
 
 	var _refund_time
 	## Subscription refund time. If this time is set, the subscription was refunded.
 	var refund_time : String:
 		get:
 			return "" if not _refund_time is String else String(_refund_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for refund_time: %s" % [v])
+				return
+			v = str(v)
+			_refund_time = v
 
 	var _store
 	var store : int:
 		get:
 			return ApiStoreProvider.values()[0] if not ApiStoreProvider.values().has(_store) else _store
+		set(p_value):
+			if not ApiStoreProvider.values().has(p_value):
+				push_error("Invalid ApiStoreProvider value for store: %s" % p_value)
+				return
+			_store = p_value
 
 	var _update_time
 	## UNIX Timestamp when the receipt validation was updated in DB.
 	var update_time : String:
 		get:
 			return "" if not _update_time is String else String(_update_time)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for update_time: %s" % [v])
+				return
+			v = str(v)
+			_update_time = v
 
 	var _user_id
 	## Subscription User ID.
 	var user_id : String:
 		get:
 			return "" if not _user_id is String else String(_user_id)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for user_id: %s" % [v])
+				return
+			v = str(v)
+			_user_id = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiValidatedSubscription from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_active = null, # : bool
+		p_create_time = null, # : String
+		p_environment = null, # : int
+		p_expiry_time = null, # : String
+		p_original_transaction_id = null, # : String
+		p_product_id = null, # : String
+		p_provider_notification = null, # : String
+		p_provider_response = null, # : String
+		p_purchase_time = null, # : String
+		p_refund_time = null, # : String
+		p_store = null, # : int
+		p_update_time = null, # : String
+		p_user_id = null, # : String
+	) -> ApiValidatedSubscription:
+		var obj := ApiValidatedSubscription.new()
+		if p_active != null:
+			obj.active = p_active
+		if p_create_time != null:
+			obj.create_time = str(p_create_time) if p_create_time is int else p_create_time
+		if p_environment != null:
+			obj.environment = p_environment
+		if p_expiry_time != null:
+			obj.expiry_time = str(p_expiry_time) if p_expiry_time is int else p_expiry_time
+		if p_original_transaction_id != null:
+			obj.original_transaction_id = str(p_original_transaction_id) if p_original_transaction_id is int else p_original_transaction_id
+		if p_product_id != null:
+			obj.product_id = str(p_product_id) if p_product_id is int else p_product_id
+		if p_provider_notification != null:
+			obj.provider_notification = str(p_provider_notification) if p_provider_notification is int else p_provider_notification
+		if p_provider_response != null:
+			obj.provider_response = str(p_provider_response) if p_provider_response is int else p_provider_response
+		if p_purchase_time != null:
+			obj.purchase_time = str(p_purchase_time) if p_purchase_time is int else p_purchase_time
+		if p_refund_time != null:
+			obj.refund_time = str(p_refund_time) if p_refund_time is int else p_refund_time
+		if p_store != null:
+			obj.store = p_store
+		if p_update_time != null:
+			obj.update_time = str(p_update_time) if p_update_time is int else p_update_time
+		if p_user_id != null:
+			obj.user_id = str(p_user_id) if p_user_id is int else p_user_id
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiValidatedSubscription:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiValidatedSubscription", p_dict), ApiValidatedSubscription) as ApiValidatedSubscription
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiValidatedSubscription from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiValidatedSubscription:
+		var obj := ApiValidatedSubscription.new()
+		var v : Variant
+		v = p_dict.get("active")
+		if v is bool:
+			obj._active = v
+		v = p_dict.get("create_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._create_time = v
+		v = p_dict.get("environment")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._environment = v
+		v = p_dict.get("expiry_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._expiry_time = v
+		v = p_dict.get("original_transaction_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._original_transaction_id = v
+		v = p_dict.get("product_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._product_id = v
+		v = p_dict.get("provider_notification")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._provider_notification = v
+		v = p_dict.get("provider_response")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._provider_response = v
+		v = p_dict.get("purchase_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._purchase_time = v
+		v = p_dict.get("refund_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._refund_time = v
+		v = p_dict.get("store")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._store = v
+		v = p_dict.get("update_time")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._update_time = v
+		v = p_dict.get("user_id")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._user_id = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _active != null:
+			out["active"] = _active
+		if _create_time != null:
+			out["create_time"] = _create_time
+		if _environment != null:
+			out["environment"] = _environment
+		if _expiry_time != null:
+			out["expiry_time"] = _expiry_time
+		if _original_transaction_id != null:
+			out["original_transaction_id"] = _original_transaction_id
+		if _product_id != null:
+			out["product_id"] = _product_id
+		if _provider_notification != null:
+			out["provider_notification"] = _provider_notification
+		if _provider_response != null:
+			out["provider_response"] = _provider_response
+		if _purchase_time != null:
+			out["purchase_time"] = _purchase_time
+		if _refund_time != null:
+			out["refund_time"] = _refund_time
+		if _store != null:
+			out["store"] = _store
+		if _update_time != null:
+			out["update_time"] = _update_time
+		if _user_id != null:
+			out["user_id"] = _user_id
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiValidatedSubscription, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiValidatedSubscription:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiValidatedSubscription = p_other
+		if typeof(_active) != typeof(other._active):
+			return false
+		if _active != other._active:
+			return false
+		if typeof(_create_time) != typeof(other._create_time):
+			return false
+		if _create_time != other._create_time:
+			return false
+		if typeof(_environment) != typeof(other._environment):
+			return false
+		if _environment != other._environment:
+			return false
+		if typeof(_expiry_time) != typeof(other._expiry_time):
+			return false
+		if _expiry_time != other._expiry_time:
+			return false
+		if typeof(_original_transaction_id) != typeof(other._original_transaction_id):
+			return false
+		if _original_transaction_id != other._original_transaction_id:
+			return false
+		if typeof(_product_id) != typeof(other._product_id):
+			return false
+		if _product_id != other._product_id:
+			return false
+		if typeof(_provider_notification) != typeof(other._provider_notification):
+			return false
+		if _provider_notification != other._provider_notification:
+			return false
+		if typeof(_provider_response) != typeof(other._provider_response):
+			return false
+		if _provider_response != other._provider_response:
+			return false
+		if typeof(_purchase_time) != typeof(other._purchase_time):
+			return false
+		if _purchase_time != other._purchase_time:
+			return false
+		if typeof(_refund_time) != typeof(other._refund_time):
+			return false
+		if _refund_time != other._refund_time:
+			return false
+		if typeof(_store) != typeof(other._store):
+			return false
+		if _store != other._store:
+			return false
+		if typeof(_update_time) != typeof(other._update_time):
+			return false
+		if _update_time != other._update_time:
+			return false
+		if typeof(_user_id) != typeof(other._user_id):
+			return false
+		if _user_id != other._user_id:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiValidatedSubscription which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiValidatedSubscription:
+		var obj := ApiValidatedSubscription.new(_ex)
+		obj._active = _active
+		obj._create_time = _create_time
+		obj._environment = _environment
+		obj._expiry_time = _expiry_time
+		obj._original_transaction_id = _original_transaction_id
+		obj._product_id = _product_id
+		obj._provider_notification = _provider_notification
+		obj._provider_response = _provider_response
+		obj._purchase_time = _purchase_time
+		obj._refund_time = _refund_time
+		obj._store = _store
+		obj._update_time = _update_time
+		obj._user_id = _user_id
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_active)
+		values.append(_create_time)
+		values.append(_environment)
+		values.append(_expiry_time)
+		values.append(_original_transaction_id)
+		values.append(_product_id)
+		values.append(_provider_notification)
+		values.append(_provider_response)
+		values.append(_purchase_time)
+		values.append(_refund_time)
+		values.append(_store)
+		values.append(_update_time)
+		values.append(_user_id)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "active: %s, " % _active
 		output += "create_time: %s, " % _create_time
 		output += "environment: %s, " % _environment
@@ -3464,6 +14316,7 @@ class ApiValidatedSubscription extends NakamaAsyncResult:
 		output += "store: %s, " % _store
 		output += "update_time: %s, " % _update_time
 		output += "user_id: %s, " % _user_id
+		output += map_string
 		return output
 
 ## The object to store.
@@ -3483,56 +14336,256 @@ class ApiWriteStorageObject extends NakamaAsyncResult:
 	var collection : String:
 		get:
 			return "" if not _collection is String else String(_collection)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for collection: %s" % [v])
+				return
+			v = str(v)
+			_collection = v
 
 	var _key
 	## The key for the object within the collection.
 	var key : String:
 		get:
 			return "" if not _key is String else String(_key)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for key: %s" % [v])
+				return
+			v = str(v)
+			_key = v
 
 	var _permission_read
 	## The read access permissions for the object.
 	var permission_read : int:
 		get:
 			return 0 if not _permission_read is int else int(_permission_read)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for permission_read: %s" % [v])
+				return
+			v = int(v)
+			_permission_read = v
 
 	var _permission_write
 	## The write access permissions for the object.
 	var permission_write : int:
 		get:
 			return 0 if not _permission_write is int else int(_permission_write)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for permission_write: %s" % [v])
+				return
+			v = int(v)
+			_permission_write = v
 
 	var _value
 	## The value of the object.
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
 
-	# The version hash of the object to check. Possible values are: ["", "*", "#hash#"].
 	var _version
 	var version : String:
 		get:
 			return "" if not _version is String else String(_version)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for version: %s" % [v])
+				return
+			v = str(v)
+			_version = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiWriteStorageObject from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_collection = null, # : String
+		p_key = null, # : String
+		p_permission_read = null, # : int
+		p_permission_write = null, # : int
+		p_value = null, # : String
+		p_version = null, # : String
+	) -> ApiWriteStorageObject:
+		var obj := ApiWriteStorageObject.new()
+		if p_collection != null:
+			obj.collection = str(p_collection) if p_collection is int else p_collection
+		if p_key != null:
+			obj.key = str(p_key) if p_key is int else p_key
+		if p_permission_read != null:
+			obj.permission_read = p_permission_read
+		if p_permission_write != null:
+			obj.permission_write = p_permission_write
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		if p_version != null:
+			obj.version = str(p_version) if p_version is int else p_version
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiWriteStorageObject:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiWriteStorageObject", p_dict), ApiWriteStorageObject) as ApiWriteStorageObject
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiWriteStorageObject from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiWriteStorageObject:
+		var obj := ApiWriteStorageObject.new()
+		var v : Variant
+		v = p_dict.get("collection")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._collection = v
+		v = p_dict.get("key")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._key = v
+		v = p_dict.get("permission_read")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._permission_read = v
+		v = p_dict.get("permission_write")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._permission_write = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		v = p_dict.get("version")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._version = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _collection != null:
+			out["collection"] = _collection
+		if _key != null:
+			out["key"] = _key
+		if _permission_read != null:
+			out["permission_read"] = _permission_read
+		if _permission_write != null:
+			out["permission_write"] = _permission_write
+		if _value != null:
+			out["value"] = _value
+		if _version != null:
+			out["version"] = _version
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiWriteStorageObject, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiWriteStorageObject:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiWriteStorageObject = p_other
+		if typeof(_collection) != typeof(other._collection):
+			return false
+		if _collection != other._collection:
+			return false
+		if typeof(_key) != typeof(other._key):
+			return false
+		if _key != other._key:
+			return false
+		if typeof(_permission_read) != typeof(other._permission_read):
+			return false
+		if _permission_read != other._permission_read:
+			return false
+		if typeof(_permission_write) != typeof(other._permission_write):
+			return false
+		if _permission_write != other._permission_write:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if typeof(_version) != typeof(other._version):
+			return false
+		if _version != other._version:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiWriteStorageObject which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiWriteStorageObject:
+		var obj := ApiWriteStorageObject.new(_ex)
+		obj._collection = _collection
+		obj._key = _key
+		obj._permission_read = _permission_read
+		obj._permission_write = _permission_write
+		obj._value = _value
+		obj._version = _version
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_collection)
+		values.append(_key)
+		values.append(_permission_read)
+		values.append(_permission_write)
+		values.append(_value)
+		values.append(_version)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "collection: %s, " % _collection
 		output += "key: %s, " % _key
 		output += "permission_read: %s, " % _permission_read
 		output += "permission_write: %s, " % _permission_write
 		output += "value: %s, " % _value
 		output += "version: %s, " % _version
+		output += map_string
 		return output
 
 ## Write objects to the storage engine.
@@ -3546,22 +14599,166 @@ class ApiWriteStorageObjectsRequest extends NakamaAsyncResult:
 	## The objects to store on the server.
 	var objects : Array:
 		get:
+			_materialize_objects()
 			return Array() if not _objects is Array else Array(_objects)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ApiWriteStorageObject._from_dict(raw))
+				elif e is ApiWriteStorageObject:
+					arr.append(e)
+				else:
+					push_error("Invalid ApiWriteStorageObject value for an element of objects: %s" % [e])
+					return
+			_objects = arr
+			_objects_lazy = false
+
+	## True while _objects still holds the raw dictionaries received from the server.
+	var _objects_lazy := false
+
+	## The number of elements in objects, without deserializing them.
+	func get_objects_count() -> int:
+		if not _objects is Array:
+			return 0
+		var arr : Array = _objects
+		return arr.size()
+
+	## Return element p_index of objects, deserializing only that element, or null when there is none.
+	func get_objects_at(p_index : int) -> ApiWriteStorageObject:
+		if not _objects is Array:
+			return null
+		var arr : Array = _objects
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ApiWriteStorageObject._from_dict(raw)
+		var e : ApiWriteStorageObject = arr[p_index]
+		return e
+
+	func _materialize_objects() -> void:
+		if not _objects_lazy:
+			return
+		for i in get_objects_count():
+			get_objects_at(i)
+		_objects_lazy = false
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ApiWriteStorageObjectsRequest from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_objects = null, # : Array
+	) -> ApiWriteStorageObjectsRequest:
+		var obj := ApiWriteStorageObjectsRequest.new()
+		if p_objects != null:
+			obj.objects = p_objects
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ApiWriteStorageObjectsRequest:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ApiWriteStorageObjectsRequest", p_dict), ApiWriteStorageObjectsRequest) as ApiWriteStorageObjectsRequest
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ApiWriteStorageObjectsRequest from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ApiWriteStorageObjectsRequest:
+		var obj := ApiWriteStorageObjectsRequest.new()
+		var v : Variant
+		v = p_dict.get("objects")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._objects = arr
+			obj._objects_lazy = true
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _objects is Array:
+			var arr := []
+			for e in _objects:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ApiWriteStorageObject:
+					var item : ApiWriteStorageObject = e
+					arr.append(item._to_dict())
+			out["objects"] = arr
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ApiWriteStorageObjectsRequest, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ApiWriteStorageObjectsRequest:
+			return false
+		if p_other == self:
+			return true
+		var other : ApiWriteStorageObjectsRequest = p_other
+		if typeof(_objects) != typeof(other._objects):
+			return false
+		if _objects != null:
+			if get_objects_count() != other.get_objects_count():
+				return false
+			for i in get_objects_count():
+				var e : ApiWriteStorageObject = get_objects_at(i)
+				if e == null or not e.equals(other.get_objects_at(i)):
+					return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ApiWriteStorageObjectsRequest which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ApiWriteStorageObjectsRequest:
+		var obj := ApiWriteStorageObjectsRequest.new(_ex)
+		if _objects is Array:
+			var arr := []
+			for e in _objects:
+				if e is ApiWriteStorageObject:
+					var item : ApiWriteStorageObject = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._objects = arr
+			obj._objects_lazy = _objects_lazy
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		if _objects is Array:
+			var hashes := []
+			for i in get_objects_count():
+				var e : ApiWriteStorageObject = get_objects_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
-		output += "objects: %s, " % [_objects]
+		var map_string : String = ""
+		output += "objects: %s, " % [objects]
+		output += map_string
 		return output
 
 class ProtobufAny extends NakamaAsyncResult:
@@ -3575,27 +14772,124 @@ class ProtobufAny extends NakamaAsyncResult:
 	var type_url : String:
 		get:
 			return "" if not _type_url is String else String(_type_url)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for type_url: %s" % [v])
+				return
+			v = str(v)
+			_type_url = v
 
 	var _value
 	var value : String:
 		get:
 			return "" if not _value is String else String(_value)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for value: %s" % [v])
+				return
+			v = str(v)
+			_value = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new ProtobufAny from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_type_url = null, # : String
+		p_value = null, # : String
+	) -> ProtobufAny:
+		var obj := ProtobufAny.new()
+		if p_type_url != null:
+			obj.type_url = str(p_type_url) if p_type_url is int else p_type_url
+		if p_value != null:
+			obj.value = str(p_value) if p_value is int else p_value
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> ProtobufAny:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "ProtobufAny", p_dict), ProtobufAny) as ProtobufAny
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a ProtobufAny from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> ProtobufAny:
+		var obj := ProtobufAny.new()
+		var v : Variant
+		v = p_dict.get("type_url")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._type_url = v
+		v = p_dict.get("value")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._value = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _type_url != null:
+			out["type_url"] = _type_url
+		if _value != null:
+			out["value"] = _value
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another ProtobufAny, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is ProtobufAny:
+			return false
+		if p_other == self:
+			return true
+		var other : ProtobufAny = p_other
+		if typeof(_type_url) != typeof(other._type_url):
+			return false
+		if _type_url != other._type_url:
+			return false
+		if typeof(_value) != typeof(other._value):
+			return false
+		if _value != other._value:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this ProtobufAny which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> ProtobufAny:
+		var obj := ProtobufAny.new(_ex)
+		obj._type_url = _type_url
+		obj._value = _value
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_type_url)
+		values.append(_value)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "type_url: %s, " % _type_url
 		output += "value: %s, " % _value
+		output += map_string
 		return output
 
 class RpcStatus extends NakamaAsyncResult:
@@ -3610,33 +14904,229 @@ class RpcStatus extends NakamaAsyncResult:
 	var code : int:
 		get:
 			return 0 if not _code is int else int(_code)
+		set(p_value):
+			var v : Variant = p_value
+			if v is String and str(v).is_valid_int():
+				v = str(v).to_int()
+			if not (v is int or v is float):
+				push_error("Invalid int value for code: %s" % [v])
+				return
+			v = int(v)
+			_code = v
 
 	var _details
 	var details : Array:
 		get:
+			_materialize_details()
 			return Array() if not _details is Array else Array(_details)
+		set(p_value):
+			var arr := []
+			for e in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append(ProtobufAny._from_dict(raw))
+				elif e is ProtobufAny:
+					arr.append(e)
+				else:
+					push_error("Invalid ProtobufAny value for an element of details: %s" % [e])
+					return
+			_details = arr
+			_details_lazy = false
+
+	## True while _details still holds the raw dictionaries received from the server.
+	var _details_lazy := false
+
+	## The number of elements in details, without deserializing them.
+	func get_details_count() -> int:
+		if not _details is Array:
+			return 0
+		var arr : Array = _details
+		return arr.size()
+
+	## Return element p_index of details, deserializing only that element, or null when there is none.
+	func get_details_at(p_index : int) -> ProtobufAny:
+		if not _details is Array:
+			return null
+		var arr : Array = _details
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = ProtobufAny._from_dict(raw)
+		var e : ProtobufAny = arr[p_index]
+		return e
+
+	func _materialize_details() -> void:
+		if not _details_lazy:
+			return
+		for i in get_details_count():
+			get_details_at(i)
+		_details_lazy = false
 
 	var _message
 	var message : String:
 		get:
 			return "" if not _message is String else String(_message)
+		set(p_value):
+			var v : Variant = p_value
+			if not (v is String or v is StringName or v is int):
+				push_error("Invalid String value for message: %s" % [v])
+				return
+			v = str(v)
+			_message = v
+
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
 
 	func _init(p_exception = null):
 		super(p_exception)
 
+	## Create a new RpcStatus from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		p_code = null, # : int
+		p_details = null, # : Array
+		p_message = null, # : String
+	) -> RpcStatus:
+		var obj := RpcStatus.new()
+		if p_code != null:
+			obj.code = p_code
+		if p_details != null:
+			obj.details = p_details
+		if p_message != null:
+			obj.message = str(p_message) if p_message is int else p_message
+		return obj
+
 	static func create(p_ns : GDScript, p_dict : Dictionary) -> RpcStatus:
-		return _safe_ret(NakamaSerializer.deserialize(p_ns, "RpcStatus", p_dict), RpcStatus) as RpcStatus
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return NakamaSerializer.serialize(self)
+		return _to_dict()
+
+	## Build a RpcStatus from a decoded JSON dictionary. Same rules as NakamaSerializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> RpcStatus:
+		var obj := RpcStatus.new()
+		var v : Variant
+		v = p_dict.get("code")
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj._code = v
+		v = p_dict.get("details")
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj._details = arr
+			obj._details_lazy = true
+		v = p_dict.get("message")
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+		if v is String:
+			obj._message = v
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as NakamaSerializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+		if _code != null:
+			out["code"] = _code
+		if _details is Array:
+			var arr := []
+			for e in _details:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is ProtobufAny:
+					var item : ProtobufAny = e
+					arr.append(item._to_dict())
+			out["details"] = arr
+		if _message != null:
+			out["message"] = _message
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another RpcStatus, following nested objects, arrays and maps.
+	func equals(p_other) -> bool:
+		if not p_other is RpcStatus:
+			return false
+		if p_other == self:
+			return true
+		var other : RpcStatus = p_other
+		if typeof(_code) != typeof(other._code):
+			return false
+		if _code != other._code:
+			return false
+		if typeof(_details) != typeof(other._details):
+			return false
+		if _details != null:
+			if get_details_count() != other.get_details_count():
+				return false
+			for i in get_details_count():
+				var e : ProtobufAny = get_details_at(i)
+				if e == null or not e.equals(other.get_details_at(i)):
+					return false
+		if typeof(_message) != typeof(other._message):
+			return false
+		if _message != other._message:
+			return false
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this RpcStatus which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> RpcStatus:
+		var obj := RpcStatus.new(_ex)
+		obj._code = _code
+		if _details is Array:
+			var arr := []
+			for e in _details:
+				if e is ProtobufAny:
+					var item : ProtobufAny = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj._details = arr
+			obj._details_lazy = _details_lazy
+		obj._message = _message
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+		values.append(_code)
+		if _details is Array:
+			var hashes := []
+			for i in get_details_count():
+				var e : ProtobufAny = get_details_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+		values.append(_message)
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
 			return get_exception()._to_string()
 		var output : String = ""
+		var map_string : String = ""
 		output += "code: %s, " % _code
-		output += "details: %s, " % [_details]
+		output += "details: %s, " % [details]
 		output += "message: %s, " % _message
+		output += map_string
 		return output
 
 ## The low level client for the Nakama API.
@@ -3650,6 +15140,21 @@ class ApiClient extends RefCounted:
 	var auto_refresh := true
 	var auto_refresh_time := 300
 
+	## Whether requests carry a W3C traceparent header. Its trace ID is on the returned result.
+	var trace_requests := true
+	var _trace_id := ""
+	## The trace ID shared by all requests, 32 lowercase hex digits like NakamaTrace.trace_id_of() makes of the ID of a game session. Each request gets a new one when empty.
+	var trace_id : String:
+		set(p_value):
+			if p_value and not NakamaTrace.is_valid_trace_id(p_value):
+				push_error("Invalid trace ID '%s': expected 32 lowercase hex digits, see NakamaTrace.trace_id_of()." % p_value)
+				return
+			_trace_id = p_value
+		get:
+			return _trace_id
+	## A header which also carries the trace ID, like "X-Correlation-Id", when not empty.
+	var correlation_header := ""
+
 	var auto_retry : bool:
 		set(p_value):
 			_http_adapter.auto_retry = p_value
@@ -3668,6 +15173,7 @@ class ApiClient extends RefCounted:
 		get:
 			return _http_adapter.auto_retry_backoff_base
 
+	## The token of the last request sent, to pass to cancel_request(). Deprecated: when requests start in the same frame it may belong to another one, pass a NakamaCancellationToken to the request instead.
 	var last_cancel_token:
 		get:
 			return _http_adapter.get_last_token()
@@ -3679,115 +15185,219 @@ class ApiClient extends RefCounted:
 		_namespace = p_namespace
 		_server_key = p_server_key
 
-	func _refresh_session(p_session : NakamaSession):
+		
+	## A session refresh in flight, awaited by the requests which need it while it is pending.
+	class _RefreshFlight extends RefCounted:
+		signal completed
+		var result : ApiSession
+
+	## The session refreshes in flight, by refresh token.
+	var _refreshes : Dictionary = {}
+
+	## Refresh the session when it is about to expire. Concurrent calls for the same session share one request, and get the same result.
+	func _refresh_session(p_session : NakamaSession) -> ApiSession:
 		if auto_refresh and p_session.is_valid() and p_session.refresh_token and not p_session.is_refresh_expired() and p_session.would_expire_in(auto_refresh_time):
-			var request = ApiSessionRefreshRequest.new()
-			request._token = p_session.refresh_token
-			return await session_refresh_async(_server_key, "", request)
+			var key : String = p_session.refresh_token
+			if _refreshes.has(key):
+				var pending : _RefreshFlight = _refreshes[key]
+				await pending.completed
+				return pending.result
+			var flight := _RefreshFlight.new()
+			_refreshes[key] = flight
+			var request := ApiSessionRefreshRequest.new()
+			request.token = p_session.refresh_token
+			flight.result = await session_refresh_async(_server_key, "", request)
+			_refreshes.erase(key)
+			flight.completed.emit()
+			return flight.result
 		return null
 
-	func cancel_request(p_token):
+	func cancel_request(p_token) -> void:
 		if p_token:
 			_http_adapter.cancel_request(p_token)
 
+	## A request about to be sent, which interceptors can inspect and change.
+	class Request extends RefCounted:
+		var operation_id : String # The operation ID in the spec, like "GetAccount".
+		var trace_id : String
+		var method : String
+		var uri : String
+		var headers : Dictionary
+		var body : PackedByteArray
+
+	## Called around the requests of the client once added with add_interceptor(). Extend it and override its methods to add headers, sign or log bodies, or rewrite URIs.
+	class Interceptor extends RefCounted:
+
+		## Called before p_request is sent, in the order the interceptors were added. It can await.
+		func before_request(_p_request : Request) -> void:
+			pass
+
+		## Called with the decoded response or the exception of p_request, in the reverse order. Returns the result passed on.
+		func after_response(_p_request : Request, p_result : Variant) -> Variant:
+			return p_result
+
+	var _interceptors : Array = []
+
+	func add_interceptor(p_interceptor : Interceptor) -> void:
+		if not _interceptors.has(p_interceptor):
+			_interceptors.append(p_interceptor)
+
+	func remove_interceptor(p_interceptor : Interceptor) -> void:
+		_interceptors.erase(p_interceptor)
+
+	## The trace ID of a new request, empty when requests are not traced.
+	func _request_trace_id() -> String:
+		if not trace_requests:
+			return ""
+		return _trace_id if _trace_id else NakamaTrace.new_trace_id()
+
+	func _send_async(p_operation_id : String, p_trace_id : String, p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+			p_cancel_token : NakamaCancellationToken = null, p_retryable : bool = true, p_timeout : int = 0) -> Variant:
+		if p_trace_id:
+			p_headers["traceparent"] = NakamaTrace.traceparent(p_trace_id, NakamaTrace.new_span_id())
+			if correlation_header:
+				p_headers[correlation_header] = p_trace_id
+		var result : Variant = await _intercept_async(p_operation_id, p_trace_id, p_method, p_uri, p_headers, p_body, p_cancel_token, p_retryable, p_timeout)
+		if result is NakamaException:
+			var exception : NakamaException = result
+			exception._trace_id = p_trace_id
+		return result
+
+	func _intercept_async(p_operation_id : String, p_trace_id : String, p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+			p_cancel_token : NakamaCancellationToken, p_retryable : bool, p_timeout : int) -> Variant:
+		if _interceptors.is_empty():
+			return await _http_adapter.send_async(p_method, p_uri, p_headers, p_body, p_retryable, p_timeout, p_cancel_token)
+		var request := Request.new()
+		request.operation_id = p_operation_id
+		request.trace_id = p_trace_id
+		request.method = p_method
+		request.uri = p_uri
+		request.headers = p_headers
+		request.body = p_body
+		# Interceptors added or removed while the request is pending apply to the next one.
+		var chain := _interceptors.duplicate()
+		for interceptor in chain:
+			await interceptor.before_request(request)
+		var result : Variant = await _http_adapter.send_async(request.method, request.uri, request.headers, request.body, p_retryable, p_timeout, p_cancel_token)
+		chain.reverse()
+		for interceptor in chain:
+			result = await interceptor.after_response(request, result)
+		return result
+
 	## A healthcheck which load balancers can use to check the service.
 	func healthcheck_async(
 		p_session : NakamaSession
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/healthcheck"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Healthcheck", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Delete the current user's account.
 	func delete_account_async(
 		p_session : NakamaSession
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteAccount", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Fetch the current user's account.
 	func get_account_async(
 		p_session : NakamaSession
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiAccount:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiAccount.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetAccount", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiAccount.new(result)
-		var out : ApiAccount = NakamaSerializer.deserialize(_namespace, "ApiAccount", result)
+		var body : Dictionary = result
+		var out : ApiAccount = ApiAccount._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Update fields in the current user's account.
 	func update_account_async(
 		p_session : NakamaSession
 		, p_body : ApiUpdateAccountRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UpdateAccount", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Authenticate a user with an Apple ID against the server.
 	func authenticate_apple_async(
@@ -3796,27 +15406,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountApple
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/apple"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateApple", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with a custom id against the server.
@@ -3826,27 +15440,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountCustom
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/custom"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateCustom", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with a device id against the server.
@@ -3856,27 +15474,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountDevice
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/device"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateDevice", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with an email+password against the server.
@@ -3886,27 +15508,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountEmail
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/email"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateEmail", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with a Facebook OAuth token against the server.
@@ -3917,29 +15543,33 @@ class ApiClient extends RefCounted:
 		, p_create = null # : boolean
 		, p_username = null # : string
 		, p_sync = null # : boolean
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/facebook"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
 		if p_sync != null:
 			query_params += "sync=%s&" % str(bool(p_sync)).to_lower()
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateFacebook", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with a Facebook Instant Game token against the server.
@@ -3949,27 +15579,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountFacebookInstantGame
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/facebookinstantgame"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateFacebookInstantGame", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with Apple's GameCenter against the server.
@@ -3979,27 +15613,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountGameCenter
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/gamecenter"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateGameCenter", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with Google against the server.
@@ -4009,27 +15647,31 @@ class ApiClient extends RefCounted:
 		, p_account : ApiAccountGoogle
 		, p_create = null # : boolean
 		, p_username = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/google"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateGoogle", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Authenticate a user with Steam against the server.
@@ -4040,525 +15682,605 @@ class ApiClient extends RefCounted:
 		, p_create = null # : boolean
 		, p_username = null # : string
 		, p_sync = null # : boolean
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/authenticate/steam"
-		var query_params = ""
+		var query_params := ""
 		if p_create != null:
 			query_params += "create=%s&" % str(bool(p_create)).to_lower()
 		if p_username != null:
-			query_params += "username=%s&" % NakamaSerializer.escape_http(p_username)
+			query_params += "username=%s&" % NakamaSerializer.escape_http(str(p_username))
 		if p_sync != null:
 			query_params += "sync=%s&" % str(bool(p_sync)).to_lower()
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AuthenticateSteam", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Add an Apple ID to the social profiles on the current user's account.
 	func link_apple_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountApple
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/apple"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkApple", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add a custom ID to the social profiles on the current user's account.
 	func link_custom_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountCustom
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/custom"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkCustom", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add a device ID to the social profiles on the current user's account.
 	func link_device_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountDevice
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/device"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkDevice", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add an email+password to the social profiles on the current user's account.
 	func link_email_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountEmail
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/email"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkEmail", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add Facebook to the social profiles on the current user's account.
 	func link_facebook_async(
 		p_session : NakamaSession
 		, p_account : ApiAccountFacebook
 		, p_sync = null # : boolean
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/facebook"
-		var query_params = ""
+		var query_params := ""
 		if p_sync != null:
 			query_params += "sync=%s&" % str(bool(p_sync)).to_lower()
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkFacebook", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add Facebook Instant Game to the social profiles on the current user's account.
 	func link_facebook_instant_game_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountFacebookInstantGame
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/facebookinstantgame"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkFacebookInstantGame", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add Apple's GameCenter to the social profiles on the current user's account.
 	func link_game_center_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountGameCenter
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/gamecenter"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkGameCenter", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add Google to the social profiles on the current user's account.
 	func link_google_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountGoogle
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/google"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkGoogle", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add Steam to the social profiles on the current user's account.
 	func link_steam_async(
 		p_session : NakamaSession
 		, p_body : ApiLinkSteamRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/link/steam"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LinkSteam", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Refresh a user's session using a refresh token retrieved from a previous authentication request.
 	func session_refresh_async(
 		p_basic_auth_username : String
 		, p_basic_auth_password : String
 		, p_body : ApiSessionRefreshRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSession:
 		var urlpath : String = "/v2/account/session/refresh"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("SessionRefresh", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSession.new(result)
-		var out : ApiSession = NakamaSerializer.deserialize(_namespace, "ApiSession", result)
+		var body : Dictionary = result
+		var out : ApiSession = ApiSession._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Remove the Apple ID from the social profiles on the current user's account.
 	func unlink_apple_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountApple
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/apple"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkApple", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove the custom ID from the social profiles on the current user's account.
 	func unlink_custom_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountCustom
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/custom"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkCustom", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove the device ID from the social profiles on the current user's account.
 	func unlink_device_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountDevice
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/device"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkDevice", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove the email+password from the social profiles on the current user's account.
 	func unlink_email_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountEmail
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/email"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkEmail", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove Facebook from the social profiles on the current user's account.
 	func unlink_facebook_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountFacebook
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/facebook"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkFacebook", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove Facebook Instant Game profile from the social profiles on the current user's account.
 	func unlink_facebook_instant_game_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountFacebookInstantGame
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/facebookinstantgame"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkFacebookInstantGame", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove Apple's GameCenter from the social profiles on the current user's account.
 	func unlink_game_center_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountGameCenter
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/gamecenter"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkGameCenter", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove Google from the social profiles on the current user's account.
 	func unlink_google_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountGoogle
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/google"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkGoogle", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Remove Steam from the social profiles on the current user's account.
 	func unlink_steam_async(
 		p_session : NakamaSession
 		, p_body : ApiAccountSteam
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/account/unlink/steam"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UnlinkSteam", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List a channel's message history.
 	func list_channel_messages_async(
@@ -4567,92 +16289,155 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_forward = null # : boolean
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiChannelMessageList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiChannelMessageList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/channel/{channelId}"
 		urlpath = urlpath.replace("{channelId}", NakamaSerializer.escape_http(p_channel_id))
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_forward != null:
 			query_params += "forward=%s&" % str(bool(p_forward)).to_lower()
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListChannelMessages", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiChannelMessageList.new(result)
-		var out : ApiChannelMessageList = NakamaSerializer.deserialize(_namespace, "ApiChannelMessageList", result)
+		var body : Dictionary = result
+		var out : ApiChannelMessageList = ApiChannelMessageList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_channel_messages_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_channel_messages_pages_async(
+		p_session : NakamaSession
+		, p_channel_id : String
+		, p_limit = null # : integer
+		, p_forward = null # : boolean
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiChannelMessageList = await list_channel_messages_async(p_session, p_channel_id, p_limit, p_forward, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_messages_count() == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			cursor = page.next_cursor
+		return pages
+
+	## Fetch the pages of list_channel_messages_async until there are no more or at least p_max_items messages were received (0 for no limit), and return them as one ApiChannelMessageList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_channel_messages_all_async(
+		p_session : NakamaSession
+		, p_channel_id : String
+		, p_limit = null # : integer
+		, p_forward = null # : boolean
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiChannelMessageList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiChannelMessageList
+		while true:
+			page = await list_channel_messages_async(p_session, p_channel_id, p_limit, p_forward, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_messages_count()
+			for i in count:
+				items.append(page.get_messages_at(i))
+			if count == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.next_cursor
+		page._messages = items
+		page._messages_lazy = false
+		return page
+
 	## Submit an event for processing in the server's registered runtime custom events handler.
 	func event_async(
 		p_session : NakamaSession
 		, p_body : ApiEvent
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/event"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("Event", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Delete one or more users by ID or username.
 	func delete_friends_async(
 		p_session : NakamaSession
 		, p_ids = null # : array
 		, p_usernames = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/friend"
-		var query_params = ""
+		var query_params := ""
 		if p_ids != null:
 			for elem in p_ids:
 				query_params += "ids=%s&" % elem
 		if p_usernames != null:
 			for elem in p_usernames:
 				query_params += "usernames=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteFriends", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List all friends for the current user.
 	func list_friends_async(
@@ -4660,155 +16445,224 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_state = null # : integer
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiFriendList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiFriendList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/friend"
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_state != null:
 			query_params += "state=%d&" % p_state
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListFriends", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiFriendList.new(result)
-		var out : ApiFriendList = NakamaSerializer.deserialize(_namespace, "ApiFriendList", result)
+		var body : Dictionary = result
+		var out : ApiFriendList = ApiFriendList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_friends_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_friends_pages_async(
+		p_session : NakamaSession
+		, p_limit = null # : integer
+		, p_state = null # : integer
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiFriendList = await list_friends_async(p_session, p_limit, p_state, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_friends_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_friends_async until there are no more or at least p_max_items friends were received (0 for no limit), and return them as one ApiFriendList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_friends_all_async(
+		p_session : NakamaSession
+		, p_limit = null # : integer
+		, p_state = null # : integer
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiFriendList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiFriendList
+		while true:
+			page = await list_friends_async(p_session, p_limit, p_state, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_friends_count()
+			for i in count:
+				items.append(page.get_friends_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._friends = items
+		page._friends_lazy = false
+		return page
+
 	## Add friends by ID or username to a user's account.
 	func add_friends_async(
 		p_session : NakamaSession
 		, p_ids = null # : array
 		, p_usernames = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/friend"
-		var query_params = ""
+		var query_params := ""
 		if p_ids != null:
 			for elem in p_ids:
 				query_params += "ids=%s&" % elem
 		if p_usernames != null:
 			for elem in p_usernames:
 				query_params += "usernames=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AddFriends", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Block one or more users by ID or username.
 	func block_friends_async(
 		p_session : NakamaSession
 		, p_ids = null # : array
 		, p_usernames = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/friend/block"
-		var query_params = ""
+		var query_params := ""
 		if p_ids != null:
 			for elem in p_ids:
 				query_params += "ids=%s&" % elem
 		if p_usernames != null:
 			for elem in p_usernames:
 				query_params += "usernames=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("BlockFriends", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Import Facebook friends and add them to a user's account.
 	func import_facebook_friends_async(
 		p_session : NakamaSession
 		, p_account : ApiAccountFacebook
 		, p_reset = null # : boolean
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/friend/facebook"
-		var query_params = ""
+		var query_params := ""
 		if p_reset != null:
 			query_params += "reset=%s&" % str(bool(p_reset)).to_lower()
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ImportFacebookFriends", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Import Steam friends and add them to a user's account.
 	func import_steam_friends_async(
 		p_session : NakamaSession
 		, p_account : ApiAccountSteam
 		, p_reset = null # : boolean
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/friend/steam"
-		var query_params = ""
+		var query_params := ""
 		if p_reset != null:
 			query_params += "reset=%s&" % str(bool(p_reset)).to_lower()
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_account.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ImportSteamFriends", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List groups based on given filters.
 	func list_groups_async(
@@ -4819,322 +16673,421 @@ class ApiClient extends RefCounted:
 		, p_lang_tag = null # : string
 		, p_members = null # : integer
 		, p_open = null # : boolean
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiGroupList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiGroupList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group"
-		var query_params = ""
+		var query_params := ""
 		if p_name != null:
-			query_params += "name=%s&" % NakamaSerializer.escape_http(p_name)
+			query_params += "name=%s&" % NakamaSerializer.escape_http(str(p_name))
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_lang_tag != null:
-			query_params += "lang_tag=%s&" % NakamaSerializer.escape_http(p_lang_tag)
+			query_params += "lang_tag=%s&" % NakamaSerializer.escape_http(str(p_lang_tag))
 		if p_members != null:
 			query_params += "members=%d&" % p_members
 		if p_open != null:
 			query_params += "open=%s&" % str(bool(p_open)).to_lower()
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListGroups", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiGroupList.new(result)
-		var out : ApiGroupList = NakamaSerializer.deserialize(_namespace, "ApiGroupList", result)
+		var body : Dictionary = result
+		var out : ApiGroupList = ApiGroupList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_groups_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_groups_pages_async(
+		p_session : NakamaSession
+		, p_name = null # : string
+		, p_cursor = null # : string
+		, p_limit = null # : integer
+		, p_lang_tag = null # : string
+		, p_members = null # : integer
+		, p_open = null # : boolean
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiGroupList = await list_groups_async(p_session, p_name, cursor, p_limit, p_lang_tag, p_members, p_open, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_groups_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_groups_async until there are no more or at least p_max_items groups were received (0 for no limit), and return them as one ApiGroupList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_groups_all_async(
+		p_session : NakamaSession
+		, p_name = null # : string
+		, p_cursor = null # : string
+		, p_limit = null # : integer
+		, p_lang_tag = null # : string
+		, p_members = null # : integer
+		, p_open = null # : boolean
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiGroupList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiGroupList
+		while true:
+			page = await list_groups_async(p_session, p_name, cursor, p_limit, p_lang_tag, p_members, p_open, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_groups_count()
+			for i in count:
+				items.append(page.get_groups_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._groups = items
+		page._groups_lazy = false
+		return page
+
 	## Create a new group with the current user as the owner.
 	func create_group_async(
 		p_session : NakamaSession
 		, p_body : ApiCreateGroupRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiGroup:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiGroup.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("CreateGroup", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiGroup.new(result)
-		var out : ApiGroup = NakamaSerializer.deserialize(_namespace, "ApiGroup", result)
+		var body : Dictionary = result
+		var out : ApiGroup = ApiGroup._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Delete a group by ID.
 	func delete_group_async(
 		p_session : NakamaSession
 		, p_group_id : String
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteGroup", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Update fields in a given group.
 	func update_group_async(
 		p_session : NakamaSession
 		, p_group_id : String
 		, p_body : ApiUpdateGroupRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("UpdateGroup", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Add users to a group.
 	func add_group_users_async(
 		p_session : NakamaSession
 		, p_group_id : String
 		, p_user_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/add"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
+		var query_params := ""
 		if p_user_ids != null:
 			for elem in p_user_ids:
 				query_params += "user_ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("AddGroupUsers", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Ban a set of users from a group.
 	func ban_group_users_async(
 		p_session : NakamaSession
 		, p_group_id : String
 		, p_user_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/ban"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
+		var query_params := ""
 		if p_user_ids != null:
 			for elem in p_user_ids:
 				query_params += "user_ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("BanGroupUsers", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Demote a set of users in a group to the next role down.
 	func demote_group_users_async(
 		p_session : NakamaSession
 		, p_group_id : String
 		, p_user_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/demote"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
+		var query_params := ""
 		if p_user_ids != null:
 			for elem in p_user_ids:
 				query_params += "user_ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DemoteGroupUsers", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Immediately join an open group, or request to join a closed one.
 	func join_group_async(
 		p_session : NakamaSession
 		, p_group_id : String
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/join"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("JoinGroup", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Kick a set of users from a group.
 	func kick_group_users_async(
 		p_session : NakamaSession
 		, p_group_id : String
 		, p_user_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/kick"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
+		var query_params := ""
 		if p_user_ids != null:
 			for elem in p_user_ids:
 				query_params += "user_ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("KickGroupUsers", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Leave a group the user is a member of.
 	func leave_group_async(
 		p_session : NakamaSession
 		, p_group_id : String
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/leave"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("LeaveGroup", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Promote a set of users in a group to the next role up.
 	func promote_group_users_async(
 		p_session : NakamaSession
 		, p_group_id : String
 		, p_user_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/promote"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
+		var query_params := ""
 		if p_user_ids != null:
 			for elem in p_user_ids:
 				query_params += "user_ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("PromoteGroupUsers", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List all users that are part of a group.
 	func list_group_users_async(
@@ -5143,249 +17096,385 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_state = null # : integer
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiGroupUserList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiGroupUserList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/group/{groupId}/user"
 		urlpath = urlpath.replace("{groupId}", NakamaSerializer.escape_http(p_group_id))
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_state != null:
 			query_params += "state=%d&" % p_state
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListGroupUsers", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiGroupUserList.new(result)
-		var out : ApiGroupUserList = NakamaSerializer.deserialize(_namespace, "ApiGroupUserList", result)
+		var body : Dictionary = result
+		var out : ApiGroupUserList = ApiGroupUserList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_group_users_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_group_users_pages_async(
+		p_session : NakamaSession
+		, p_group_id : String
+		, p_limit = null # : integer
+		, p_state = null # : integer
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiGroupUserList = await list_group_users_async(p_session, p_group_id, p_limit, p_state, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_group_users_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_group_users_async until there are no more or at least p_max_items group_users were received (0 for no limit), and return them as one ApiGroupUserList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_group_users_all_async(
+		p_session : NakamaSession
+		, p_group_id : String
+		, p_limit = null # : integer
+		, p_state = null # : integer
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiGroupUserList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiGroupUserList
+		while true:
+			page = await list_group_users_async(p_session, p_group_id, p_limit, p_state, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_group_users_count()
+			for i in count:
+				items.append(page.get_group_users_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._group_users = items
+		page._group_users_lazy = false
+		return page
+
 	## Validate Apple IAP Receipt
 	func validate_purchase_apple_async(
 		p_session : NakamaSession
 		, p_body : ApiValidatePurchaseAppleRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiValidatePurchaseResponse:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiValidatePurchaseResponse.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/purchase/apple"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ValidatePurchaseApple", trace, method, uri, headers, content, p_cancel_token, false, 30)
 		if result is NakamaException:
 			return ApiValidatePurchaseResponse.new(result)
-		var out : ApiValidatePurchaseResponse = NakamaSerializer.deserialize(_namespace, "ApiValidatePurchaseResponse", result)
+		var body : Dictionary = result
+		var out : ApiValidatePurchaseResponse = ApiValidatePurchaseResponse._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Validate Google IAP Receipt
 	func validate_purchase_google_async(
 		p_session : NakamaSession
 		, p_body : ApiValidatePurchaseGoogleRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiValidatePurchaseResponse:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiValidatePurchaseResponse.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/purchase/google"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ValidatePurchaseGoogle", trace, method, uri, headers, content, p_cancel_token, false, 30)
 		if result is NakamaException:
 			return ApiValidatePurchaseResponse.new(result)
-		var out : ApiValidatePurchaseResponse = NakamaSerializer.deserialize(_namespace, "ApiValidatePurchaseResponse", result)
+		var body : Dictionary = result
+		var out : ApiValidatePurchaseResponse = ApiValidatePurchaseResponse._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Validate Huawei IAP Receipt
 	func validate_purchase_huawei_async(
 		p_session : NakamaSession
 		, p_body : ApiValidatePurchaseHuaweiRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiValidatePurchaseResponse:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiValidatePurchaseResponse.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/purchase/huawei"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ValidatePurchaseHuawei", trace, method, uri, headers, content, p_cancel_token, false, 30)
 		if result is NakamaException:
 			return ApiValidatePurchaseResponse.new(result)
-		var out : ApiValidatePurchaseResponse = NakamaSerializer.deserialize(_namespace, "ApiValidatePurchaseResponse", result)
+		var body : Dictionary = result
+		var out : ApiValidatePurchaseResponse = ApiValidatePurchaseResponse._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## List user's subscriptions.
 	func list_subscriptions_async(
 		p_session : NakamaSession
 		, p_body : ApiListSubscriptionsRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiSubscriptionList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiSubscriptionList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/subscription"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListSubscriptions", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiSubscriptionList.new(result)
-		var out : ApiSubscriptionList = NakamaSerializer.deserialize(_namespace, "ApiSubscriptionList", result)
+		var body : Dictionary = result
+		var out : ApiSubscriptionList = ApiSubscriptionList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_subscriptions_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_subscriptions_pages_async(
+		p_session : NakamaSession
+		, p_body : ApiListSubscriptionsRequest
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var body := p_body.duplicate_deep()
+		var cursor = body._cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiSubscriptionList = await list_subscriptions_async(p_session, body, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_validated_subscriptions_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+			body.cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_subscriptions_async until there are no more or at least p_max_items validated_subscriptions were received (0 for no limit), and return them as one ApiSubscriptionList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_subscriptions_all_async(
+		p_session : NakamaSession
+		, p_body : ApiListSubscriptionsRequest
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiSubscriptionList:
+		var items := []
+		var body := p_body.duplicate_deep()
+		var cursor = body._cursor
+		var page : ApiSubscriptionList
+		while true:
+			page = await list_subscriptions_async(p_session, body, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_validated_subscriptions_count()
+			for i in count:
+				items.append(page.get_validated_subscriptions_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+			body.cursor = page.cursor
+		page._validated_subscriptions = items
+		page._validated_subscriptions_lazy = false
+		return page
+
 	## Validate Apple Subscription Receipt
 	func validate_subscription_apple_async(
 		p_session : NakamaSession
 		, p_body : ApiValidateSubscriptionAppleRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiValidateSubscriptionResponse:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiValidateSubscriptionResponse.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/subscription/apple"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ValidateSubscriptionApple", trace, method, uri, headers, content, p_cancel_token, false, 30)
 		if result is NakamaException:
 			return ApiValidateSubscriptionResponse.new(result)
-		var out : ApiValidateSubscriptionResponse = NakamaSerializer.deserialize(_namespace, "ApiValidateSubscriptionResponse", result)
+		var body : Dictionary = result
+		var out : ApiValidateSubscriptionResponse = ApiValidateSubscriptionResponse._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Validate Google Subscription Receipt
 	func validate_subscription_google_async(
 		p_session : NakamaSession
 		, p_body : ApiValidateSubscriptionGoogleRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiValidateSubscriptionResponse:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiValidateSubscriptionResponse.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/subscription/google"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ValidateSubscriptionGoogle", trace, method, uri, headers, content, p_cancel_token, false, 30)
 		if result is NakamaException:
 			return ApiValidateSubscriptionResponse.new(result)
-		var out : ApiValidateSubscriptionResponse = NakamaSerializer.deserialize(_namespace, "ApiValidateSubscriptionResponse", result)
+		var body : Dictionary = result
+		var out : ApiValidateSubscriptionResponse = ApiValidateSubscriptionResponse._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Get subscription by product id.
 	func get_subscription_async(
 		p_session : NakamaSession
 		, p_product_id : String
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiValidatedSubscription:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiValidatedSubscription.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/iap/subscription/{productId}"
 		urlpath = urlpath.replace("{productId}", NakamaSerializer.escape_http(p_product_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetSubscription", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiValidatedSubscription.new(result)
-		var out : ApiValidatedSubscription = NakamaSerializer.deserialize(_namespace, "ApiValidatedSubscription", result)
+		var body : Dictionary = result
+		var out : ApiValidatedSubscription = ApiValidatedSubscription._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Delete a leaderboard record.
 	func delete_leaderboard_record_async(
 		p_session : NakamaSession
 		, p_leaderboard_id : String
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/leaderboard/{leaderboardId}"
 		urlpath = urlpath.replace("{leaderboardId}", NakamaSerializer.escape_http(p_leaderboard_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteLeaderboardRecord", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List leaderboard records.
 	func list_leaderboard_records_async(
@@ -5395,65 +17484,126 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_cursor = null # : string
 		, p_expiry = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiLeaderboardRecordList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiLeaderboardRecordList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/leaderboard/{leaderboardId}"
 		urlpath = urlpath.replace("{leaderboardId}", NakamaSerializer.escape_http(p_leaderboard_id))
-		var query_params = ""
+		var query_params := ""
 		if p_owner_ids != null:
 			for elem in p_owner_ids:
 				query_params += "owner_ids=%s&" % elem
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
 		if p_expiry != null:
-			query_params += "expiry=%s&" % NakamaSerializer.escape_http(p_expiry)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "expiry=%s&" % NakamaSerializer.escape_http(str(p_expiry))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListLeaderboardRecords", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiLeaderboardRecordList.new(result)
-		var out : ApiLeaderboardRecordList = NakamaSerializer.deserialize(_namespace, "ApiLeaderboardRecordList", result)
+		var body : Dictionary = result
+		var out : ApiLeaderboardRecordList = ApiLeaderboardRecordList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_leaderboard_records_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_leaderboard_records_pages_async(
+		p_session : NakamaSession
+		, p_leaderboard_id : String
+		, p_owner_ids = null # : array
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_expiry = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiLeaderboardRecordList = await list_leaderboard_records_async(p_session, p_leaderboard_id, p_owner_ids, p_limit, cursor, p_expiry, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_records_count() == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			cursor = page.next_cursor
+		return pages
+
+	## Fetch the pages of list_leaderboard_records_async until there are no more or at least p_max_items records were received (0 for no limit), and return them as one ApiLeaderboardRecordList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_leaderboard_records_all_async(
+		p_session : NakamaSession
+		, p_leaderboard_id : String
+		, p_owner_ids = null # : array
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_expiry = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiLeaderboardRecordList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiLeaderboardRecordList
+		while true:
+			page = await list_leaderboard_records_async(p_session, p_leaderboard_id, p_owner_ids, p_limit, cursor, p_expiry, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_records_count()
+			for i in count:
+				items.append(page.get_records_at(i))
+			if count == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.next_cursor
+		page._records = items
+		page._records_lazy = false
+		return page
+
 	## Write a record to a leaderboard.
 	func write_leaderboard_record_async(
 		p_session : NakamaSession
 		, p_leaderboard_id : String
 		, p_record : WriteLeaderboardRecordRequestLeaderboardRecordWrite
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiLeaderboardRecord:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiLeaderboardRecord.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/leaderboard/{leaderboardId}"
 		urlpath = urlpath.replace("{leaderboardId}", NakamaSerializer.escape_http(p_leaderboard_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_record.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("WriteLeaderboardRecord", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiLeaderboardRecord.new(result)
-		var out : ApiLeaderboardRecord = NakamaSerializer.deserialize(_namespace, "ApiLeaderboardRecord", result)
+		var body : Dictionary = result
+		var out : ApiLeaderboardRecord = ApiLeaderboardRecord._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## List leaderboard records that belong to a user.
@@ -5464,8 +17614,9 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_expiry = null # : string
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiLeaderboardRecordList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiLeaderboardRecordList.new(try_refresh.get_exception())
@@ -5473,27 +17624,83 @@ class ApiClient extends RefCounted:
 		var urlpath : String = "/v2/leaderboard/{leaderboardId}/owner/{ownerId}"
 		urlpath = urlpath.replace("{leaderboardId}", NakamaSerializer.escape_http(p_leaderboard_id))
 		urlpath = urlpath.replace("{ownerId}", NakamaSerializer.escape_http(p_owner_id))
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_expiry != null:
-			query_params += "expiry=%s&" % NakamaSerializer.escape_http(p_expiry)
+			query_params += "expiry=%s&" % NakamaSerializer.escape_http(str(p_expiry))
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListLeaderboardRecordsAroundOwner", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiLeaderboardRecordList.new(result)
-		var out : ApiLeaderboardRecordList = NakamaSerializer.deserialize(_namespace, "ApiLeaderboardRecordList", result)
+		var body : Dictionary = result
+		var out : ApiLeaderboardRecordList = ApiLeaderboardRecordList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_leaderboard_records_around_owner_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_leaderboard_records_around_owner_pages_async(
+		p_session : NakamaSession
+		, p_leaderboard_id : String
+		, p_owner_id : String
+		, p_limit = null # : integer
+		, p_expiry = null # : string
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiLeaderboardRecordList = await list_leaderboard_records_around_owner_async(p_session, p_leaderboard_id, p_owner_id, p_limit, p_expiry, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_records_count() == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			cursor = page.next_cursor
+		return pages
+
+	## Fetch the pages of list_leaderboard_records_around_owner_async until there are no more or at least p_max_items records were received (0 for no limit), and return them as one ApiLeaderboardRecordList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_leaderboard_records_around_owner_all_async(
+		p_session : NakamaSession
+		, p_leaderboard_id : String
+		, p_owner_id : String
+		, p_limit = null # : integer
+		, p_expiry = null # : string
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiLeaderboardRecordList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiLeaderboardRecordList
+		while true:
+			page = await list_leaderboard_records_around_owner_async(p_session, p_leaderboard_id, p_owner_id, p_limit, p_expiry, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_records_count()
+			for i in count:
+				items.append(page.get_records_at(i))
+			if count == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.next_cursor
+		page._records = items
+		page._records_lazy = false
+		return page
+
 	## Fetch list of running matches.
 	func list_matches_async(
 		p_session : NakamaSession
@@ -5503,126 +17710,189 @@ class ApiClient extends RefCounted:
 		, p_min_size = null # : integer
 		, p_max_size = null # : integer
 		, p_query = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiMatchList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiMatchList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/match"
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_authoritative != null:
 			query_params += "authoritative=%s&" % str(bool(p_authoritative)).to_lower()
 		if p_label != null:
-			query_params += "label=%s&" % NakamaSerializer.escape_http(p_label)
+			query_params += "label=%s&" % NakamaSerializer.escape_http(str(p_label))
 		if p_min_size != null:
 			query_params += "min_size=%d&" % p_min_size
 		if p_max_size != null:
 			query_params += "max_size=%d&" % p_max_size
 		if p_query != null:
-			query_params += "query=%s&" % NakamaSerializer.escape_http(p_query)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "query=%s&" % NakamaSerializer.escape_http(str(p_query))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListMatches", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiMatchList.new(result)
-		var out : ApiMatchList = NakamaSerializer.deserialize(_namespace, "ApiMatchList", result)
+		var body : Dictionary = result
+		var out : ApiMatchList = ApiMatchList._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Delete one or more notifications for the current user.
 	func delete_notifications_async(
 		p_session : NakamaSession
 		, p_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/notification"
-		var query_params = ""
+		var query_params := ""
 		if p_ids != null:
 			for elem in p_ids:
 				query_params += "ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "DELETE"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "DELETE"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteNotifications", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Fetch list of notifications.
 	func list_notifications_async(
 		p_session : NakamaSession
 		, p_limit = null # : integer
 		, p_cacheable_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiNotificationList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiNotificationList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/notification"
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_cacheable_cursor != null:
-			query_params += "cacheable_cursor=%s&" % NakamaSerializer.escape_http(p_cacheable_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cacheable_cursor=%s&" % NakamaSerializer.escape_http(str(p_cacheable_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListNotifications", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiNotificationList.new(result)
-		var out : ApiNotificationList = NakamaSerializer.deserialize(_namespace, "ApiNotificationList", result)
+		var body : Dictionary = result
+		var out : ApiNotificationList = ApiNotificationList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_notifications_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_notifications_pages_async(
+		p_session : NakamaSession
+		, p_limit = null # : integer
+		, p_cacheable_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cacheable_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiNotificationList = await list_notifications_async(p_session, p_limit, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_notifications_count() == 0 or page.cacheable_cursor == "" or page.cacheable_cursor == cursor:
+				break
+			cursor = page.cacheable_cursor
+		return pages
+
+	## Fetch the pages of list_notifications_async until there are no more or at least p_max_items notifications were received (0 for no limit), and return them as one ApiNotificationList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_notifications_all_async(
+		p_session : NakamaSession
+		, p_limit = null # : integer
+		, p_cacheable_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiNotificationList:
+		var items := []
+		var cursor = p_cacheable_cursor
+		var page : ApiNotificationList
+		while true:
+			page = await list_notifications_async(p_session, p_limit, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_notifications_count()
+			for i in count:
+				items.append(page.get_notifications_at(i))
+			if count == 0 or page.cacheable_cursor == "" or page.cacheable_cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cacheable_cursor
+		page._notifications = items
+		page._notifications_lazy = false
+		return page
+
 	## Execute a Lua function on the server.
 	func rpc_func2_async(
 		p_bearer_token : String
 		, p_id : String
 		, p_payload = null # : string
 		, p_http_key = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiRpc:
 		var urlpath : String = "/v2/rpc/{id}"
 		urlpath = urlpath.replace("{id}", NakamaSerializer.escape_http(p_id))
-		var query_params = ""
+		var query_params := ""
 		if p_payload != null:
-			query_params += "payload=%s&" % NakamaSerializer.escape_http(p_payload)
+			query_params += "payload=%s&" % NakamaSerializer.escape_http(str(p_payload))
 		if p_http_key != null:
-			query_params += "http_key=%s&" % NakamaSerializer.escape_http(p_http_key)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
+			query_params += "http_key=%s&" % NakamaSerializer.escape_http(str(p_http_key))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
 		if (p_bearer_token):
-			var header = "Bearer %s" % p_bearer_token
+			var header := "Bearer %s" % p_bearer_token
 			headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("RpcFunc2", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiRpc.new(result)
-		var out : ApiRpc = NakamaSerializer.deserialize(_namespace, "ApiRpc", result)
+		var body : Dictionary = result
+		var out : ApiRpc = ApiRpc._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Execute a Lua function on the server.
@@ -5631,133 +17901,153 @@ class ApiClient extends RefCounted:
 		, p_id : String
 		, p_payload : String
 		, p_http_key = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiRpc:
 		var urlpath : String = "/v2/rpc/{id}"
 		urlpath = urlpath.replace("{id}", NakamaSerializer.escape_http(p_id))
-		var query_params = ""
+		var query_params := ""
 		if p_http_key != null:
-			query_params += "http_key=%s&" % NakamaSerializer.escape_http(p_http_key)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
+			query_params += "http_key=%s&" % NakamaSerializer.escape_http(str(p_http_key))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
 		if (p_bearer_token):
-			var header = "Bearer %s" % p_bearer_token
+			var header := "Bearer %s" % p_bearer_token
 			headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_payload).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("RpcFunc", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiRpc.new(result)
-		var out : ApiRpc = NakamaSerializer.deserialize(_namespace, "ApiRpc", result)
+		var body : Dictionary = result
+		var out : ApiRpc = ApiRpc._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
 	func session_logout_async(
 		p_session : NakamaSession
 		, p_body : ApiSessionLogoutRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/session/logout"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("SessionLogout", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## Get storage objects.
 	func read_storage_objects_async(
 		p_session : NakamaSession
 		, p_body : ApiReadStorageObjectsRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiStorageObjects:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiStorageObjects.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/storage"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ReadStorageObjects", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiStorageObjects.new(result)
-		var out : ApiStorageObjects = NakamaSerializer.deserialize(_namespace, "ApiStorageObjects", result)
+		var body : Dictionary = result
+		var out : ApiStorageObjects = ApiStorageObjects._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Write objects into the storage engine.
 	func write_storage_objects_async(
 		p_session : NakamaSession
 		, p_body : ApiWriteStorageObjectsRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiStorageObjectAcks:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiStorageObjectAcks.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/storage"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("WriteStorageObjects", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiStorageObjectAcks.new(result)
-		var out : ApiStorageObjectAcks = NakamaSerializer.deserialize(_namespace, "ApiStorageObjectAcks", result)
+		var body : Dictionary = result
+		var out : ApiStorageObjectAcks = ApiStorageObjectAcks._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Delete one or more objects by ID or username.
 	func delete_storage_objects_async(
 		p_session : NakamaSession
 		, p_body : ApiDeleteStorageObjectsRequest
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/storage/delete"
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_body.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("DeleteStorageObjects", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List publicly readable storage objects in a given collection.
 	func list_storage_objects_async(
@@ -5766,35 +18056,90 @@ class ApiClient extends RefCounted:
 		, p_user_id = null # : string
 		, p_limit = null # : integer
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiStorageObjectList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiStorageObjectList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/storage/{collection}"
 		urlpath = urlpath.replace("{collection}", NakamaSerializer.escape_http(p_collection))
-		var query_params = ""
+		var query_params := ""
 		if p_user_id != null:
-			query_params += "user_id=%s&" % NakamaSerializer.escape_http(p_user_id)
+			query_params += "user_id=%s&" % NakamaSerializer.escape_http(str(p_user_id))
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListStorageObjects", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiStorageObjectList.new(result)
-		var out : ApiStorageObjectList = NakamaSerializer.deserialize(_namespace, "ApiStorageObjectList", result)
+		var body : Dictionary = result
+		var out : ApiStorageObjectList = ApiStorageObjectList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_storage_objects_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_storage_objects_pages_async(
+		p_session : NakamaSession
+		, p_collection : String
+		, p_user_id = null # : string
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiStorageObjectList = await list_storage_objects_async(p_session, p_collection, p_user_id, p_limit, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_objects_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_storage_objects_async until there are no more or at least p_max_items objects were received (0 for no limit), and return them as one ApiStorageObjectList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_storage_objects_all_async(
+		p_session : NakamaSession
+		, p_collection : String
+		, p_user_id = null # : string
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiStorageObjectList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiStorageObjectList
+		while true:
+			page = await list_storage_objects_async(p_session, p_collection, p_user_id, p_limit, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_objects_count()
+			for i in count:
+				items.append(page.get_objects_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._objects = items
+		page._objects_lazy = false
+		return page
+
 	## List publicly readable storage objects in a given collection.
 	func list_storage_objects2_async(
 		p_session : NakamaSession
@@ -5802,8 +18147,9 @@ class ApiClient extends RefCounted:
 		, p_user_id : String
 		, p_limit = null # : integer
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiStorageObjectList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiStorageObjectList.new(try_refresh.get_exception())
@@ -5811,25 +18157,79 @@ class ApiClient extends RefCounted:
 		var urlpath : String = "/v2/storage/{collection}/{userId}"
 		urlpath = urlpath.replace("{collection}", NakamaSerializer.escape_http(p_collection))
 		urlpath = urlpath.replace("{userId}", NakamaSerializer.escape_http(p_user_id))
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListStorageObjects2", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiStorageObjectList.new(result)
-		var out : ApiStorageObjectList = NakamaSerializer.deserialize(_namespace, "ApiStorageObjectList", result)
+		var body : Dictionary = result
+		var out : ApiStorageObjectList = ApiStorageObjectList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_storage_objects2_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_storage_objects2_pages_async(
+		p_session : NakamaSession
+		, p_collection : String
+		, p_user_id : String
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiStorageObjectList = await list_storage_objects2_async(p_session, p_collection, p_user_id, p_limit, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_objects_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_storage_objects2_async until there are no more or at least p_max_items objects were received (0 for no limit), and return them as one ApiStorageObjectList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_storage_objects2_all_async(
+		p_session : NakamaSession
+		, p_collection : String
+		, p_user_id : String
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiStorageObjectList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiStorageObjectList
+		while true:
+			page = await list_storage_objects2_async(p_session, p_collection, p_user_id, p_limit, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_objects_count()
+			for i in count:
+				items.append(page.get_objects_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._objects = items
+		page._objects_lazy = false
+		return page
+
 	## List current or upcoming tournaments.
 	func list_tournaments_async(
 		p_session : NakamaSession
@@ -5839,14 +18239,15 @@ class ApiClient extends RefCounted:
 		, p_end_time = null # : integer
 		, p_limit = null # : integer
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiTournamentList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiTournamentList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/tournament"
-		var query_params = ""
+		var query_params := ""
 		if p_category_start != null:
 			query_params += "category_start=%d&" % p_category_start
 		if p_category_end != null:
@@ -5858,21 +18259,79 @@ class ApiClient extends RefCounted:
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListTournaments", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiTournamentList.new(result)
-		var out : ApiTournamentList = NakamaSerializer.deserialize(_namespace, "ApiTournamentList", result)
+		var body : Dictionary = result
+		var out : ApiTournamentList = ApiTournamentList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_tournaments_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_tournaments_pages_async(
+		p_session : NakamaSession
+		, p_category_start = null # : integer
+		, p_category_end = null # : integer
+		, p_start_time = null # : integer
+		, p_end_time = null # : integer
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiTournamentList = await list_tournaments_async(p_session, p_category_start, p_category_end, p_start_time, p_end_time, p_limit, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_tournaments_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_tournaments_async until there are no more or at least p_max_items tournaments were received (0 for no limit), and return them as one ApiTournamentList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_tournaments_all_async(
+		p_session : NakamaSession
+		, p_category_start = null # : integer
+		, p_category_end = null # : integer
+		, p_start_time = null # : integer
+		, p_end_time = null # : integer
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiTournamentList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiTournamentList
+		while true:
+			page = await list_tournaments_async(p_session, p_category_start, p_category_end, p_start_time, p_end_time, p_limit, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_tournaments_count()
+			for i in count:
+				items.append(page.get_tournaments_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._tournaments = items
+		page._tournaments_lazy = false
+		return page
+
 	## List tournament records.
 	func list_tournament_records_async(
 		p_session : NakamaSession
@@ -5881,65 +18340,126 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_cursor = null # : string
 		, p_expiry = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiTournamentRecordList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiTournamentRecordList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/tournament/{tournamentId}"
 		urlpath = urlpath.replace("{tournamentId}", NakamaSerializer.escape_http(p_tournament_id))
-		var query_params = ""
+		var query_params := ""
 		if p_owner_ids != null:
 			for elem in p_owner_ids:
 				query_params += "owner_ids=%s&" % elem
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
 		if p_expiry != null:
-			query_params += "expiry=%s&" % NakamaSerializer.escape_http(p_expiry)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "expiry=%s&" % NakamaSerializer.escape_http(str(p_expiry))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListTournamentRecords", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiTournamentRecordList.new(result)
-		var out : ApiTournamentRecordList = NakamaSerializer.deserialize(_namespace, "ApiTournamentRecordList", result)
+		var body : Dictionary = result
+		var out : ApiTournamentRecordList = ApiTournamentRecordList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_tournament_records_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_tournament_records_pages_async(
+		p_session : NakamaSession
+		, p_tournament_id : String
+		, p_owner_ids = null # : array
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_expiry = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiTournamentRecordList = await list_tournament_records_async(p_session, p_tournament_id, p_owner_ids, p_limit, cursor, p_expiry, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_records_count() == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			cursor = page.next_cursor
+		return pages
+
+	## Fetch the pages of list_tournament_records_async until there are no more or at least p_max_items records were received (0 for no limit), and return them as one ApiTournamentRecordList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_tournament_records_all_async(
+		p_session : NakamaSession
+		, p_tournament_id : String
+		, p_owner_ids = null # : array
+		, p_limit = null # : integer
+		, p_cursor = null # : string
+		, p_expiry = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiTournamentRecordList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiTournamentRecordList
+		while true:
+			page = await list_tournament_records_async(p_session, p_tournament_id, p_owner_ids, p_limit, cursor, p_expiry, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_records_count()
+			for i in count:
+				items.append(page.get_records_at(i))
+			if count == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.next_cursor
+		page._records = items
+		page._records_lazy = false
+		return page
+
 	## Write a record to a tournament.
 	func write_tournament_record2_async(
 		p_session : NakamaSession
 		, p_tournament_id : String
 		, p_record : WriteTournamentRecordRequestTournamentRecordWrite
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiLeaderboardRecord:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiLeaderboardRecord.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/tournament/{tournamentId}"
 		urlpath = urlpath.replace("{tournamentId}", NakamaSerializer.escape_http(p_tournament_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_record.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("WriteTournamentRecord2", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiLeaderboardRecord.new(result)
-		var out : ApiLeaderboardRecord = NakamaSerializer.deserialize(_namespace, "ApiLeaderboardRecord", result)
+		var body : Dictionary = result
+		var out : ApiLeaderboardRecord = ApiLeaderboardRecord._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Write a record to a tournament.
@@ -5947,55 +18467,63 @@ class ApiClient extends RefCounted:
 		p_session : NakamaSession
 		, p_tournament_id : String
 		, p_record : WriteTournamentRecordRequestTournamentRecordWrite
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiLeaderboardRecord:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiLeaderboardRecord.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/tournament/{tournamentId}"
 		urlpath = urlpath.replace("{tournamentId}", NakamaSerializer.escape_http(p_tournament_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "PUT"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "PUT"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 		content = JSON.stringify(p_record.serialize()).to_utf8_buffer()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("WriteTournamentRecord", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return ApiLeaderboardRecord.new(result)
-		var out : ApiLeaderboardRecord = NakamaSerializer.deserialize(_namespace, "ApiLeaderboardRecord", result)
+		var body : Dictionary = result
+		var out : ApiLeaderboardRecord = ApiLeaderboardRecord._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## Attempt to join an open and running tournament.
 	func join_tournament_async(
 		p_session : NakamaSession
 		, p_tournament_id : String
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> NakamaAsyncResult:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return NakamaAsyncResult.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/tournament/{tournamentId}/join"
 		urlpath = urlpath.replace("{tournamentId}", NakamaSerializer.escape_http(p_tournament_id))
-		var query_params = ""
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "POST"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var query_params := ""
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "POST"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("JoinTournament", trace, method, uri, headers, content, p_cancel_token, false)
 		if result is NakamaException:
 			return NakamaAsyncResult.new(result)
-		return NakamaAsyncResult.new()
+		var out := NakamaAsyncResult.new()
+		out._trace_id = trace
+		return out
 
 	## List tournament records for a given owner.
 	func list_tournament_records_around_owner_async(
@@ -6005,8 +18533,9 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_expiry = null # : string
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiTournamentRecordList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiTournamentRecordList.new(try_refresh.get_exception())
@@ -6014,41 +18543,98 @@ class ApiClient extends RefCounted:
 		var urlpath : String = "/v2/tournament/{tournamentId}/owner/{ownerId}"
 		urlpath = urlpath.replace("{tournamentId}", NakamaSerializer.escape_http(p_tournament_id))
 		urlpath = urlpath.replace("{ownerId}", NakamaSerializer.escape_http(p_owner_id))
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_expiry != null:
-			query_params += "expiry=%s&" % NakamaSerializer.escape_http(p_expiry)
+			query_params += "expiry=%s&" % NakamaSerializer.escape_http(str(p_expiry))
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListTournamentRecordsAroundOwner", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiTournamentRecordList.new(result)
-		var out : ApiTournamentRecordList = NakamaSerializer.deserialize(_namespace, "ApiTournamentRecordList", result)
+		var body : Dictionary = result
+		var out : ApiTournamentRecordList = ApiTournamentRecordList._from_dict(body)
+		out._trace_id = trace
 		return out
 
+	## Fetch the pages of list_tournament_records_around_owner_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_tournament_records_around_owner_pages_async(
+		p_session : NakamaSession
+		, p_tournament_id : String
+		, p_owner_id : String
+		, p_limit = null # : integer
+		, p_expiry = null # : string
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiTournamentRecordList = await list_tournament_records_around_owner_async(p_session, p_tournament_id, p_owner_id, p_limit, p_expiry, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_records_count() == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			cursor = page.next_cursor
+		return pages
+
+	## Fetch the pages of list_tournament_records_around_owner_async until there are no more or at least p_max_items records were received (0 for no limit), and return them as one ApiTournamentRecordList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_tournament_records_around_owner_all_async(
+		p_session : NakamaSession
+		, p_tournament_id : String
+		, p_owner_id : String
+		, p_limit = null # : integer
+		, p_expiry = null # : string
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiTournamentRecordList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiTournamentRecordList
+		while true:
+			page = await list_tournament_records_around_owner_async(p_session, p_tournament_id, p_owner_id, p_limit, p_expiry, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_records_count()
+			for i in count:
+				items.append(page.get_records_at(i))
+			if count == 0 or page.next_cursor == "" or page.next_cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.next_cursor
+		page._records = items
+		page._records_lazy = false
+		return page
+
 	## Fetch zero or more users by ID and/or username.
 	func get_users_async(
 		p_session : NakamaSession
 		, p_ids = null # : array
 		, p_usernames = null # : array
 		, p_facebook_ids = null # : array
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiUsers:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiUsers.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/user"
-		var query_params = ""
+		var query_params := ""
 		if p_ids != null:
 			for elem in p_ids:
 				query_params += "ids=%s&" % elem
@@ -6058,18 +18644,21 @@ class ApiClient extends RefCounted:
 		if p_facebook_ids != null:
 			for elem in p_facebook_ids:
 				query_params += "facebook_ids=%s&" % elem
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("GetUsers", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiUsers.new(result)
-		var out : ApiUsers = NakamaSerializer.deserialize(_namespace, "ApiUsers", result)
+		var body : Dictionary = result
+		var out : ApiUsers = ApiUsers._from_dict(body)
+		out._trace_id = trace
 		return out
 
 	## List groups the current user belongs to.
@@ -6079,31 +18668,86 @@ class ApiClient extends RefCounted:
 		, p_limit = null # : integer
 		, p_state = null # : integer
 		, p_cursor = null # : string
+		, p_cancel_token : NakamaCancellationToken = null
 	) -> ApiUserGroupList:
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : ApiSession = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return ApiUserGroupList.new(try_refresh.get_exception())
 			await p_session.refresh(try_refresh)
 		var urlpath : String = "/v2/user/{userId}/group"
 		urlpath = urlpath.replace("{userId}", NakamaSerializer.escape_http(p_user_id))
-		var query_params = ""
+		var query_params := ""
 		if p_limit != null:
 			query_params += "limit=%d&" % p_limit
 		if p_state != null:
 			query_params += "state=%d&" % p_state
 		if p_cursor != null:
-			query_params += "cursor=%s&" % NakamaSerializer.escape_http(p_cursor)
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "GET"
-		var headers = {}
-		var header = "Bearer %s" % p_session.token
+			query_params += "cursor=%s&" % NakamaSerializer.escape_http(str(p_cursor))
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "GET"
+		var headers := {}
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
 
-		var content : PackedByteArray
+		var content : PackedByteArray = PackedByteArray()
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("ListUserGroups", trace, method, uri, headers, content, p_cancel_token)
 		if result is NakamaException:
 			return ApiUserGroupList.new(result)
-		var out : ApiUserGroupList = NakamaSerializer.deserialize(_namespace, "ApiUserGroupList", result)
+		var body : Dictionary = result
+		var out : ApiUserGroupList = ApiUserGroupList._from_dict(body)
+		out._trace_id = trace
 		return out
+
+	## Fetch the pages of list_user_groups_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func list_user_groups_pages_async(
+		p_session : NakamaSession
+		, p_user_id : String
+		, p_limit = null # : integer
+		, p_state = null # : integer
+		, p_cursor = null # : string
+		, p_max_pages : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> Array:
+		var pages : Array = []
+		var cursor = p_cursor
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : ApiUserGroupList = await list_user_groups_async(p_session, p_user_id, p_limit, p_state, cursor, p_cancel_token)
+			pages.append(page)
+			if page.is_exception() or page.get_user_groups_count() == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			cursor = page.cursor
+		return pages
+
+	## Fetch the pages of list_user_groups_async until there are no more or at least p_max_items user_groups were received (0 for no limit), and return them as one ApiUserGroupList.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func list_user_groups_all_async(
+		p_session : NakamaSession
+		, p_user_id : String
+		, p_limit = null # : integer
+		, p_state = null # : integer
+		, p_cursor = null # : string
+		, p_max_items : int = 0
+		, p_cancel_token : NakamaCancellationToken = null
+	) -> ApiUserGroupList:
+		var items := []
+		var cursor = p_cursor
+		var page : ApiUserGroupList
+		while true:
+			page = await list_user_groups_async(p_session, p_user_id, p_limit, p_state, cursor, p_cancel_token)
+			if page.is_exception():
+				return page
+			var count := page.get_user_groups_count()
+			for i in count:
+				items.append(page.get_user_groups_at(i))
+			if count == 0 or page.cursor == "" or page.cursor == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+			cursor = page.cursor
+		page._user_groups = items
+		page._user_groups_lazy = false
+		return page
@@ -75,6 +75,10 @@ var _last_id : int = 1
 var _conn = null
 var logger : NakamaLogger = null
 
+## The trace ID prefixed to the IDs of the messages sent, "<trace id>-<n>", so server logs can be linked to the client ones. [br]
+## Sockets created with Nakama.create_socket_from() share the trace ID of the client, when it has one.
+var trace_id : String = NakamaTrace.new_trace_id()
+
 class AsyncConnection:
 	signal completed(result)
 
@@ -83,6 +87,7 @@ class AsyncConnection:
 
 class AsyncRequest:
 	var id : String
+	var trace_id : String
 	var type
 	var ns
 	var result_key : String
@@ -100,6 +105,7 @@ class AsyncRequest:
 
 	func resume(data, logger = null) -> void:
 		var result = _parse_result(data, logger)
+		result._trace_id = trace_id
 		emit_signal("completed", result)
 
 	func _parse_result(data, logger):
@@ -277,16 +283,17 @@ func _clear_requests():
 		_cancel_request(id)
 
 func _send_async(p_message, p_parse_type = NakamaAsyncResult, p_ns = NakamaRTAPI, p_msg_key = null, p_result_key = null) -> AsyncRequest:
-	logger.debug("Sending async request: %s" % p_message)
+	logger.debug("Sending async request [Trace: %s]: %s" % [trace_id, p_message])
 	# For messages coming from the API which does not have a key defined, so we can override it
 	var msg = p_msg_key
 	# For regular RT messages
 	if msg == null:
 		msg = p_message.get_msg_key()
-	var id = str(_last_id)
+	var id = "%s-%d" % [trace_id, _last_id] if trace_id else str(_last_id)
 	_last_id += 1
 
 	_requests[id] = AsyncRequest.new(id, p_parse_type, p_ns, p_result_key)
+	_requests[id].trace_id = trace_id
 
 	var json := JSON.stringify({
 		"cid": id,
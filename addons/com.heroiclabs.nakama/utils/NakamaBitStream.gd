@@ -0,0 +1,152 @@
+extends RefCounted
+
+## The binary encoding of the match state messages generated by codegen with -opcodes. [br]
+## Values are packed bit by bit, least significant bit first: flags take one bit, varints groups of 7 bits and a
+## continuation bit, and ranged ints and quantised floats the bits of their range. The last byte is padded with zeros.
+class_name NakamaBitStream
+
+## Writes values to a PackedByteArray.
+class Writer extends RefCounted:
+
+	var _bytes := PackedByteArray()
+	var _bit := 0
+
+	## Write the p_count lowest bits of p_value.
+	func write_bits(p_value : int, p_count : int) -> void:
+		for i in p_count:
+			if (_bit & 7) == 0:
+				_bytes.append(0)
+			if (p_value >> i) & 1:
+				_bytes[_bit >> 3] |= 1 << (_bit & 7)
+			_bit += 1
+
+	## Write a flag in one bit, and return it.
+	func write_flag(p_value : bool) -> bool:
+		write_bits(1 if p_value else 0, 1)
+		return p_value
+
+	## Write an unsigned varint, like a count or a sequence.
+	func write_uint(p_value : int) -> void:
+		var value := p_value
+		while true:
+			var group := value & 0x7F
+			value = (value >> 7) & 0x01FFFFFFFFFFFFFF
+			if value == 0:
+				write_bits(group, 8)
+				return
+			write_bits(group | 0x80, 8)
+
+	## Write a signed varint, zigzag encoded so small negative values are short too.
+	func write_int(p_value : int) -> void:
+		write_uint((p_value << 1) ^ (p_value >> 63))
+
+	## Write an int from p_min to p_min + p_steps, clamped to that range, in p_bits bits.
+	func write_ranged(p_value : int, p_min : int, p_steps : int, p_bits : int) -> void:
+		write_bits(clampi(p_value, p_min, p_min + p_steps) - p_min, p_bits)
+
+	## Write a float as the nearest of p_steps steps of p_precision from p_min, in p_bits bits.
+	func write_quantised(p_value : float, p_min : float, p_precision : float, p_steps : int, p_bits : int) -> void:
+		var step := 0.0
+		if not is_nan(p_value):
+			step = clampf(roundf((p_value - p_min) / p_precision), 0, p_steps)
+		write_bits(int(step), p_bits)
+
+	## Write a float in 64 bits.
+	func write_double(p_value : float) -> void:
+		var bytes := PackedByteArray()
+		bytes.resize(8)
+		bytes.encode_double(0, p_value)
+		write_bits(bytes.decode_s64(0), 64)
+
+	## Write bytes after their size.
+	func write_bytes(p_value : PackedByteArray) -> void:
+		write_uint(p_value.size())
+		for b in p_value:
+			write_bits(b, 8)
+
+	## Write a string as UTF-8 bytes.
+	func write_string(p_value : String) -> void:
+		write_bytes(p_value.to_utf8_buffer())
+
+	## The bytes written.
+	func get_bytes() -> PackedByteArray:
+		return _bytes
+
+## Reads values from a PackedByteArray.
+class Reader extends RefCounted:
+
+	## If a value was read past the end of the bytes. The values read since are zero.
+	var failed := false
+
+	var _bytes : PackedByteArray
+	var _bit := 0
+
+	func _init(p_bytes : PackedByteArray):
+		_bytes = p_bytes
+
+	## The number of bits left to read.
+	func remaining() -> int:
+		return _bytes.size() * 8 - _bit
+
+	## Read p_count bits.
+	func read_bits(p_count : int) -> int:
+		if failed or p_count > remaining():
+			failed = true
+			return 0
+		var value := 0
+		for i in p_count:
+			if _bytes[_bit >> 3] & (1 << (_bit & 7)):
+				value |= 1 << i
+			_bit += 1
+		return value
+
+	func read_flag() -> bool:
+		return read_bits(1) == 1
+
+	func read_uint() -> int:
+		var value := 0
+		for i in 10:
+			var group := read_bits(8)
+			value |= (group & 0x7F) << (7 * i)
+			if (group & 0x80) == 0:
+				return value
+		failed = true
+		return 0
+
+	func read_int() -> int:
+		var value := read_uint()
+		return ((value >> 1) & 0x7FFFFFFFFFFFFFFF) ^ -(value & 1)
+
+	## Read the size of an array, which fails when there are not as many bits left.
+	func read_count() -> int:
+		var count := read_uint()
+		if count < 0 or count > remaining():
+			failed = true
+			return 0
+		return count
+
+	func read_ranged(p_min : int, p_steps : int, p_bits : int) -> int:
+		return p_min + mini(read_bits(p_bits), p_steps)
+
+	func read_quantised(p_min : float, p_precision : float, p_steps : int, p_bits : int) -> float:
+		return p_min + mini(read_bits(p_bits), p_steps) * p_precision
+
+	func read_double() -> float:
+		var bytes := PackedByteArray()
+		bytes.resize(8)
+		bytes.encode_s64(0, read_bits(64))
+		return bytes.decode_double(0)
+
+	func read_bytes() -> PackedByteArray:
+		var size := read_uint()
+		if size < 0 or size > remaining() / 8:
+			failed = true
+			return PackedByteArray()
+		var out := PackedByteArray()
+		out.resize(size)
+		for i in size:
+			out[i] = read_bits(8)
+		return out
+
+	func read_string() -> String:
+		return read_bytes().get_string_from_utf8()
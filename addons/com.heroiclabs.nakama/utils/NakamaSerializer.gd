@@ -19,14 +19,17 @@ static func serialize(p_obj : Object) -> Dictionary:
 		match val_type:
 			TYPE_OBJECT: # Simple objects
 				out[k] = serialize(val)
-			TYPE_ARRAY: # Array of objects
+			TYPE_ARRAY: # Array of objects, or typed array of simple values
 				var arr = []
 				for e in val:
-					if typeof(e) != TYPE_OBJECT:
-						continue
-					arr.append(serialize(e))
+					if typeof(e) == TYPE_OBJECT:
+						arr.append(serialize(e))
+					elif typeof(e) == TYPE_DICTIONARY: # Lazy element not deserialized yet
+						arr.append(e)
+					elif content != TYPE_OBJECT and typeof(e) == content:
+						arr.append(e)
 				out[k] = arr
-			TYPE_PACKED_INT32_ARRAY, TYPE_PACKED_STRING_ARRAY: # Array of ints, bools, or strings
+			TYPE_PACKED_INT32_ARRAY, TYPE_PACKED_INT64_ARRAY, TYPE_PACKED_FLOAT64_ARRAY, TYPE_PACKED_STRING_ARRAY: # Array of ints, bools, floats or strings
 				var arr = []
 				for e in val:
 					if content == TYPE_BOOL:
@@ -57,6 +60,11 @@ static func serialize(p_obj : Object) -> Dictionary:
 				out[k] = dict
 			_:
 				out[k] = val
+	var unknown = p_obj.get("_unknown")
+	if typeof(unknown) == TYPE_DICTIONARY: # Fields unknown to the schema, kept for forward compatibility
+		for k in unknown:
+			if not out.has(k):
+				out[k] = unknown[k]
 	return out
 
 static func deserialize(p_ns : GDScript, p_cls_name : String, p_dict : Dictionary) -> Object:
@@ -65,6 +73,10 @@ static func deserialize(p_ns : GDScript, p_cls_name : String, p_dict : Dictionar
 	if schema == null:
 		return NakamaException.new() # No schema defined
 	var obj = cls.new()
+	if "_unknown" in obj: # Keep fields unknown to the schema so they survive a later serialize()
+		for k in p_dict:
+			if not schema.has(k):
+				obj._unknown[k] = p_dict[k]
 	for k in schema:
 		var prop = schema[k]
 		var pname = prop["name"]
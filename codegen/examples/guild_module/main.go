@@ -0,0 +1,135 @@
+// An example Nakama Go runtime module, to generate the typed wrappers of its RPCs with -rpcs.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+)
+
+const rpcJoinGuild = "join_guild"
+
+// GuildMember is a member of a guild.
+type GuildMember struct {
+	UserID string `json:"user_id"`
+	// The rank of the member, 0 for the leader.
+	Rank int `json:"rank"`
+}
+
+// Audit is embedded in the records stored by the module.
+type Audit struct {
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// The user who changed the record last.
+	Editor string `json:"editor,omitempty"`
+}
+
+// Guild is a group of players.
+type Guild struct {
+	Audit
+	ID      string             `json:"id"`
+	Name    string             `json:"name"`
+	Open    bool               `json:"open"`
+	Level   int                `json:"level,omitempty"`
+	Tags    []string           `json:"tags"`
+	Members []*GuildMember     `json:"members"`
+	Stats   map[string]float64 `json:"stats,omitempty"`
+	Leader  *GuildMember       `json:"leader,omitempty"`
+	Emblem  []byte             `json:"emblem,omitempty"` // A PNG image.
+	Secret  string             `json:"-"`
+	created int64
+}
+
+// PlayerProfile is stored in the profiles collection.
+type PlayerProfile struct {
+	Audit
+	DisplayName string                   `json:"display_name"`
+	Editor      string                   `json:"editor"` // Hides Audit.Editor.
+	Guild       *Guild                   `json:"guild,omitempty"`
+	Cooldown    time.Duration            `json:"cooldown"`
+	Settings    map[string]interface{}   `json:"settings,omitempty"`
+	Inventory   map[string]InventoryItem `json:"inventory"`
+	Friends     []string                 `json:"friends"`
+	Scores      [][]int                  `json:"scores,omitempty"`
+	Extra       json.RawMessage          `json:"extra,omitempty"`
+}
+
+type InventoryItem struct {
+	Count    int  `json:"count"`
+	Equipped bool `json:"equipped,omitempty"`
+}
+
+type CreateGuildRequest struct {
+	Name string   `json:"name"`
+	Open bool     `json:"open"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+type JoinGuildRequest struct {
+	GuildID string `json:"guild_id"`
+}
+
+type GuildList struct {
+	Guilds []Guild `json:"guilds"`
+	Cursor string  `json:"cursor,omitempty"`
+}
+
+type guildHandlers struct {
+	nk runtime.NakamaModule
+}
+
+func InitModule(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, initializer runtime.Initializer) error {
+	h := &guildHandlers{nk: nk}
+	if err := initializer.RegisterRpc("create_guild", rpcCreateGuild); err != nil {
+		return err
+	}
+	// Join an open guild.
+	if err := initializer.RegisterRpc(rpcJoinGuild, func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		var request JoinGuildRequest
+		if err := json.Unmarshal([]byte(payload), &request); err != nil {
+			return "", runtime.NewError("invalid request", 3)
+		}
+		out, err := json.Marshal(&Guild{ID: request.GuildID, Open: true})
+		return string(out), err
+	}); err != nil {
+		return err
+	}
+	if err := initializer.RegisterRpc("guild.search", h.searchGuilds); err != nil {
+		return err
+	}
+	return initializer.RegisterRpc("Ping", rpcPing)
+}
+
+// Create a guild led by the user.
+func rpcCreateGuild(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+	request := &CreateGuildRequest{}
+	if err := json.Unmarshal([]byte(payload), request); err != nil {
+		return "", runtime.NewError("invalid request", 3)
+	}
+	guild := Guild{Name: request.Name, Open: request.Open, Tags: request.Tags}
+	out, err := json.Marshal(guild)
+	return string(out), err
+}
+
+// Search the guilds by name.
+//
+//codegen:response GuildList
+func (h *guildHandlers) searchGuilds(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+	filters := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(payload), &filters); err != nil {
+		return "", runtime.NewError("invalid request", 3)
+	}
+	return respond(&GuildList{})
+}
+
+func rpcPing(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+	return "{}", nil
+}
+
+func respond(v interface{}) (string, error) {
+	out, err := json.Marshal(v)
+	return string(out), err
+}
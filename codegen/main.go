@@ -16,30 +16,55 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"io"
+	"math"
+	"math/bits"
 	"net/http"
 	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
+// Output options, set from the command line.
+var (
+	// Emit Array[T], PackedInt64Array and Array[bool] instead of untyped arrays (Godot 4.2+).
+	typedArrays bool
+	// Emit Dictionary[String, T] instead of untyped dictionaries (Godot 4.4+).
+	typedDictionaries bool
+	// Emit code which passes the untyped_declaration and unsafe_* warnings (Godot 4.2+).
+	strict bool
+	// Pass optional query parameters in a typed <Operation>Options object instead of untyped arguments.
+	optionObjects bool
+)
+
 var utilities = map[string]string{
 	"ApiAccount": `
 
-	var _wallet_dict = null
+	var _wallet_dict : Variant = null
 	var wallet_dict : Dictionary:
 		get:
 			if _wallet_dict == null:
 				if _wallet == null:
 					return {}
-				var json = JSON.new()
-				if json.parse(_wallet) != OK:
+				var json := JSON.new()
+				if json.parse(wallet) != OK:
 					return {}
 				_wallet_dict = json.get_data()
-			return _wallet_dict as Dictionary
+			return _wallet_dict
 `,
 }
 
@@ -51,23 +76,27 @@ class_name {{.ClassName}}API
 {{- $classname := $defname | title }}
 {{- if isRefToEnum $classname }}
 
-# {{ enumSummary $definition | stripNewlines }}
-{{- range $idx, $val := ($definition | enumDescriptions) }}
-# {{ $val }}
-{{- end -}}
-# {{ $definition | enumDescriptions }}
-enum {{ $classname | title }} { {{- range $idx, $enum := $definition.Enum }}{{ $enum }} = {{ $idx }},{{- end -}} }
-{{- else if or (eq $classname "ProtobufAny") (eq $classname "RpcStatus")}}
+## {{ enumSummary $definition | stripNewlines }}
+enum {{ $classname | title }} {
+{{- range $idx, $enum := $definition.Enum }}
+{{- with enumValueDoc $definition $enum }}
+	## {{ . }}
+{{- end }}
+	{{ $enum }} = {{ $idx }},
+{{- end }}
+}
 {{- else }}
-
-# {{ $definition.Description | stripNewlines }}
+{{ "" }}
+{{- with $definition.Description }}
+## {{ . | stripNewlines }}
+{{- end }}
 class {{ $classname }} extends {{.ClassName}}AsyncResult:
 
 	const _SCHEMA = {
 		{{- range $propname, $property := $definition.Properties }}
 		{{- $fieldname := $propname | pascalToSnake }}
 		{{- $_field := printf "_%s" $fieldname }}
-		{{- $gdType := godotType $property.Type $property.Ref $property.Items.Type $property.Items.Ref (isRefToEnum (cleanRef $property.Ref)) }}
+		{{- $gdType := propType $property }}
 		"{{ $fieldname }}": {"name": "{{ $_field }}", "type": {{ $gdType | godotSchemaType }}, "required": false
 		{{- if eq $property.Type "array" -}}
 			, "content": {{ (godotType $property.Items.Type $property.Items.Ref "" "" false) | godotSchemaType }}
@@ -81,37 +110,374 @@ class {{ $classname }} extends {{.ClassName}}AsyncResult:
         {{- range $propname, $property := $definition.Properties }}
         {{- $fieldname := $propname | pascalToSnake }}
         {{- $_field := printf "_%s" $fieldname }}
-        {{- $gdType := godotType $property.Type $property.Ref $property.Items.Type $property.Items.Ref (isRefToEnum (cleanRef $property.Ref)) }}
+        {{- $gdType := propType $property }}
 	{{- $gdDef := $gdType | godotDef }}
-	{{ "\n" }}
-	{{- $commentedDescription := commentLines $property.Description }}
-	{{- $commentedDescription }}
-	var {{ $_field }}
+{{ "" }}
+	{{- if and strict (eq (fieldKind $property) "object") }}
+	var {{ $_field }} : {{ $gdType }} = null
+	{{- else }}
+	var {{ $_field }}{{ decl "Variant" }}
+	{{- end }}
+	{{- with $property.Description }}
+{{ commentLines . }}
+	{{- end }}
 	var {{ $fieldname }} : {{ $gdType }}:
 		get:
 		{{- if $property.Ref }}
 			{{- if isRefToEnum (cleanRef $property.Ref) }}{{/* Enums */}}
 			return {{ cleanRef $property.Ref }}.values()[0] if not {{ cleanRef $property.Ref }}.values().has({{ $_field }}) else {{ $_field }}
+			{{- else if strict }}{{/* Object reference, typed backing field */}}
+			return {{ $_field }}
 			{{- else }}{{/* Object reference */}}
 			return _{{ $fieldname }} as {{ $gdType }}
 			{{- end }}
+		{{- else if isTypedContainer $gdType }}{{/* Typed arrays and dictionaries */}}
+			{{- if eq (fieldKind $property) "object_array" }}
+			_materialize{{ $_field }}()
+			{{- end }}
+			var out : {{ $gdType }} = {{ $gdDef }}
+			if {{ $_field }} != null:
+				{{- if strict }}
+				var src : {{ if eq $property.Type "object" }}Dictionary{{ else }}Array{{ end }} = {{ $_field }}
+				out.assign(src)
+				{{- else }}
+				out.assign({{ $_field }})
+				{{- end }}
+			return out
+		{{- else if and strict (eq $property.Type "object") }}{{/* Dictionaries */}}
+			if {{ $_field }} is Dictionary:
+				var map : Dictionary = {{ $_field }}
+				return map.duplicate()
+			return {}
 		{{- else if eq $property.Type "object"}}{{/* Dictionaries */}}
 			return Dictionary() if not {{ $_field }} is Dictionary else {{ $_field }}.duplicate()
+		{{- else if and strict (or (eq $gdType "PackedInt64Array") (eq $gdType "PackedFloat64Array")) }}
+			var out := {{ $gdDef }}
+			if {{ $_field }} != null:
+				for e : Variant in {{ $_field }}:
+					out.append({{ if eq $gdType "PackedInt64Array" }}int{{ else }}float{{ end }}(e))
+			return out
+		{{- else if strict }}{{/* Simple type, arrays */}}
+			{{- if eq (fieldKind $property) "object_array" }}
+			_materialize{{ $_field }}()
+			{{- end }}
+			if {{ $_field }} is {{ $gdType }}:
+				return {{ $_field }}
+			return {{ $gdDef }}
+		{{- else if eq (fieldKind $property) "object_array" }}{{/* Lazy arrays of objects */}}
+			_materialize{{ $_field }}()
+			return {{ $gdDef }} if not {{ $_field }} is {{ $gdType }} else {{ $gdType }}({{ $_field }})
+		{{- else if or (eq $gdType "PackedInt64Array") (eq $gdType "PackedFloat64Array") }}{{/* Also accepts the PackedInt32Array built by the serializer */}}
+			return {{ $gdDef }} if {{ $_field }} == null else {{ $gdType }}(Array({{ $_field }}))
 		{{- else }}{{/* Simple type */}}
 			return {{ $gdDef }} if not {{ $_field }} is {{ $gdType }} else {{ $gdType }}({{ $_field }})
 		{{- end }}
+		{{- template "setter" (propSetter $property $fieldname) }}
+		{{- if eq (fieldKind $property) "object_array" }}
+		{{- $itemclass := cleanRef $property.Items.Ref }}
+
+	## True while {{ $_field }} still holds the raw dictionaries received from the server.
+	var {{ $_field }}_lazy := false
+
+	## The number of elements in {{ $fieldname }}, without deserializing them.
+	func get_{{ $fieldname }}_count() -> int:
+		if not {{ $_field }} is Array:
+			return 0
+		var arr : Array = {{ $_field }}
+		return arr.size()
+
+	## Return element p_index of {{ $fieldname }}, deserializing only that element, or null when there is none.
+	func get_{{ $fieldname }}_at(p_index : int) -> {{ $itemclass }}:
+		if not {{ $_field }} is Array:
+			return null
+		var arr : Array = {{ $_field }}
+		if p_index < 0 or p_index >= arr.size():
+			return null
+		if arr[p_index] is Dictionary:
+			var raw : Dictionary = arr[p_index]
+			arr[p_index] = {{ $itemclass }}._from_dict(raw)
+		var e : {{ $itemclass }} = arr[p_index]
+		return e
+
+	func _materialize{{ $_field }}() -> void:
+		if not {{ $_field }}_lazy:
+			return
+		for i{{ decl "int" }} in get_{{ $fieldname }}_count():
+			get_{{ $fieldname }}_at(i)
+		{{ $_field }}_lazy = false
+		{{- end }}
 		{{- end }}
 
+	## Fields sent by the server which are not part of the schema, re-emitted by serialize().
+	var _unknown : Dictionary = {}
+
 	{{- godotClassUtils $classname }}
 
-	func _init(p_exception = null):
+	func _init(p_exception{{ decl "Variant" }} = null):
 		super(p_exception)
 
-	static func create(p_ns : GDScript, p_dict : Dictionary) -> {{ $classname }}:
-		return _safe_ret({{.ClassName}}Serializer.deserialize(p_ns, "{{ $classname }}", p_dict), {{ $classname }}) as {{ $classname }}
+	## Create a new {{ $classname }} from typed values. Arguments left to null are not set, the others go through the setters.
+	static func make(
+		{{- range $propname, $property := $definition.Properties }}
+		{{- $fieldname := $propname | pascalToSnake }}
+		{{- $gdType := propType $property }}{{/* Godot does not support typed optional parameters yet. */}}
+		p_{{ $fieldname }}{{ decl "Variant" }} = null, # : {{ $gdType }}
+		{{- end }}
+	) -> {{ $classname }}:
+		var obj := {{ $classname }}.new()
+		{{- range $propname, $property := $definition.Properties }}
+		{{- $fieldname := $propname | pascalToSnake }}
+		if p_{{ $fieldname }} != null:
+			{{- if eq (propType $property) "String" }}
+			obj.{{ $fieldname }} = str(p_{{ $fieldname }}) if p_{{ $fieldname }} is int else p_{{ $fieldname }}
+			{{- else }}
+			obj.{{ $fieldname }} = p_{{ $fieldname }}
+			{{- end }}
+		{{- end }}
+		return obj
+
+	static func create({{ if strict }}_p_ns{{ else }}p_ns{{ end }} : GDScript, p_dict : Dictionary) -> {{ $classname }}:
+		return _from_dict(p_dict)
 
 	func serialize() -> Dictionary:
-		return {{.ClassName}}Serializer.serialize(self)
+		return _to_dict()
+
+	## Build a {{ $classname }} from a decoded JSON dictionary. Same rules as {{.ClassName}}Serializer.deserialize, without reflection.
+	static func _from_dict(p_dict : Dictionary) -> {{ $classname }}:
+		var obj := {{ $classname }}.new()
+		var v : Variant
+			{{- range $propname, $property := $definition.Properties }}
+			{{- $fieldname := $propname | pascalToSnake }}
+			{{- $_field := printf "_%s" $fieldname }}
+			{{- $kind := fieldKind $property }}
+			{{- $gdType := propType $property }}
+		v = p_dict.get("{{ $fieldname }}")
+			{{- if eq $kind "object" }}
+		if v is Dictionary:
+			var raw : Dictionary = v
+			obj.{{ $_field }} = {{ cleanRef $property.Ref }}._from_dict(raw)
+			{{- else if eq $kind "object_array" }}
+		if v is Array: # Elements are deserialized on first access
+			var arr := []
+			for e{{ decl "Variant" }} in v:
+				if e is Dictionary:
+					arr.append(e)
+			obj.{{ $_field }} = arr
+			obj.{{ $_field }}_lazy = true
+			{{- else if eq $kind "array" }}
+		if v is Array:
+				{{- if eq $property.Items.Type "string" }}
+			var arr := PackedStringArray()
+			for e{{ decl "Variant" }} in v:
+				arr.append(str(e))
+				{{- else if eq $property.Items.Type "integer" }}
+			var arr := {{ if typedArrays }}PackedInt64Array(){{ else }}PackedInt32Array(){{ end }}
+			for e{{ decl "Variant" }} in v:
+				arr.append(int(e))
+				{{- else if eq $property.Items.Type "boolean" }}
+			var arr {{ if typedArrays }}: Array[bool] = []{{ else }}:= PackedInt32Array(){{ end }}
+			for e{{ decl "Variant" }} in v:
+				arr.append(bool(e))
+				{{- else if and (eq $property.Items.Type "number") typedArrays }}
+			var arr := PackedFloat64Array()
+			for e{{ decl "Variant" }} in v:
+				arr.append(float(e))
+				{{- else }}
+			var src : Array = v
+			var arr := src.duplicate()
+				{{- end }}
+			obj.{{ $_field }} = arr
+			{{- else if eq $kind "map" }}
+		if v is Dictionary:
+			var map := {}
+			for k{{ decl "Variant" }} in v:
+				{{- if eq $property.AdditionalProperties.Type "integer" }}
+				map[k] = int(v[k])
+				{{- else if eq $property.AdditionalProperties.Type "boolean" }}
+				map[k] = bool(v[k])
+				{{- else if eq $property.AdditionalProperties.Type "number" }}
+				map[k] = float(v[k])
+				{{- else }}
+				map[k] = str(v[k])
+				{{- end }}
+			obj.{{ $_field }} = map
+			{{- else if eq $gdType "int" }}
+		if v is float:
+			v = int(v)
+		elif v is String:
+			var s : String = v
+			if s.is_valid_int():
+				v = s.to_int()
+		if v is int:
+			obj.{{ $_field }} = v
+			{{- else }}
+			{{- if eq $gdType "String" }}
+		if v is int: # Like the int64 values sent as strings
+			v = str(v)
+			{{- end }}
+		if v is {{ $gdType }}:
+			obj.{{ $_field }} = v
+			{{- end }}
+			{{- end }}
+		for k{{ decl "Variant" }} in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	## Convert to a dictionary ready to be encoded as JSON. Same rules as {{.ClassName}}Serializer.serialize, without reflection.
+	func _to_dict() -> Dictionary:
+		var out := {}
+			{{- range $propname, $property := $definition.Properties }}
+			{{- $fieldname := $propname | pascalToSnake }}
+			{{- $_field := printf "_%s" $fieldname }}
+			{{- $kind := fieldKind $property }}
+			{{- if eq $kind "object" }}
+		if {{ $_field }} is Object:
+			out["{{ $fieldname }}"] = {{ $_field }}._to_dict()
+			{{- else if eq $kind "object_array" }}
+		if {{ $_field }} is Array:
+			var arr := []
+			for e{{ decl "Variant" }} in {{ $_field }}:
+				if e is Dictionary: # Not deserialized yet, sent as received
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+				elif e is {{ cleanRef $property.Items.Ref }}:
+					var item : {{ cleanRef $property.Items.Ref }} = e
+					arr.append(item._to_dict())
+			out["{{ $fieldname }}"] = arr
+			{{- else if eq $kind "array" }}
+		if {{ $_field }} != null:
+			var arr := []
+			for e{{ decl "Variant" }} in {{ $_field }}:
+				{{- if eq $property.Items.Type "boolean" }}
+				arr.append(bool(e))
+				{{- else }}
+				arr.append(e)
+				{{- end }}
+			out["{{ $fieldname }}"] = arr
+			{{- else if eq $kind "map" }}
+		if {{ $_field }} is Dictionary:
+			var map := {}
+			for k{{ decl "Variant" }} in {{ $_field }}:
+				{{- if eq $property.AdditionalProperties.Type "integer" }}
+				map[k] = int({{ $_field }}[k])
+				{{- else if eq $property.AdditionalProperties.Type "boolean" }}
+				map[k] = bool({{ $_field }}[k])
+				{{- else if eq $property.AdditionalProperties.Type "number" }}
+				map[k] = float({{ $_field }}[k])
+				{{- else }}
+				if {{ $_field }}[k] is String:
+					map[k] = {{ $_field }}[k]
+				{{- end }}
+			out["{{ $fieldname }}"] = map
+			{{- else }}
+		if {{ $_field }} != null:
+			out["{{ $fieldname }}"] = {{ $_field }}
+			{{- end }}
+			{{- end }}
+		for k{{ decl "Variant" }} in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+
+	## Compare field by field with another {{ $classname }}, following nested objects, arrays and maps.
+	func equals(p_other{{ decl "Variant" }}) -> bool:
+		if not p_other is {{ $classname }}:
+			return false
+		if p_other == self:
+			return true
+		var other : {{ $classname }} = p_other
+			{{- range $propname, $property := $definition.Properties }}
+			{{- $fieldname := $propname | pascalToSnake }}
+			{{- $_field := printf "_%s" $fieldname }}
+			{{- $kind := fieldKind $property }}
+		if typeof({{ $_field }}) != typeof(other.{{ $_field }}):
+			return false
+			{{- if eq $kind "object" }}
+		if {{ $_field }} != null and not {{ $_field }}.equals(other.{{ $_field }}):
+			return false
+			{{- else if eq $kind "object_array" }}
+		if {{ $_field }} != null:
+			if get_{{ $fieldname }}_count() != other.get_{{ $fieldname }}_count():
+				return false
+			for i{{ decl "int" }} in get_{{ $fieldname }}_count():
+				var e : {{ cleanRef $property.Items.Ref }} = get_{{ $fieldname }}_at(i)
+				if e == null or not e.equals(other.get_{{ $fieldname }}_at(i)):
+					return false
+			{{- else }}
+		if {{ $_field }} != other.{{ $_field }}:
+			return false
+			{{- end }}
+			{{- end }}
+		if _unknown != other._unknown:
+			return false
+		return true
+
+	## Return a copy of this {{ $classname }} which shares no objects, arrays or maps with the original.
+	func duplicate_deep() -> {{ $classname }}:
+		var obj := {{ $classname }}.new(_ex)
+			{{- range $propname, $property := $definition.Properties }}
+			{{- $fieldname := $propname | pascalToSnake }}
+			{{- $_field := printf "_%s" $fieldname }}
+			{{- $kind := fieldKind $property }}
+			{{- if eq $kind "object" }}
+		if {{ $_field }} != null:
+			obj.{{ $_field }} = {{ $_field }}.duplicate_deep()
+			{{- else if eq $kind "object_array" }}
+		if {{ $_field }} is Array:
+			var arr := []
+			for e{{ decl "Variant" }} in {{ $_field }}:
+				if e is {{ cleanRef $property.Items.Ref }}:
+					var item : {{ cleanRef $property.Items.Ref }} = e
+					arr.append(item.duplicate_deep())
+				else:
+					var raw : Dictionary = e
+					arr.append(raw.duplicate(true))
+			obj.{{ $_field }} = arr
+			obj.{{ $_field }}_lazy = {{ $_field }}_lazy
+			{{- else if eq $kind "map" }}
+		if {{ $_field }} is Dictionary:
+			var map : Dictionary = {{ $_field }}
+			obj.{{ $_field }} = map.duplicate(true)
+			{{- else if eq $kind "array" }}{{/* Packed arrays are copied on write, Array and Array[bool] are not */}}
+		if {{ $_field }} is Array:
+			var arr : Array = {{ $_field }}
+			obj.{{ $_field }} = arr.duplicate(true)
+		else:
+			obj.{{ $_field }} = {{ $_field }}
+			{{- else }}
+		obj.{{ $_field }} = {{ $_field }}
+			{{- end }}
+			{{- end }}
+		obj._unknown = _unknown.duplicate(true)
+		return obj
+
+	## Compute a hash consistent with equals().
+	func hash() -> int:
+		var values := []
+			{{- range $propname, $property := $definition.Properties }}
+			{{- $fieldname := $propname | pascalToSnake }}
+			{{- $_field := printf "_%s" $fieldname }}
+			{{- $kind := fieldKind $property }}
+			{{- if eq $kind "object" }}
+		values.append({{ $_field }}.hash() if {{ $_field }} != null else null)
+			{{- else if eq $kind "object_array" }}
+		if {{ $_field }} is Array:
+			var hashes := []
+			for i{{ decl "int" }} in get_{{ $fieldname }}_count():
+				var e : {{ cleanRef $property.Items.Ref }} = get_{{ $fieldname }}_at(i)
+				hashes.append(e.hash() if e != null else null)
+			values.append(hashes)
+		else:
+			values.append(null)
+			{{- else if or (eq $kind "array") (eq $kind "map") }}{{/* Equal dictionaries may have their keys in another order */}}
+		values.append(_hashable({{ $_field }}))
+			{{- else }}
+		values.append({{ $_field }})
+			{{- end }}
+			{{- end }}
+		values.append(_hashable(_unknown))
+		return values.hash()
 
 	func _to_string() -> String:
 		if is_exception():
@@ -121,11 +487,13 @@ class {{ $classname }} extends {{.ClassName}}AsyncResult:
             {{- range $propname, $property := $definition.Properties }}
             {{- $fieldname := $propname | pascalToSnake }}
             {{- $_field := printf "_%s" $fieldname }}
-            {{- if eq $property.Type "array" }}
+            {{- if eq (fieldKind $property) "object_array" }}
+		output += "{{ $fieldname }}: %s, " % [{{ $fieldname }}]
+            {{- else if eq $property.Type "array" }}
 		output += "{{ $fieldname }}: %s, " % [{{ $_field }}]
             {{- else if eq $property.Type "object" }}
 		if typeof({{ $_field }}) == TYPE_DICTIONARY:
-			for k in {{ $_field }}:
+			for k{{ decl "Variant" }} in {{ $_field }}:
 				map_string += "{%s=%s}, " % [k, {{ $_field }}[k]]
 		output += "{{ $fieldname }}: [%s], " % map_string
 		map_string = ""
@@ -137,18 +505,63 @@ class {{ $classname }} extends {{.ClassName}}AsyncResult:
 		return output
     {{- end }}
 {{- end }}
+{{- range $url, $path := .Paths }}
+{{- range $method, $operation := $path }}
+{{- $optional := optionalParams $operation }}
+{{- if $optional }}
+
+## Optional parameters of {{ $operation.OperationId | apiFuncName }}_async. Only the parameters which are set are sent.
+class {{ optionsClass $operation }} extends RefCounted:
+	{{- range $parameter := $optional }}
+	{{- $fieldname := $parameter.Name | pascalToSnake }}
+	{{- $gdType := godotType $parameter.Type "" $parameter.Items.Type "" false }}
+{{ "" }}
+	var _{{ $fieldname }}{{ decl "Variant" }}
+	{{- with $parameter.Description }}
+{{ commentLines . }}
+	{{- end }}
+	var {{ $fieldname }} : {{ $gdType }}:
+		get:
+		{{- if strict }}
+			if _{{ $fieldname }} is {{ $gdType }}:
+				return _{{ $fieldname }}
+			return {{ $gdType | godotDef }}
+		{{- else }}
+			return {{ $gdType | godotDef }} if _{{ $fieldname }} == null else _{{ $fieldname }}
+		{{- end }}
+		set(p_value):
+			_{{ $fieldname }} = p_value
+	{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
 
-# The low level client for the {{.ClassName}} API.
+## The low level client for the {{.ClassName}} API.
 class ApiClient extends RefCounted:
 
 	var _base_uri : String
 
-	var _http_adapter
+	var _http_adapter{{ if strict }} : {{.ClassName}}HTTPAdapter{{ end }}
 	var _namespace : GDScript
 	var _server_key : String
 	var auto_refresh := true
 	var auto_refresh_time := 300
 
+	## Whether requests carry a W3C traceparent header. Its trace ID is on the returned result.
+	var trace_requests := true
+	var _trace_id := ""
+	## The trace ID shared by all requests, 32 lowercase hex digits like {{.ClassName}}Trace.trace_id_of() makes of the ID of a game session. Each request gets a new one when empty.
+	var trace_id : String:
+		set(p_value):
+			if p_value and not {{.ClassName}}Trace.is_valid_trace_id(p_value):
+				push_error("Invalid trace ID '%s': expected 32 lowercase hex digits, see {{.ClassName}}Trace.trace_id_of()." % p_value)
+				return
+			_trace_id = p_value
+		get:
+			return _trace_id
+	## A header which also carries the trace ID, like "X-Correlation-Id", when not empty.
+	var correlation_header := ""
+
 	var auto_retry : bool:
 		set(p_value):
 			_http_adapter.auto_retry = p_value
@@ -167,11 +580,12 @@ class ApiClient extends RefCounted:
 		get:
 			return _http_adapter.auto_retry_backoff_base
 
-	var last_cancel_token:
+	## The token of the last request sent, to pass to cancel_request(). Deprecated: when requests start in the same frame it may belong to another one, pass a {{.ClassName}}CancellationToken to the request instead.
+	var last_cancel_token{{ decl "int" }}:
 		get:
 			return _http_adapter.get_last_token()
 
-	func _init(p_base_uri : String, p_http_adapter, p_namespace : GDScript, p_server_key : String, p_timeout : int = 10):
+	func _init(p_base_uri : String, p_http_adapter{{ if strict }} : {{.ClassName}}HTTPAdapter{{ end }}, p_namespace : GDScript, p_server_key : String, p_timeout : int = 10):
 		_base_uri = p_base_uri
 		_http_adapter = p_http_adapter
 		_http_adapter.timeout = p_timeout
@@ -181,62 +595,121 @@ class ApiClient extends RefCounted:
 		{{ range $url, $path := .Paths }}
 		{{- range $method, $operation := $path}}
 			{{- if hasSuffix $operation.OperationId "Refresh" }}
-	func _refresh_session(p_session : {{.ClassName}}Session):
+	## A session refresh in flight, awaited by the requests which need it while it is pending.
+	class _RefreshFlight extends RefCounted:
+		signal completed
+		var result : {{ $operation.Responses.Ok.Schema.Ref | cleanRef }}
+
+	## The session refreshes in flight, by refresh token.
+	var _refreshes : Dictionary = {}
+
+	## Refresh the session when it is about to expire. Concurrent calls for the same session share one request, and get the same result.
+	func _refresh_session(p_session : {{.ClassName}}Session) -> {{ $operation.Responses.Ok.Schema.Ref | cleanRef }}:
 		if auto_refresh and p_session.is_valid() and p_session.refresh_token and not p_session.is_refresh_expired() and p_session.would_expire_in(auto_refresh_time):
+			var key : String = p_session.refresh_token
+			if _refreshes.has(key):
+				var pending : _RefreshFlight = _refreshes[key]
+				await pending.completed
+				return pending.result
+			var flight := _RefreshFlight.new()
+			_refreshes[key] = flight
 		{{- $operationId := $operation.OperationId }}
 		{{- range $parameter := $operation.Parameters }}
-			var request = {{ $parameter.Schema.Ref | cleanRef }}.new()
-			request._token = p_session.refresh_token
-			return await {{ $operationId | apiFuncName }}_async(_server_key, "", request)
+			var request := {{ $parameter.Schema.Ref | cleanRef }}.new()
+			request.token = p_session.refresh_token
+			flight.result = await {{ $operationId | apiFuncName }}_async(_server_key, "", request)
 		{{- end }}
+			_refreshes.erase(key)
+			flight.completed.emit()
+			return flight.result
 		return null
 			{{- end }}
 		{{- end }}
 	{{- end }}
 
-	func cancel_request(p_token):
+	func cancel_request(p_token{{ decl "Variant" }}) -> void:
 		if p_token:
 			_http_adapter.cancel_request(p_token)
 
+	## A request about to be sent, which interceptors can inspect and change.
+	class Request extends RefCounted:
+		var operation_id : String # The operation ID in the spec, like "GetAccount".
+		var trace_id : String
+		var method : String
+		var uri : String
+		var headers : Dictionary
+		var body : PackedByteArray
+
+	## Called around the requests of the client once added with add_interceptor(). Extend it and override its methods to add headers, sign or log bodies, or rewrite URIs.
+	class Interceptor extends RefCounted:
+
+		## Called before p_request is sent, in the order the interceptors were added. It can await.
+		func before_request(_p_request : Request) -> void:
+			pass
+
+		## Called with the decoded response or the exception of p_request, in the reverse order. Returns the result passed on.
+		func after_response(_p_request : Request, p_result : Variant) -> Variant:
+			return p_result
+
+	var _interceptors : Array = []
+
+	func add_interceptor(p_interceptor : Interceptor) -> void:
+		if not _interceptors.has(p_interceptor):
+			_interceptors.append(p_interceptor)
+
+	func remove_interceptor(p_interceptor : Interceptor) -> void:
+		_interceptors.erase(p_interceptor)
+
+	## The trace ID of a new request, empty when requests are not traced.
+	func _request_trace_id() -> String:
+		if not trace_requests:
+			return ""
+		return _trace_id if _trace_id else {{.ClassName}}Trace.new_trace_id()
+
+	func _send_async(p_operation_id : String, p_trace_id : String, p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+			p_cancel_token : {{.ClassName}}CancellationToken = null, p_retryable : bool = true, p_timeout : int = 0) -> Variant:
+		if p_trace_id:
+			p_headers["traceparent"] = {{.ClassName}}Trace.traceparent(p_trace_id, {{.ClassName}}Trace.new_span_id())
+			if correlation_header:
+				p_headers[correlation_header] = p_trace_id
+		var result : Variant = await _intercept_async(p_operation_id, p_trace_id, p_method, p_uri, p_headers, p_body, p_cancel_token, p_retryable, p_timeout)
+		if result is {{.ClassName}}Exception:
+			var exception : {{.ClassName}}Exception = result
+			exception._trace_id = p_trace_id
+		return result
+
+	func _intercept_async(p_operation_id : String, p_trace_id : String, p_method : String, p_uri : String, p_headers : Dictionary, p_body : PackedByteArray,
+			p_cancel_token : {{.ClassName}}CancellationToken, p_retryable : bool, p_timeout : int) -> Variant:
+		if _interceptors.is_empty():
+			return await _http_adapter.send_async(p_method, p_uri, p_headers, p_body, p_retryable, p_timeout, p_cancel_token)
+		var request := Request.new()
+		request.operation_id = p_operation_id
+		request.trace_id = p_trace_id
+		request.method = p_method
+		request.uri = p_uri
+		request.headers = p_headers
+		request.body = p_body
+		# Interceptors added or removed while the request is pending apply to the next one.
+		var chain := _interceptors.duplicate()
+		for interceptor{{ decl "Interceptor" }} in chain:
+			await interceptor.before_request(request)
+		var result : Variant = await _http_adapter.send_async(request.method, request.uri, request.headers, request.body, p_retryable, p_timeout, p_cancel_token)
+		chain.reverse()
+		for interceptor{{ decl "Interceptor" }} in chain:
+			result = await interceptor.after_response(request, result)
+		return result
+
         {{- range $url, $path := .Paths }}
         {{- range $method, $operation := $path}}
 
-	# {{ $operation.Summary | stripNewlines }}
+	## {{ $operation.Summary | stripNewlines }}
         {{- if $operation.Responses.Ok.Schema.Ref }}
 	func {{ $operation.OperationId | apiFuncName }}_async(
         {{- else }}
 	func {{ $operation.OperationId | apiFuncName }}_async(
         {{- end}}
 
-        {{- if $operation.Security }}
-        {{- with (index $operation.Security 0) }}
-            {{- range $key, $value := . }}
-                {{- if eq $key "BasicAuth" }}
-		p_basic_auth_username : String
-		, p_basic_auth_password : String
-                {{- else if eq $key "HttpKeyAuth" }}
-		p_bearer_token : String
-                {{- end }}
-            {{- end }}
-        {{- end }}
-        {{- else }}
-		p_session : {{.ClassName}}Session
-        {{- end }}
-
-        {{- range $parameter := $operation.Parameters }}
-        {{- $argument := $parameter.Name | prependParameter }}
-	{{- if not $parameter.Required }}{{/* Godot does not support typed optional parameters yet. */}}
-		, {{ $argument }} = null # : {{ $parameter.Type }}
-        {{- else if eq $parameter.In "body" }}
-            {{- if eq $parameter.Schema.Type "string" }}
-		, {{ $argument }} : String
-            {{- else }}
-		, {{ $argument }} : {{ $parameter.Schema.Ref | cleanRef }}
-            {{- end }}
-        {{- else }}
-		, {{ $argument }} : {{ godotType $parameter.Type $parameter.Schema.Ref $parameter.Items.Type "" (isRefToEnum (cleanRef $parameter.Schema.Ref)) }}
-        {{- end }}
-	{{- end }}
+        {{- template "params" $operation }}
 	)
 	{{- if $operation.Responses.Ok.Schema.Ref }} -> {{ $operation.Responses.Ok.Schema.Ref | cleanRef }}
 	{{- else }} -> {{.ClassName}}AsyncResult
@@ -246,11 +719,11 @@ class ApiClient extends RefCounted:
           {{- $classname = $operation.Responses.Ok.Schema.Ref | cleanRef }}
         {{- end }}
         {{- if not $operation.Security }}
-		var try_refresh = await _refresh_session(p_session)
+		var try_refresh : {{ refreshResponse }} = await _refresh_session(p_session)
 		if try_refresh != null:
 			if try_refresh.is_exception():
 				return {{ $classname }}.new(try_refresh.get_exception())
-			await p_session.refresh(try_refresh)
+			{{ if not strict }}await {{ end }}p_session.refresh(try_refresh)
         {{- end }}
 		var urlpath : String = "{{- $url }}"
             {{- range $parameter := $operation.Parameters }}
@@ -259,12 +732,18 @@ class ApiClient extends RefCounted:
 		urlpath = urlpath.replace("{{- print "{" $parameter.Name "}"}}", {{.ClassName}}Serializer.escape_http({{ $argument }}))
             {{- end }}
             {{- end }}
-		var query_params = ""
+            {{- if optionalParams $operation }}
+		var options := p_options if p_options != null else {{ optionsClass $operation }}.new()
+            {{- end }}
+		var query_params := ""
             {{- range $parameter := $operation.Parameters }}
             {{- $argument := $parameter.Name | prependParameter }}
             {{- $snakecase := $parameter.Name | pascalToSnake }}
             {{- if eq $parameter.In "query"}}
-            {{- if $parameter.Required }}
+            {{- if isOption $parameter }}
+            {{- $argument = printf "options.%s" $snakecase }}
+		if options._{{ $snakecase }} != null:
+            {{- else if $parameter.Required }}
 		if true: # Hack for static checks
             {{- else }}
 		if {{ $argument }} != null:
@@ -272,36 +751,36 @@ class ApiClient extends RefCounted:
                 {{- if eq $parameter.Type "integer" }}
 			query_params += "{{- $snakecase }}=%d&" % {{ $argument }}
                 {{- else if eq $parameter.Type "string" }}
-			query_params += "{{- $snakecase }}=%s&" % {{.ClassName}}Serializer.escape_http({{ $argument }})
+			query_params += "{{- $snakecase }}=%s&" % {{.ClassName}}Serializer.escape_http({{ if $parameter.Required }}{{ $argument }}{{ else }}str({{ $argument }}){{ end }})
                 {{- else if eq $parameter.Type "boolean" }}
 			query_params += "{{- $snakecase }}=%s&" % str(bool({{ $argument }})).to_lower()
                 {{- else if eq $parameter.Type "array" }}
-			for elem in {{ $argument }}:
+			for elem{{ decl "Variant" }} in {{ $argument }}:
 				query_params += "{{- $snakecase }}=%s&" % elem
                 {{- else }}
 		{{ $parameter }} // ERROR
                 {{- end }}
             {{- end }}
             {{- end }}
-		var uri = "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
-		var method = "{{- $method | uppercase }}"
-		var headers = {}
+		var uri := "%s%s%s" % [_base_uri, urlpath, "?" + query_params if query_params else ""]
+		var method := "{{- $method | uppercase }}"
+		var headers := {}
             {{- if $operation.Security }}
             {{- with (index $operation.Security 0) }}
                 {{- range $key, $value := . }}
                     {{- if eq $key "BasicAuth" }}
-		var credentials = Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
-		var header = "Basic %s" % credentials
+		var credentials := Marshalls.utf8_to_base64(p_basic_auth_username + ":" + p_basic_auth_password)
+		var header := "Basic %s" % credentials
 		headers["Authorization"] = header
                     {{- else if eq $key "HttpKeyAuth" }}
 		if (p_bearer_token):
-			var header = "Bearer %s" % p_bearer_token
+			var header := "Bearer %s" % p_bearer_token
 			headers["Authorization"] = header
                     {{- end }}
                 {{- end }}
             {{- end }}
             {{- else }}
-		var header = "Bearer %s" % p_session.token
+		var header := "Bearer %s" % p_session.token
 		headers["Authorization"] = header
             {{- end }}
 
@@ -317,246 +796,5628 @@ class ApiClient extends RefCounted:
             {{- end }}
             {{- end }}
 
-		var result = await _http_adapter.send_async(method, uri, headers, content)
+		var trace := _request_trace_id()
+		var result : Variant = await _send_async("{{ trimPrefix "{{.ClassName}}_" $operation.OperationId }}", trace, method, uri, headers, content, p_cancel_token{{ requestPolicy $method $operation }})
 		if result is {{.ClassName}}Exception:
 			return {{ $classname }}.new(result)
 
             {{- if $operation.Responses.Ok.Schema.Ref }}
-		var out : {{ $classname }} = {{.ClassName}}Serializer.deserialize(_namespace, "{{ $classname }}", result)
-		return out
+		var body : Dictionary = result
+		var out : {{ $classname }} = {{ $classname }}._from_dict(body)
             {{- else }}
-		return {{.ClassName}}AsyncResult.new()
+		var out := {{.ClassName}}AsyncResult.new()
             {{- end}}
+		out._trace_id = trace
+		return out
+		{{- $page := pagination $operation }}
+		{{- if $page.Ok }}
+		{{- $func := $operation.OperationId | apiFuncName }}
+		{{- $pages := "Array" }}
+		{{- if typedArrays }}{{ $pages = printf "Array[%s]" $page.Response }}{{ end }}
+
+	## Fetch the pages of {{ $func }}_async one after the other, until there are no more or p_max_pages were fetched (0 for no limit).
+	## A failed page is the last one returned.
+	func {{ $func }}_pages_async(
+		{{- template "arguments" $operation }}
+		, p_max_pages : int = 0
+		, p_cancel_token : {{.ClassName}}CancellationToken = null
+	) -> {{ $pages }}:
+		var pages : {{ $pages }} = []
+		{{- template "cursor" $page }}
+		while p_max_pages <= 0 or pages.size() < p_max_pages:
+			var page : {{ $page.Response }} = await {{ $func }}_async({{ $page.Args }})
+			pages.append(page)
+			if page.is_exception() or page.get_{{ $page.Items }}_count() == 0 or page.{{ $page.Next }} == "" or page.{{ $page.Next }} == cursor:
+				break
+		{{- template "next_cursor" $page }}
+		return pages
+
+	## Fetch the pages of {{ $func }}_async until there are no more or at least p_max_items {{ $page.Items }} were received (0 for no limit), and return them as one {{ $page.Response }}.
+	## The other fields, like the cursor to continue from, are the ones of the last page. A failed page is returned as is.
+	func {{ $func }}_all_async(
+		{{- template "arguments" $operation }}
+		, p_max_items : int = 0
+		, p_cancel_token : {{.ClassName}}CancellationToken = null
+	) -> {{ $page.Response }}:
+		var items := []
+		{{- template "cursor" $page }}
+		var page : {{ $page.Response }}
+		while true:
+			page = await {{ $func }}_async({{ $page.Args }})
+			if page.is_exception():
+				return page
+			var count := page.get_{{ $page.Items }}_count()
+			for i{{ decl "int" }} in count:
+				items.append(page.get_{{ $page.Items }}_at(i))
+			if count == 0 or page.{{ $page.Next }} == "" or page.{{ $page.Next }} == cursor:
+				break
+			if p_max_items > 0 and items.size() >= p_max_items:
+				break
+		{{- template "next_cursor" $page }}
+		page._{{ $page.Items }} = items
+		page._{{ $page.Items }}_lazy = false
+		return page
+		{{- end }}
+{{- end }}
+{{- end }}
+
+{{- define "cursor" }}{{/* Declare the cursor of the page to fetch, and the options or body it is sent in. */}}
+{{- if eq .Carrier "options" }}
+		var options := {{ .Options }}.new()
+		if p_options != null:
+			{{- range .OptionFields }}
+			options._{{ . }} = p_options._{{ . }}
+			{{- end }}
+		var cursor{{ decl "Variant" }} = options._{{ .Cursor }}
+{{- else if eq .Carrier "body" }}
+		var body := {{ .Body }}.duplicate_deep()
+		var cursor{{ decl "Variant" }} = body._cursor
+{{- else }}
+		var cursor{{ decl "Variant" }} = p_{{ .Cursor }}
 {{- end }}
 {{- end }}
+
+{{- define "next_cursor" }}{{/* Move the cursor to the next page. */}}
+			cursor = page.{{ .Next }}
+{{- if eq .Carrier "options" }}
+			options.{{ .Cursor }} = page.{{ .Next }}
+{{- else if eq .Carrier "body" }}
+			body.cursor = page.{{ .Next }}
+{{- end }}
+{{- end }}
+
+{{- define "params" }}{{/* The parameters of the _async method of an operation. */}}
+{{- template "arguments" . }}
+		, p_cancel_token : {{.ClassName}}CancellationToken = null
+{{- end }}
+
+{{- define "arguments" }}{{/* The parameters of the _async method of an operation, but the cancellation token. */}}
+{{- $operation := . }}
+        {{- if $operation.Security }}
+        {{- with (index $operation.Security 0) }}
+            {{- range $key, $value := . }}
+                {{- if eq $key "BasicAuth" }}
+		p_basic_auth_username : String
+		, p_basic_auth_password : String
+                {{- else if eq $key "HttpKeyAuth" }}
+		p_bearer_token : String
+                {{- end }}
+            {{- end }}
+        {{- end }}
+        {{- else }}
+		p_session : {{.ClassName}}Session
+        {{- end }}
+
+        {{- range $parameter := $operation.Parameters }}
+        {{- $argument := $parameter.Name | prependParameter }}
+	{{- if isOption $parameter }}{{/* Passed in p_options. */}}
+	{{- else if and (not $parameter.Required) strict }}{{/* Checked against null below. */}}
+		, {{ $argument }} : Variant = null # : {{ $parameter.Type }}
+	{{- else if not $parameter.Required }}{{/* Godot does not support typed optional parameters yet. */}}
+		, {{ $argument }} = null # : {{ $parameter.Type }}
+        {{- else if eq $parameter.In "body" }}
+            {{- if eq $parameter.Schema.Type "string" }}
+		, {{ $argument }} : String
+            {{- else }}
+		, {{ $argument }} : {{ $parameter.Schema.Ref | cleanRef }}
+            {{- end }}
+        {{- else }}
+		, {{ $argument }} : {{ godotType $parameter.Type $parameter.Schema.Ref $parameter.Items.Type "" (isRefToEnum (cleanRef $parameter.Schema.Ref)) }}
+        {{- end }}
+	{{- end }}
+	{{- if optionalParams $operation }}
+		, p_options : {{ optionsClass $operation }} = null
+	{{- end }}
+{{- end }}
 `
 
-func convertRefToClassName(input string) (className string) {
-	cleanRef := strings.TrimPrefix(input, "#/definitions/")
-	className = strings.Title(cleanRef)
-	return
-}
+// The high-level client facade, generated with -client from the spec and an overlay file.
+const clientTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
 
-func stripNewlines(input string) (output string) {
-	output = strings.Replace(input, "\n", " ", -1)
-	return
-}
+extends RefCounted
 
-func prependParameter(input string) (output string) {
-	output = "p_" + pascalToSnake(input)
-	return
-}
+## A client for the API in {{.ClassName}} server.
+## The requests take an optional last p_cancel_token, a {{.ClassName}}CancellationToken which cancels them.
+class_name {{.ClassName}}Client
+{{- if .Preamble }}
 
-func pascalToSnake(input string) (output string) {
-	output = ""
-	prev_low := false
-	for _, v := range input {
-		is_cap := v >= 'A' && v <= 'Z'
-		is_low := v >= 'a' && v <= 'z'
-		if is_cap && prev_low {
-			output = output + "_"
-		}
-		output += strings.ToLower(string(v))
-		prev_low = is_low
-	}
-	return
-}
+{{ .Preamble }}
+{{- end }}
 
-func apiFuncName(input string) (output string) {
-	output = pascalToSnake(input[7:])
-	return
-}
+var _host
+## The host address of the server. Defaults to "127.0.0.1".
+var host : String:
+	set(v):
+		pass
+	get:
+		return _host
 
-func godotType(p_type string, p_ref string, p_item_type string, p_extra string, p_is_enum bool) (out string) {
+var _port
+## The port number of the server. Defaults to 7350.
+var port : int:
+	set(v):
+		pass
+	get:
+		return _port
 
-	is_array := false
-	is_dict := false
-	switch p_type {
-	case "integer":
-		out = "int"
-	case "string":
-		out = "String"
-	case "boolean":
-		out = "bool"
-	case "array":
-		is_array = true
-	case "object":
-		is_dict = true
-	default:
-		if p_is_enum {
-			out = "int"
-		} else {
-			out = convertRefToClassName(p_ref)
-		}
-	}
+var _scheme
+## The protocol scheme used to connect with the server. Must be either "http" or "https".
+var scheme : String:
+	set(v):
+		pass
+	get:
+		return _scheme
 
-	if is_array {
-		switch p_item_type {
-		case "integer":
-			out = "PackedIntArray"
-			return
-		case "string":
-			out = "PackedStringArray"
-			return
-		case "boolean":
-			out = "PackedIntArray"
-			return
-		default:
-			out = "Array"
-		}
-	}
-	if is_dict {
-		out = "Dictionary"
-	}
-	return
-}
+var _server_key : String = "defaultkey"
+## The key used to authenticate with the server without a session. Defaults to "defaultkey".
+var server_key:
+	set(v):
+		pass
+	get:
+		return _server_key
 
-func godotDef(p_type string) (out string) {
-	switch p_type {
-	case "bool":
-		out = "false"
-	case "int":
-		out = "0"
-	case "String":
-		out = "\"\""
-	case "PackedIntArray":
-		out = "PackedIntArray()"
-	case "PackedStringArray":
-		out = "PackedStringArray()"
-	case "Array":
-		out = "Array()"
-	case "Dictionary":
-		out = "Dictionary()"
-	}
-	return
-}
+## Set the timeout in seconds on requests sent to the server.
+var timeout : int
 
-func godotLooseType(p_type string) (out string) {
-	switch p_type {
-	case "PackedStringArray", "PackedIntArray":
-		out = "Array"
-	default:
-		out = p_type
-	}
-	return
-}
+var logger : {{.ClassName}}Logger = null
 
-func godotSchemaType(p_type string) (out string) {
-	out = "TYPE_"
-	switch p_type {
-	case "bool":
-		out += "BOOL"
-	case "int":
+var _api_client : {{.ClassName}}API.ApiClient
+
+var auto_refresh : bool = true:
+	set(v):
+		set_auto_refresh(v)
+	get:
+		return get_auto_refresh()
+
+var auto_refresh_seconds : int = true:
+	set(v):
+		set_auto_refresh_seconds(v)
+	get:
+		return get_auto_refresh_seconds()
+
+var auto_retry : bool = true:
+	set(v):
+		set_auto_retry(v)
+	get:
+		return get_auto_retry()
+
+var auto_retry_count:
+	set(v):
+		set_auto_retry_count(v)
+	get:
+		return get_auto_retry_count()
+
+var auto_retry_backoff_base:
+	set(v):
+		set_auto_retry_backoff_base(v)
+	get:
+		return get_auto_retry_backoff_base()
+
+## The token of the last request sent, to pass to cancel_request(). [br]
+## Deprecated: when requests start in the same frame it may belong to another one, pass a p_cancel_token to the request instead.
+var last_cancel_token:
+	set(v):
+		pass
+	get:
+		return get_last_cancel_token()
+
+## The trace ID sent in the W3C traceparent header of all requests, 32 lowercase hex digits like {{.ClassName}}Trace.trace_id_of() makes of the ID of a game session. Each request gets a new one when empty. [br]
+## Sockets created with Nakama.create_socket_from() use it in the IDs of their messages.
+var trace_id : String:
+	set(v):
+		_api_client.trace_id = v
+	get:
+		return _api_client.trace_id
+
+## A header which also carries the trace ID of each request, like "X-Correlation-Id", when not empty.
+var correlation_header : String:
+	set(v):
+		_api_client.correlation_header = v
+	get:
+		return _api_client.correlation_header
+
+func get_auto_refresh():
+	return _api_client.auto_refresh
+
+func set_auto_refresh(p_value):
+	_api_client.auto_refresh = p_value
+
+func get_auto_refresh_seconds():
+	return _api_client.auto_refresh_time
+
+func set_auto_refresh_seconds(p_value):
+	_api_client.auto_refresh_time = p_value
+
+func get_last_cancel_token():
+	return _api_client.last_cancel_token
+
+func get_auto_retry():
+	return _api_client.auto_retry
+
+func set_auto_retry(p_value):
+	_api_client.auto_retry = p_value
+
+func get_auto_retry_count():
+	return _api_client.auto_retry_count
+
+func set_auto_retry_count(p_value):
+	_api_client.auto_retry_count = p_value
+
+func get_auto_retry_backoff_base():
+	return _api_client.auto_retry_backoff_base
+
+func set_auto_retry_backoff_base(p_value):
+	_api_client.auto_retry_backoff_base = p_value
+
+func cancel_request(p_token):
+	_api_client.cancel_request(p_token)
+
+## Add an interceptor called around every request of the client, to add headers, sign or log bodies, or rewrite URIs. [br]
+## p_interceptor - An object extending {{.ClassName}}API.ApiClient.Interceptor.
+func add_interceptor(p_interceptor : {{.ClassName}}API.ApiClient.Interceptor) -> void:
+	_api_client.add_interceptor(p_interceptor)
+
+## Remove an interceptor added with add_interceptor(). [br]
+## p_interceptor - The interceptor to remove.
+func remove_interceptor(p_interceptor : {{.ClassName}}API.ApiClient.Interceptor) -> void:
+	_api_client.remove_interceptor(p_interceptor)
+
+func _init(p_adapter : {{.ClassName}}HTTPAdapter,
+		p_server_key : String,
+		p_scheme : String,
+		p_host : String,
+		p_port : int,
+		p_timeout : int):
+
+	_server_key = p_server_key
+	_scheme = p_scheme
+	_host = p_host
+	_port = p_port
+	timeout = p_timeout
+	logger = p_adapter.logger
+	_api_client = {{.ClassName}}API.ApiClient.new(_scheme + "://" + _host + ":" + str(_port), p_adapter, {{.ClassName}}API, _server_key, p_timeout)
+
+## Restore a session from the auth token. [br]
+## A ` + "`null`" + ` or empty authentication token will return ` + "`null`" + `. [br]
+## authToken - The authentication token to restore as a session. [br]
+## Returns a session.
+static func restore_session(auth_token : String):
+	return {{.ClassName}}Session.new(auth_token, false)
+
+func _to_string() -> String:
+	return "Client(Host='%s', Port=%s, Scheme='%s', ServerKey='%s', Timeout=%s)" % [
+		host, port, scheme, server_key, timeout
+	]
+
+func _parse_auth(p_session) -> {{.ClassName}}Session:
+	if p_session.is_exception():
+		return {{.ClassName}}Session.new(null, false, null, p_session.get_exception())
+	return {{.ClassName}}Session.new(p_session.token, p_session.created, p_session.refresh_token)
+{{- range .Methods }}
+
+{{ if .Code }}{{ .Code }}{{ else }}
+{{- if .Doc }}
+{{- range $idx, $line := .Doc }}{{ if $idx }} [br]
+{{ end }}## {{ $line }}{{ end }}
+{{- else }}
+## {{ .Summary | stripNewlines }} [br]
+{{- range .Params }}
+## {{ .Name }} - {{ if .Description }}{{ .Description | stripNewlines }}{{ else }}{{ .Name | trimPrefix "p_" }}{{ end }} [br]
+{{- end }}
+{{- if .Resolves }}
+## Returns a task which resolves to a {{ .Resolves }}.
+{{- else }}
+## Returns a task which represents the asynchronous operation.
+{{- end }}
+{{- end }}
+func {{ .Name }}_async({{ range $idx, $param := .Params }}{{ if $idx }},{{ if $param.Break }}
+		{{ else }} {{ end }}{{ end }}{{ $param.Declaration }}{{ end }}){{ if .Typed }} -> {{ .Return }}:{{ else }}: # -> {{ .Return }}:{{ end }}
+{{- range .Setup }}
+	{{ . }}
+{{- end }}
+	return {{ if .Wrap }}{{ .Wrap }}({{ end }}await _api_client.{{ .Api }}_async({{ .Args }}){{ if .Wrap }}){{ end }}
+{{- end }}
+{{- end }}
+`
+
+// The typed match state and party data messages, generated with -opcodes from an opcode schema.
+const opcodeTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+
+## The match state and party data messages of {{.ClassName}}, by opcode. [br]
+## Create it for a socket, and keep a reference to it: it emits a received_* signal with the typed message of each match state or party data received, and sends typed messages with its send_*_async() methods.
+class_name {{.ClassName}}Messages
+
+## The opcodes of the messages.
+enum OpCode {
+{{- range . }}{{ if .Const }}
+	{{ .Const }} = {{ .Code }},
+{{- end }}{{ end }}
+}
+{{- if hasDelta . }}
+
+## The number of messages sent and received which are kept to encode and decode changes.
+const DELTA_HISTORY := 32
+{{- end }}
+{{ range . }}{{ if .Const }}
+## Emitted when a {{ .Name }} is received. p_source is the NakamaRTAPI.MatchData or NakamaRTAPI.PartyData which carried it.
+signal {{ .Signal }}(p_message : {{ .Name }}, p_source : NakamaAsyncResult)
+{{ end }}{{ end }}
+## Emitted when match state or party data has an unknown opcode, or a payload which can not be decoded.
+signal received_unknown(p_op_code : int, p_source : NakamaAsyncResult)
+{{- range . }}{{ if .Delta }}
+
+## The sequence of the last {{ .Name }} received, to acknowledge to its sender.
+var {{ .Snake }}_sequence : int = 0
+var _{{ .Snake }}_received : Dictionary = {}
+var _{{ .Snake }}_sent : Dictionary = {}
+var _{{ .Snake }}_sent_sequence : int = 0
+var _{{ .Snake }}_acknowledged : int = 0
+{{- end }}{{ end }}
+
+var _socket : NakamaSocket
+
+func _init(p_socket : NakamaSocket):
+	_socket = p_socket
+	_socket.received_match_state.connect(_on_match_state)
+	_socket.received_party_data.connect(_on_party_data)
+
+func _on_match_state(p_state : NakamaRTAPI.MatchData) -> void:
+	dispatch(p_state.op_code, p_state.binary_data, p_state)
+
+func _on_party_data(p_data : NakamaRTAPI.PartyData) -> void:
+	dispatch(p_data.op_code, p_data.binary_data, p_data)
+
+## Decode the payload of an opcode and emit the signal of its message.
+func dispatch(p_op_code : int, p_payload : PackedByteArray, p_source : NakamaAsyncResult = null) -> void:
+	match p_op_code:
+{{- range . }}{{ if .Const }}
+		OpCode.{{ .Const }}:
+			var message := {{ if .Delta }}decode_{{ .Snake }}(p_payload){{ else }}{{ .Name }}.decode(p_payload){{ end }}
+			if message != null:
+				{{ .Signal }}.emit(message, p_source)
+				return
+{{- end }}{{ end }}
+	received_unknown.emit(p_op_code, p_source)
+{{- range . }}{{ if .Delta }}
+
+## Send a {{ .Name }} to a match, as the changes from the last one acknowledged with acknowledge_{{ .Snake }}(). [br]
+## The changes are from what one receiver has, so send it to the match handler of an authoritative match, or to one presence.
+func {{ .Send }}_async(p_match_id : String, p_message : {{ .Name }}, p_presences = null):
+	return await _socket.send_match_state_raw_async(p_match_id, OpCode.{{ .Const }}, encode_{{ .Snake }}(p_message), p_presences)
+
+## Encode a {{ .Name }} with its sequence, as the changes from the last one acknowledged.
+func encode_{{ .Snake }}(p_message : {{ .Name }}) -> PackedByteArray:
+	_{{ .Snake }}_sent_sequence += 1
+	var baseline : {{ .Name }} = _{{ .Snake }}_sent.get(_{{ .Snake }}_acknowledged)
+	var writer := NakamaBitStream.Writer.new()
+	writer.write_uint(_{{ .Snake }}_sent_sequence)
+	writer.write_uint(_{{ .Snake }}_acknowledged if baseline != null else 0)
+	p_message.write(writer, baseline)
+	_{{ .Snake }}_sent[_{{ .Snake }}_sent_sequence] = {{ .Name }}.from_dict(p_message.to_dict())
+	_{{ .Snake }}_sent.erase(_{{ .Snake }}_sent_sequence - DELTA_HISTORY)
+	return writer.get_bytes()
+
+## Decode a {{ .Name }} with its sequence, as the changes from the one it was encoded against. [br]
+## Return null when that one is unknown, or when the {{ .Name }} is older than the last one received.
+func decode_{{ .Snake }}(p_payload : PackedByteArray) -> {{ .Name }}:
+	var reader := NakamaBitStream.Reader.new(p_payload)
+	var sequence := reader.read_uint()
+	var baseline_sequence := reader.read_uint()
+	var baseline : {{ .Name }} = _{{ .Snake }}_received.get(baseline_sequence)
+	if baseline_sequence > 0 and baseline == null:
+		return null
+	var out := {{ .Name }}.read(reader, baseline)
+	if reader.failed or sequence <= {{ .Snake }}_sequence:
+		return null
+	_{{ .Snake }}_received[sequence] = {{ .Name }}.from_dict(out.to_dict())
+	for key in _{{ .Snake }}_received.keys():
+		if key <= sequence - DELTA_HISTORY:
+			_{{ .Snake }}_received.erase(key)
+	{{ .Snake }}_sequence = sequence
+	return out
+
+## Acknowledge that the receiver has the {{ .Name }} of p_sequence, so the next ones are sent as the changes from it.
+func acknowledge_{{ .Snake }}(p_sequence : int) -> void:
+	if p_sequence > _{{ .Snake }}_acknowledged and p_sequence <= _{{ .Snake }}_sent_sequence:
+		_{{ .Snake }}_acknowledged = p_sequence
+
+## Forget the {{ .Name }} sent and received, like when joining another match.
+func reset_{{ .Snake }}() -> void:
+	{{ .Snake }}_sequence = 0
+	_{{ .Snake }}_received.clear()
+	_{{ .Snake }}_sent.clear()
+	_{{ .Snake }}_sent_sequence = 0
+	_{{ .Snake }}_acknowledged = 0
+{{- else if .Const }}
+
+## Send a {{ .Name }} to a match, to p_presences or to all of its presences when null.
+func {{ .Send }}_async(p_match_id : String, p_message : {{ .Name }}, p_presences = null):
+	return await _socket.send_match_state_raw_async(p_match_id, OpCode.{{ .Const }}, p_message.encode(), p_presences)
+
+## Send a {{ .Name }} to the members of a party.
+func {{ .Send }}_to_party_async(p_party_id : String, p_message : {{ .Name }}):
+	return await _socket.send_party_data_raw_async(p_party_id, OpCode.{{ .Const }}, p_message.encode())
+{{- end }}{{ end }}
+{{- range $class := . }}
+
+{{- if .Description }}
+
+
+## {{ .Description | stripNewlines }}
+{{- else }}
+
+{{ end }}
+class {{ .Name }} extends RefCounted:
+{{- if .Const }}
+
+	const OP_CODE := OpCode.{{ .Const }}
+{{- end }}
+{{- range .Fields }}
+{{ if .Description }}
+	## {{ .Description | stripNewlines }}
+{{- end }}
+	var {{ .Name }} : {{ .GodotType }} = {{ .Default }}
+{{- end }}
+
+	func to_dict() -> Dictionary:
+		return {
+{{- range .Fields }}
+			"{{ .Name }}": {{ .ToDict }},
+{{- end }}
+		}
+
+	static func from_dict(p_dict : Dictionary) -> {{ .Name }}:
+		var out := {{ .Name }}.new()
+{{- range .Fields }}
+{{- range .FromDict }}
+		{{ . }}
+{{- end }}
+{{- end }}
+		return out
+{{- if .Binary }}
+
+	## Write the message to p_writer, as the changes from p_baseline when given.
+	func write(p_writer : NakamaBitStream.Writer, p_baseline : {{ .Name }} = null) -> void:
+{{- range .Fields }}
+		if p_baseline == null or p_writer.write_flag({{ .Differs }}):
+{{- range .Write }}
+			{{ . }}
+{{- end }}
+{{- else }}
+		pass
+{{- end }}
+
+	## Read a message from p_reader, as the changes from p_baseline when given.
+	static func read(p_reader : NakamaBitStream.Reader, p_baseline : {{ .Name }} = null) -> {{ .Name }}:
+		var out := {{ .Name }}.new() if p_baseline == null else from_dict(p_baseline.to_dict())
+{{- range .Fields }}
+		if p_baseline == null or p_reader.read_flag():
+{{- range .Read }}
+			{{ . }}
+{{- end }}
+{{- end }}
+		return out
+{{- end }}
+{{- if and .Const .Binary }}
+
+	## Encode the message as the payload of match state or party data, as the changes from p_baseline when given.
+	func encode(p_baseline : {{ .Name }} = null) -> PackedByteArray:
+		var writer := NakamaBitStream.Writer.new()
+		write(writer, p_baseline)
+		return writer.get_bytes()
+
+	## Decode a payload, as the changes from p_baseline when given, or return null when it is too short.
+	static func decode(p_payload : PackedByteArray, p_baseline : {{ .Name }} = null) -> {{ .Name }}:
+		var reader := NakamaBitStream.Reader.new(p_payload)
+		var out := read(reader, p_baseline)
+		return null if reader.failed else out
+{{- else if .Const }}
+
+	## Encode the message as the payload of match state or party data.
+	func encode() -> PackedByteArray:
+		return JSON.stringify(to_dict()).to_utf8_buffer()
+
+	## Decode a payload, or return null when it is not a JSON object.
+	static func decode(p_payload : PackedByteArray) -> {{ .Name }}:
+		var parsed = JSON.parse_string(p_payload.get_string_from_utf8())
+		if parsed is Dictionary:
+			return from_dict(parsed)
+		return null
+{{- end }}
+{{- end }}
+`
+
+// The messages of an opcode schema and a runtime.Match for a Nakama Go runtime module, generated with -opcodes -go-package.
+const goMatchTemplate string = `// Code generated by codegen/main.go. DO NOT EDIT.
+
+// Package {{ .Package }} has the match state messages of {{ .ClassName }}, by opcode, and a runtime.Match which decodes
+// them for a Nakama Go runtime module. Register it with a Handlers of the messages:
+//
+//	initializer.RegisterMatch("{{ .Package }}", func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule) (runtime.Match, error) {
+//		return &{{ .Package }}.Match{Handlers: &handlers{}, TickRate: 20}, nil
+//	})
+package {{ .Package }}
+
+import (
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
+
+	"github.com/heroiclabs/nakama-common/runtime"
+)
+
+// The opcodes of the messages.
+const (
+{{- range .Classes }}{{ if .GoConst }}
+	{{ .GoConst }} int64 = {{ .Code }}
+{{- end }}{{ end }}
+)
+{{- if .Delta }}
+
+// DeltaHistory is the number of messages sent and received which a stream keeps to encode and decode changes.
+const DeltaHistory = 32
+{{- end }}
+{{- range .Classes }}
+{{ if .GoConst }}
+// {{ .Name }} is the message of {{ .GoConst }}.{{ if .Description }} {{ .Description | stripNewlines }}{{ end }}
+{{- else }}
+// {{ .Name }} is a type of the messages.{{ if .Description }} {{ .Description | stripNewlines }}{{ end }}
+{{- end }}
+type {{ .Name }} struct {
+{{- range .Fields }}
+{{- if .Description }}
+	// {{ .Description | stripNewlines }}
+{{- end }}
+	{{ .GoName }} {{ .GoType }} {{ .Tag }}
+{{- end }}
+}
+{{- if .Binary }}
+
+// Write writes m to w, as the changes from baseline when it is not nil. A nil m is written as an empty one.
+func (m *{{ .Name }}) Write(w *BitWriter, baseline *{{ .Name }}) {
+	if m == nil {
+		m = &{{ .Name }}{}
+	}
+{{- range .Fields }}
+	if baseline == nil || w.WriteFlag({{ .Differs }}) {
+{{- range .Write }}
+		{{ . }}
+{{- end }}
+	}
+{{- end }}
+}
+
+// Read{{ .Name }} reads a {{ .Name }} from r, as the changes from baseline when it is not nil.
+func Read{{ .Name }}(r *BitReader, baseline *{{ .Name }}) *{{ .Name }} {
+	m := &{{ .Name }}{}
+	if baseline != nil {
+		m = baseline.Clone()
+	}
+{{- range .Fields }}
+	if baseline == nil || r.ReadFlag() {
+{{- range .Read }}
+		{{ . }}
+{{- end }}
+	}
+{{- end }}
+	return m
+}
+
+// Clone returns a deep copy of m.
+func (m *{{ .Name }}) Clone() *{{ .Name }} {
+	if m == nil {
+		return nil
+	}
+	c := *m
+{{- range .Fields }}{{ range .Clone }}
+	{{ . }}
+{{- end }}{{ end }}
+	return &c
+}
+{{- end }}
+{{- if and .GoConst .Binary }}
+
+// Encode returns the payload of m, as the changes from baseline when it is not nil.
+func (m *{{ .Name }}) Encode(baseline *{{ .Name }}) []byte {
+	w := &BitWriter{}
+	m.Write(w, baseline)
+	return w.Bytes()
+}
+
+// Decode{{ .Name }} decodes a payload, as the changes from baseline when it is not nil.
+func Decode{{ .Name }}(payload []byte, baseline *{{ .Name }}) (*{{ .Name }}, error) {
+	r := NewBitReader(payload)
+	m := Read{{ .Name }}(r, baseline)
+	if r.Failed() {
+		return nil, ErrPayloadTooShort
+	}
+	return m, nil
+}
+{{- else if .GoConst }}
+
+// Encode returns the JSON payload of m.
+func (m *{{ .Name }}) Encode() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// Decode{{ .Name }} decodes a JSON payload.
+func Decode{{ .Name }}(payload []byte) (*{{ .Name }}, error) {
+	m := &{{ .Name }}{}
+	if err := json.Unmarshal(payload, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+{{- end }}
+{{- if .Delta }}
+
+// {{ .Name }}Stream encodes the {{ .Name }} sent to one receiver as the changes from the last one it acknowledged,
+// and decodes the {{ .Name }} received from one sender.
+type {{ .Name }}Stream struct {
+	// The sequence of the last {{ .Name }} received, to acknowledge to its sender.
+	Sequence     int64
+	received     map[int64]*{{ .Name }}
+	sent         map[int64]*{{ .Name }}
+	sentSequence int64
+	acknowledged int64
+}
+
+// Encode returns the payload of m with its sequence, as the changes from the last one acknowledged.
+func (s *{{ .Name }}Stream) Encode(m *{{ .Name }}) []byte {
+	if s.sent == nil {
+		s.sent = map[int64]*{{ .Name }}{}
+	}
+	s.sentSequence++
+	baseline := s.sent[s.acknowledged]
+	w := &BitWriter{}
+	w.WriteUint(uint64(s.sentSequence))
+	if baseline != nil {
+		w.WriteUint(uint64(s.acknowledged))
+	} else {
+		w.WriteUint(0)
+	}
+	m.Write(w, baseline)
+	s.sent[s.sentSequence] = m.Clone()
+	delete(s.sent, s.sentSequence-DeltaHistory)
+	return w.Bytes()
+}
+
+// Decode decodes a payload with its sequence, as the changes from the {{ .Name }} it was encoded against.
+// It fails when that one is unknown, or when the {{ .Name }} is older than the last one received.
+func (s *{{ .Name }}Stream) Decode(payload []byte) (*{{ .Name }}, error) {
+	r := NewBitReader(payload)
+	sequence, baselineSequence := int64(r.ReadUint()), int64(r.ReadUint())
+	baseline := s.received[baselineSequence]
+	if baselineSequence > 0 && baseline == nil {
+		return nil, ErrUnknownBaseline
+	}
+	m := Read{{ .Name }}(r, baseline)
+	switch {
+	case r.Failed():
+		return nil, ErrPayloadTooShort
+	case sequence <= s.Sequence:
+		return nil, ErrStaleMessage
+	}
+	if s.received == nil {
+		s.received = map[int64]*{{ .Name }}{}
+	}
+	s.received[sequence] = m.Clone()
+	for key := range s.received {
+		if key <= sequence-DeltaHistory {
+			delete(s.received, key)
+		}
+	}
+	s.Sequence = sequence
+	return m, nil
+}
+
+// Acknowledge that the receiver has the {{ .Name }} of sequence, so the next ones are sent as the changes from it.
+func (s *{{ .Name }}Stream) Acknowledge(sequence int64) {
+	if sequence > s.acknowledged && sequence <= s.sentSequence {
+		s.acknowledged = sequence
+	}
+}
+
+// Send{{ .Name }} sends m to each of presences, or to all the presences of the match when nil, as the changes
+// from the last {{ .Name }} each acknowledged.
+func Send{{ .Name }}(dispatcher runtime.MatchDispatcher, state *MatchState, m *{{ .Name }}, presences []runtime.Presence) error {
+	if presences == nil {
+		for _, presence := range state.Presences {
+			presences = append(presences, presence)
+		}
+	}
+	for _, presence := range presences {
+		stream := state.{{ .Name }}Streams[presence.GetSessionId()]
+		if stream == nil {
+			continue
+		}
+		if err := dispatcher.BroadcastMessage({{ .GoConst }}, stream.Encode(m), []runtime.Presence{presence}, nil, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+{{- else if and .GoConst .Binary }}
+
+// Send{{ .Name }} sends m to presences, or to all the presences of the match when nil.
+func Send{{ .Name }}(dispatcher runtime.MatchDispatcher, m *{{ .Name }}, presences []runtime.Presence) error {
+	return dispatcher.BroadcastMessage({{ .GoConst }}, m.Encode(nil), presences, nil, true)
+}
+{{- else if .GoConst }}
+
+// Send{{ .Name }} sends m to presences, or to all the presences of the match when nil.
+func Send{{ .Name }}(dispatcher runtime.MatchDispatcher, m *{{ .Name }}, presences []runtime.Presence) error {
+	data, err := m.Encode()
+	if err != nil {
+		return err
+	}
+	return dispatcher.BroadcastMessage({{ .GoConst }}, data, presences, nil, true)
+}
+{{- end }}
+{{- end }}
+
+// Handlers handles the messages received by a Match, with a method per opcode which clients send, and its ticks.
+type Handlers interface {
+{{- range .Classes }}{{ if .Handled }}
+	On{{ .Name }}(ctx context.Context, logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, state *MatchState, sender runtime.Presence, message *{{ .Name }})
+{{- end }}{{ end }}
+	// OnTick is called after the messages of each tick are handled, like to send the state of the match.
+	OnTick(ctx context.Context, logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, state *MatchState)
+}
+
+// MatchState is the state of a Match: its presences{{ if .Delta }} and the streams of the messages sent as changes{{ end }},
+// by session ID, and the state of the game in Data.
+type MatchState struct {
+	Presences map[string]runtime.Presence
+{{- range .Classes }}{{ if .Delta }}
+	{{ .Name }}Streams map[string]*{{ .Name }}Stream
+{{- end }}{{ end }}
+	Data interface{}
+}
+
+// Match is a runtime.Match which keeps track of its presences, and calls its Handlers with the messages of each
+// tick decoded. Embed it in another type to change its other methods, like MatchJoinAttempt.
+type Match struct {
+	Handlers Handlers
+	// The number of ticks per second, 10 when 0.
+	TickRate int
+	Label    string
+}
+
+func (m *Match) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
+	state := &MatchState{
+		Presences: map[string]runtime.Presence{},
+{{- range .Classes }}{{ if .Delta }}
+		{{ .Name }}Streams: map[string]*{{ .Name }}Stream{},
+{{- end }}{{ end }}
+	}
+	tickRate := m.TickRate
+	if tickRate == 0 {
+		tickRate = 10
+	}
+	return state, tickRate, m.Label
+}
+
+func (m *Match) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
+	return state, true, ""
+}
+
+func (m *Match) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	s := state.(*MatchState)
+	for _, presence := range presences {
+		s.Presences[presence.GetSessionId()] = presence
+{{- range .Classes }}{{ if .Delta }}
+		s.{{ .Name }}Streams[presence.GetSessionId()] = &{{ .Name }}Stream{}
+{{- end }}{{ end }}
+	}
+	return s
+}
+
+func (m *Match) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	s := state.(*MatchState)
+	for _, presence := range presences {
+		delete(s.Presences, presence.GetSessionId())
+{{- range .Classes }}{{ if .Delta }}
+		delete(s.{{ .Name }}Streams, presence.GetSessionId())
+{{- end }}{{ end }}
+	}
+	return s
+}
+
+func (m *Match) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	s := state.(*MatchState)
+	for _, message := range messages {
+		switch message.GetOpCode() {
+{{- range .Classes }}{{ if .Handled }}
+		case {{ .GoConst }}:
+{{- if .Delta }}
+			stream := s.{{ .Name }}Streams[message.GetSessionId()]
+			if stream == nil {
+				continue
+			}
+			payload, err := stream.Decode(message.GetData())
+{{- else if .Binary }}
+			payload, err := Decode{{ .Name }}(message.GetData(), nil)
+{{- else }}
+			payload, err := Decode{{ .Name }}(message.GetData())
+{{- end }}
+			if err != nil {
+				logger.Warn("Invalid {{ .Name }} from %s: %v", message.GetUserId(), err)
+				continue
+			}
+			m.Handlers.On{{ .Name }}(ctx, logger, dispatcher, tick, s, message, payload)
+{{- else if .GoConst }}
+		case {{ .GoConst }}:
+			logger.Warn("{{ .Name }} from %s, which only the match handler sends", message.GetUserId())
+{{- end }}{{ end }}
+		default:
+			logger.Warn("Unknown opcode %d from %s", message.GetOpCode(), message.GetUserId())
+		}
+	}
+	m.Handlers.OnTick(ctx, logger, dispatcher, tick, s)
+	return s
+}
+
+func (m *Match) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	return state
+}
+
+func (m *Match) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	return state, ""
+}
+{{- if .Binary }}
+
+// The errors of decoding binary messages.
+var (
+	ErrPayloadTooShort = errors.New("the payload is too short")
+	ErrUnknownBaseline = errors.New("the changes are from a message which was not received")
+	ErrStaleMessage    = errors.New("the message is older than the last one received")
+)
+
+// BitWriter packs the values of binary messages, least significant bit first, like NakamaBitStream.Writer.
+type BitWriter struct {
+	bytes []byte
+	bits  int
+}
+
+// WriteBits writes the count lowest bits of v.
+func (w *BitWriter) WriteBits(v uint64, count int) {
+	for i := 0; i < count; i++ {
+		if w.bits%8 == 0 {
+			w.bytes = append(w.bytes, 0)
+		}
+		w.bytes[w.bits/8] |= byte(v>>i&1) << (w.bits % 8)
+		w.bits++
+	}
+}
+
+// WriteFlag writes a flag in one bit, and returns it.
+func (w *BitWriter) WriteFlag(v bool) bool {
+	if v {
+		w.WriteBits(1, 1)
+	} else {
+		w.WriteBits(0, 1)
+	}
+	return v
+}
+
+// WriteUint writes an unsigned varint.
+func (w *BitWriter) WriteUint(v uint64) {
+	for v >= 0x80 {
+		w.WriteBits(v&0x7F|0x80, 8)
+		v >>= 7
+	}
+	w.WriteBits(v, 8)
+}
+
+// WriteInt writes a zigzag encoded varint.
+func (w *BitWriter) WriteInt(v int64) {
+	w.WriteUint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+// WriteRanged writes an int from min to min + steps, clamped to that range, in bits bits.
+func (w *BitWriter) WriteRanged(v, min int64, steps uint64, bits int) {
+	if v < min {
+		v = min
+	} else if v > min+int64(steps) {
+		v = min + int64(steps)
+	}
+	w.WriteBits(uint64(v-min), bits)
+}
+
+// WriteQuantised writes a float as the nearest of steps steps of precision from min, in bits bits.
+func (w *BitWriter) WriteQuantised(v, min, precision float64, steps uint64, bits int) {
+	step := 0.0
+	if !math.IsNaN(v) {
+		step = math.Max(0, math.Min(math.Round((v-min)/precision), float64(steps)))
+	}
+	w.WriteBits(uint64(step), bits)
+}
+
+// WriteDouble writes a float in 64 bits.
+func (w *BitWriter) WriteDouble(v float64) {
+	w.WriteBits(math.Float64bits(v), 64)
+}
+
+// WriteBytes writes bytes after their size.
+func (w *BitWriter) WriteBytes(v []byte) {
+	w.WriteUint(uint64(len(v)))
+	for _, b := range v {
+		w.WriteBits(uint64(b), 8)
+	}
+}
+
+// WriteString writes a string as its bytes.
+func (w *BitWriter) WriteString(v string) {
+	w.WriteBytes([]byte(v))
+}
+
+// Bytes returns the bytes written.
+func (w *BitWriter) Bytes() []byte {
+	return w.bytes
+}
+
+// BitReader unpacks the values of binary messages like NakamaBitStream.Reader: once a read goes past the end of
+// its bytes, it fails and reads zeros.
+type BitReader struct {
+	bytes  []byte
+	bit    int
+	failed bool
+}
+
+func NewBitReader(payload []byte) *BitReader {
+	return &BitReader{bytes: payload}
+}
+
+// Failed returns if a value was read past the end of the bytes.
+func (r *BitReader) Failed() bool {
+	return r.failed
+}
+
+// Remaining returns the number of bits left to read.
+func (r *BitReader) Remaining() int {
+	return len(r.bytes)*8 - r.bit
+}
+
+// ReadBits reads count bits.
+func (r *BitReader) ReadBits(count int) (v uint64) {
+	if r.failed || count > r.Remaining() {
+		r.failed = true
+		return 0
+	}
+	for i := 0; i < count; i++ {
+		v |= uint64(r.bytes[r.bit/8]>>(r.bit%8)&1) << i
+		r.bit++
+	}
+	return v
+}
+
+func (r *BitReader) ReadFlag() bool {
+	return r.ReadBits(1) == 1
+}
+
+func (r *BitReader) ReadUint() (v uint64) {
+	for i := 0; i < 10; i++ {
+		group := r.ReadBits(8)
+		v |= (group & 0x7F) << (7 * i)
+		if group&0x80 == 0 {
+			return v
+		}
+	}
+	r.failed = true
+	return 0
+}
+
+func (r *BitReader) ReadInt() int64 {
+	v := r.ReadUint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// ReadCount reads the size of an array, which fails when there are not as many bits left.
+func (r *BitReader) ReadCount() uint64 {
+	count := r.ReadUint()
+	if count > uint64(r.Remaining()) {
+		r.failed = true
+		return 0
+	}
+	return count
+}
+
+func (r *BitReader) ReadRanged(min int64, steps uint64, bits int) int64 {
+	return min + int64(minUint(r.ReadBits(bits), steps))
+}
+
+func (r *BitReader) ReadQuantised(min, precision float64, steps uint64, bits int) float64 {
+	return min + float64(minUint(r.ReadBits(bits), steps))*precision
+}
+
+func (r *BitReader) ReadDouble() float64 {
+	return math.Float64frombits(r.ReadBits(64))
+}
+
+func (r *BitReader) ReadBytes() []byte {
+	size := r.ReadUint()
+	if size > uint64(r.Remaining()/8) {
+		r.failed = true
+		return nil
+	}
+	out := make([]byte, size)
+	for i := range out {
+		out[i] = byte(r.ReadBits(8))
+	}
+	return out
+}
+
+func (r *BitReader) ReadString() string {
+	return string(r.ReadBytes())
+}
+
+func minUint(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// changed returns if a field differs from the one of the baseline.
+func changed(a, b interface{}) bool {
+	return !reflect.DeepEqual(a, b)
+}
+{{- end }}
+`
+
+// The setter of a property of the classes of the API and of the payloads, described by a ClassSetter, which checks
+// and coerces the values with the rules of _from_dict.
+const classSetterTemplate string = `{{ define "setter" }}
+		set(p_value):
+{{- if eq .Kind "enum" }}
+			if {{ if .Nullable }}p_value != null and {{ end }}not {{ .Values }}.has(p_value):
+				push_error("Invalid {{ .Class }} value for {{ .Label }}: %s" % p_value)
+				return
+			{{ .Field }} = p_value
+{{- else if eq .Kind "map" }}{{/* Dictionaries, with their values coerced */}}
+			var map := {}
+			for k{{ decl "Variant" }} in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for {{ .Label }}: %s" % [k])
+					return
+				var v : Variant = p_value[k]
+				{{- with coerceValue .JSONType (printf "an element of %s" .Label) "\t\t\t\t" }}
+				{{ . }}
+				{{- end }}
+				map[str(k)] = v
+			{{ .Field }} = map
+{{- else if eq .Kind "object_map" }}{{/* Dictionaries are deserialized */}}
+			var map := {}
+			for k{{ decl "Variant" }} in p_value:
+				if not (k is String or k is StringName):
+					push_error("Invalid key for {{ .Label }}: %s" % [k])
+					return
+				var e : Variant = p_value[k]
+				if e is Dictionary:
+					var raw : Dictionary = e
+					map[str(k)] = {{ .Class }}._from_dict(raw)
+				elif e is {{ .Class }}:
+					map[str(k)] = e
+				else:
+					push_error("Invalid {{ .Class }} value for an element of {{ .Label }}: %s" % [e])
+					return
+			{{ .Field }} = map
+{{- else if eq .Kind "object_array" }}{{/* Dictionaries are deserialized */}}
+			var arr := []
+			for e{{ decl "Variant" }} in p_value:
+				if e is Dictionary:
+					var raw : Dictionary = e
+					arr.append({{ .Class }}._from_dict(raw))
+				elif e is {{ .Class }}:
+					arr.append(e)
+				else:
+					push_error("Invalid {{ .Class }} value for an element of {{ .Label }}: %s" % [e])
+					return
+			{{ .Field }} = arr
+			{{- if .Lazy }}
+			{{ .Field }}_lazy = false
+			{{- end }}
+{{- else if eq .Kind "array" }}{{/* Elements are coerced into the array _from_dict builds */}}
+			var arr{{ .ArrayDecl }}
+			for e{{ decl "Variant" }} in p_value:
+				var v : Variant = e
+				{{- with coerceValue .JSONType (printf "an element of %s" .Label) "\t\t\t\t" }}
+				{{ . }}
+				{{- end }}
+				arr.append(v)
+			{{ .Field }} = arr
+{{- else if coerceValue .JSONType .Label "" }}{{/* Simple types */}}
+			var v : Variant = p_value
+			{{ coerceValue .JSONType .Label "\t\t\t" }}
+			{{ .Field }} = v
+{{- else }}
+			{{ .Field }} = p_value
+{{- end }}
+{{- end }}`
+
+// payloadFuncs are the functions of the templates of the payload classes.
+var payloadFuncs = template.FuncMap{"stripNewlines": stripNewlines, "coerceValue": coerceValue, "decl": decl}
+
+// The classes of JSON payloads, like the requests and responses of RPCs, in the style of the classes of NakamaAPI.
+// Append it to a template which includes it with {{ template "payloadClasses" .Classes }}, and the enums of JSON
+// Schemas with {{ template "schemaEnums" .Enums }}.
+const payloadClassTemplate string = `
+{{- define "payloadClasses" }}
+{{- range . }}
+{{ if .Description }}
+## {{ .Description | stripNewlines }}
+{{- end }}
+class {{ .Name }} extends {{ or .Extends "NakamaAsyncResult" }}:
+
+	const _SCHEMA = {
+	{{- range .Fields }}
+		"{{ .Key }}": {"name": "_{{ .Name }}", "type": {{ .SchemaType }}, "required": {{ .Required }}{{ if .Content }}, "content": {{ .Content }}{{ end }}},
+	{{- end }}
+	}
+{{- range .Fields }}
+
+	var _{{ .Name }}
+	{{- if .Description }}
+	## {{ .Description | stripNewlines }}
+	{{- end }}
+	var {{ .Name }} : {{ .Type }}:
+		get:
+		{{- if eq .Kind "object" }}
+			return _{{ .Name }} as {{ .Type }}
+		{{- else if eq .Type "Variant" }}
+			return _{{ .Name }}
+		{{- else }}
+			return {{ .Default }} if not _{{ .Name }} is {{ .Type }} else _{{ .Name }}
+		{{- end }}
+		{{- template "setter" .Setter }}
+{{- end }}
+
+	## Fields received which are not part of the class, sent back by _to_dict().
+	var _unknown : Dictionary = {}
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	static func create(p_ns : GDScript, p_dict : Dictionary) -> {{ .Name }}:
+		return _from_dict(p_dict)
+
+	func serialize() -> Dictionary:
+		return _to_dict()
+
+	# Build a {{ .Name }} from a decoded JSON dictionary.
+	static func _from_dict(p_dict : Dictionary) -> {{ .Name }}:
+		var obj := {{ .Name }}.new()
+		var v
+	{{- range .Fields }}
+		v = p_dict.get("{{ .Key }}")
+		{{- if eq .Kind "object" }}
+		if v is Dictionary:
+			obj._{{ .Name }} = {{ .Type }}._from_dict(v)
+		{{- else if eq .Kind "object_array" }}
+		if v is Array:
+			var arr := []
+			for e in v:
+				if e is Dictionary:
+					arr.append({{ .Element }}._from_dict(e))
+			obj._{{ .Name }} = arr
+		{{- else if eq .Kind "object_map" }}
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				if v[k] is Dictionary:
+					map[k] = {{ .Element }}._from_dict(v[k])
+			obj._{{ .Name }} = map
+		{{- else if eq .Kind "array" }}
+		if v is Array:
+			var arr := {{ .Default }}
+			for e in v:
+				arr.append({{ .Convert }}(e))
+			obj._{{ .Name }} = arr
+		{{- else if eq .Kind "map" }}
+		if v is Dictionary:
+			var map := {}
+			for k in v:
+				map[k] = {{ .Convert }}(v[k])
+			obj._{{ .Name }} = map
+		{{- else if or (eq .Type "int") (eq .Type "float") }}
+		if v is int or v is float:
+			obj._{{ .Name }} = {{ .Type }}(v)
+		{{- else if eq .Type "Variant" }}
+		obj._{{ .Name }} = v
+		{{- else }}
+		if v is {{ .Type }}:
+			obj._{{ .Name }} = v
+		{{- end }}
+	{{- end }}
+		for k in p_dict:
+			if not _SCHEMA.has(k):
+				obj._unknown[k] = p_dict[k]
+		return obj
+
+	# Convert to a dictionary ready to be encoded as JSON.
+	func _to_dict() -> Dictionary:
+		var out := {}
+	{{- range .Fields }}
+		{{- if eq .Kind "object" }}
+		if _{{ .Name }} is Object:
+			out["{{ .Key }}"] = _{{ .Name }}._to_dict()
+		{{- else if eq .Kind "object_array" }}
+		if _{{ .Name }} is Array:
+			var arr := []
+			for e in _{{ .Name }}:
+				if e is {{ .Element }}:
+					arr.append(e._to_dict())
+			out["{{ .Key }}"] = arr
+		{{- else if eq .Kind "object_map" }}
+		if _{{ .Name }} is Dictionary:
+			var map := {}
+			for k in _{{ .Name }}:
+				if _{{ .Name }}[k] is {{ .Element }}:
+					map[k] = _{{ .Name }}[k]._to_dict()
+			out["{{ .Key }}"] = map
+		{{- else if eq .Kind "array" }}
+		if _{{ .Name }} != null:
+			out["{{ .Key }}"] = Array(_{{ .Name }})
+		{{- else if .Always }}
+		out["{{ .Key }}"] = {{ if .Nullable }}_{{ end }}{{ .Name }}
+		{{- else }}
+		if _{{ .Name }} != null:
+			out["{{ .Key }}"] = _{{ .Name }}
+		{{- end }}
+	{{- end }}
+		for k in _unknown:
+			if not out.has(k):
+				out[k] = _unknown[k]
+		return out
+{{- if .Validate }}
+
+	## Return the errors of the fields which do not match the schema, empty when there are none.
+	func validate() -> PackedStringArray:
+		var errors := PackedStringArray()
+	{{- range .Fields }}{{ range .Checks }}
+		{{ . }}
+	{{- end }}{{ end }}
+		return errors
+{{- end }}
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return str(_to_dict())
+{{- end }}
+{{- end }}
+
+{{- define "schemaEnums" }}
+{{- range . }}
+{{ if .Description }}
+## {{ .Description | stripNewlines }}
+{{- end }}
+class {{ .Name }}:
+	{{- range .Constants }}
+	const {{ .Name }} = {{ .Value }}
+	{{- end }}
+	const VALUES = {{ .Values }}
+{{- end }}
+{{- end }}` + classSetterTemplate
+
+// The structs of a Go package, generated with -from-go.
+const goTypesTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+class_name {{.ClassName}}Types
+
+## The structs of a Go package, like the values of storage objects or the content of notifications of a Nakama
+## Go runtime module, encoded and decoded as encoding/json does with their json tags.
+{{- template "payloadClasses" .Classes }}
+`
+
+// The classes of JSON Schema documents, generated with -json-schema.
+const jsonSchemaTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+class_name {{.ClassName}}Types
+
+## The types of JSON Schema documents, like the values of storage objects or the content of notifications, with
+## validate() to check them against their schema.
+{{- template "schemaEnums" .Enums }}
+{{- template "payloadClasses" .Classes }}
+`
+
+// The typed storage collections of a manifest, generated with -storage.
+const storageTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+class_name {{.ClassName}}Storage
+
+## The storage collections of a game, with the classes of their values. Read and write them with a NakamaClient
+## and a session:
+##     var storage := {{.ClassName}}Storage.new(client)
+##     var object = await storage.<collection>.read_async(session)
+##     object.value.<field> = ...
+##     var ack = await storage.<collection>.write_object_async(session, object)
+## Objects are written with the version they were read at, so the write is rejected when another one changed
+## them since, or created them when they did not exist.
+{{- template "schemaEnums" .Enums }}
+{{- template "payloadClasses" .Classes }}
+{{- range .Collections }}
+
+## An object of the {{ .Collection }} collection.
+class {{ .Name }}Object extends NakamaAsyncResult:
+
+	## The key of the object.
+	var key : String
+	## The user who owns the object, empty for the objects of the server.
+	var user_id : String
+	## The version of the object, or "*" when it does not exist. Writes with it are rejected when the object
+	## changed since.
+	var version : String
+	var permission_read : int
+	var permission_write : int
+	var create_time : String
+	var update_time : String
+	## If the object exists in the storage.
+	var exists : bool
+	var value : {{ .ValueType }}
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	static func _from_api(p_object : NakamaAPI.ApiStorageObject) -> {{ .Name }}Object:
+		var json = JSON.parse_string(p_object.value)
+		if not json is Dictionary:
+			return {{ .Name }}Object.new(NakamaException.new("Invalid value of %s/%s: %s" % [p_object.collection, p_object.key, p_object.value]))
+		var obj := {{ .Name }}Object.new()
+		obj.key = p_object.key
+		obj.user_id = p_object.user_id
+		obj.version = p_object.version
+		obj.permission_read = p_object.permission_read
+		obj.permission_write = p_object.permission_write
+		obj.create_time = p_object.create_time
+		obj.update_time = p_object.update_time
+		obj.exists = true
+		{{- if .Value }}
+		obj.value = {{ .Value }}._from_dict(json)
+		{{- else }}
+		obj.value = json
+		{{- end }}
+		return obj
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "{{ .Name }}Object<key=%s, user_id=%s, version=%s, value=%s>" % [key, user_id, version, value]
+
+## A page of objects of the {{ .Collection }} collection.
+class {{ .Name }}ObjectList extends NakamaAsyncResult:
+
+	## The {{ .Name }}Object of the page.
+	var objects : Array = []
+	## The cursor of the next page, empty when this one is the last.
+	var cursor : String
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "{{ .Name }}ObjectList<objects=%s, cursor=%s>" % [objects, cursor]
+{{ if .Description }}
+## {{ .Description | stripNewlines }}
+{{- else }}
+## The {{ .Collection }} collection.
+{{- end }}
+class {{ .Name }}Collection extends RefCounted:
+
+	const NAME = "{{ .Collection }}"
+	const PERMISSION_READ = {{ .PermissionRead }}
+	const PERMISSION_WRITE = {{ .PermissionWrite }}
+
+	var _client : NakamaClient
+
+	func _init(p_client : NakamaClient):
+		_client = p_client
+
+	## The key of an object of the collection.
+	static func key({{ range $i, $p := .KeyParams }}{{ if $i }}, {{ end }}{{ $p }} : String{{ end }}) -> String:
+	{{- if .KeyParams }}
+		return {{ .KeyFormat }} % [{{ .KeyArguments }}]
+	{{- else }}
+		return {{ .KeyFormat }}
+	{{- end }}
+
+	## Read an object of the collection. When it does not exist, its value is not set and its version is "*". [br]
+	## p_session - The session of the user. [br]
+	## p_user_id - The user who owns the object, the one of the session when it is empty. [br]
+	## Returns a task which resolves to the {{ .Name }}Object.
+	func read_async(p_session : NakamaSession{{ .KeyDeclarations }}, p_user_id : String = "", p_cancel_token : NakamaCancellationToken = null) -> {{ .Name }}Object:
+		var id := NakamaStorageObjectId.new(NAME, key({{ .KeyArguments }}), p_user_id if p_user_id != "" else p_session.user_id)
+		var result : NakamaAPI.ApiStorageObjects = await _client.read_storage_objects_async(p_session, [id], p_cancel_token)
+		if result.is_exception():
+			return {{ .Name }}Object.new(result.get_exception())
+		if result.objects.is_empty():
+			var missing := {{ .Name }}Object.new()
+			missing.key = id.key
+			missing.user_id = id.user_id
+			missing.version = "*"
+			return missing
+		return {{ .Name }}Object._from_api(result.objects[0])
+
+	## Write an object of the collection for the user of the session.{{ if .Value }} The value is validated first.{{ end }} [br]
+	## p_session - The session of the user. [br]
+	## p_value - The value of the object. [br]
+	## p_version - The version the object must be at for the write to succeed: the one it was read at, "*" when
+	## it must not exist, or empty to write it in any case. [br]
+	## Returns a task which resolves to the acknowledgement of the write, with the new version of the object.
+	func write_async(p_session : NakamaSession{{ .KeyDeclarations }}, p_value : {{ .ValueType }}, p_version : String = "",
+			p_permission_read : int = PERMISSION_READ, p_permission_write : int = PERMISSION_WRITE,
+			p_cancel_token : NakamaCancellationToken = null) -> NakamaAPI.ApiStorageObjectAck:
+		return await _write_async(p_session, key({{ .KeyArguments }}), p_value, p_version, p_permission_read, p_permission_write, p_cancel_token)
+
+	## Write an object read with read_async() or list_async() back, at the version it was read at. Its version is
+	## updated when the write succeeds, so that it can be written again. [br]
+	## p_session - The session of the user. [br]
+	## p_object - The object, with its value changed. [br]
+	## Returns a task which resolves to the acknowledgement of the write, with the new version of the object.
+	func write_object_async(p_session : NakamaSession, p_object : {{ .Name }}Object, p_cancel_token : NakamaCancellationToken = null) -> NakamaAPI.ApiStorageObjectAck:
+		var permission_read : int = p_object.permission_read if p_object.exists else PERMISSION_READ
+		var permission_write : int = p_object.permission_write if p_object.exists else PERMISSION_WRITE
+		var ack : NakamaAPI.ApiStorageObjectAck = await _write_async(p_session, p_object.key, p_object.value, p_object.version, permission_read, permission_write, p_cancel_token)
+		if not ack.is_exception():
+			p_object.version = ack.version
+			p_object.permission_read = permission_read
+			p_object.permission_write = permission_write
+			p_object.exists = true
+		return ack
+
+	func _write_async(p_session : NakamaSession, p_key : String, p_value : {{ .ValueType }}, p_version : String,
+			p_permission_read : int, p_permission_write : int, p_cancel_token : NakamaCancellationToken) -> NakamaAPI.ApiStorageObjectAck:
+	{{- if .Value }}
+		if p_value == null:
+			return NakamaAPI.ApiStorageObjectAck.new(NakamaException.new("Missing value of %s/%s" % [NAME, p_key]))
+		var errors := p_value.validate()
+		if not errors.is_empty():
+			return NakamaAPI.ApiStorageObjectAck.new(NakamaException.new("Invalid value of %s/%s: %s" % [NAME, p_key, ", ".join(errors)]))
+		var value := JSON.stringify(p_value.serialize())
+	{{- else }}
+		var value := JSON.stringify(p_value)
+	{{- end }}
+		var object := NakamaWriteStorageObject.new(NAME, p_key, p_permission_read, p_permission_write, value, p_version)
+		var result : NakamaAPI.ApiStorageObjectAcks = await _client.write_storage_objects_async(p_session, [object], p_cancel_token)
+		if result.is_exception():
+			return NakamaAPI.ApiStorageObjectAck.new(result.get_exception())
+		if result.acks.is_empty():
+			return NakamaAPI.ApiStorageObjectAck.new(NakamaException.new("Missing acknowledgement of %s/%s" % [NAME, p_key]))
+		return result.acks[0]
+
+	## List the objects of the collection which can be read. [br]
+	## p_session - The session of the user. [br]
+	## p_user_id - The user who owns the objects, or empty for the public ones of all the users. [br]
+	## p_limit - The number of objects of the page. [br]
+	## p_cursor - The cursor of the page, from the previous one. [br]
+	## Returns a task which resolves to the {{ .Name }}ObjectList.
+	func list_async(p_session : NakamaSession, p_user_id : String = "", p_limit : int = 10, p_cursor = null, p_cancel_token : NakamaCancellationToken = null) -> {{ .Name }}ObjectList:
+		var result : NakamaAPI.ApiStorageObjectList = await _client.list_storage_objects_async(p_session, NAME, p_user_id, p_limit, p_cursor, p_cancel_token)
+		if result.is_exception():
+			return {{ .Name }}ObjectList.new(result.get_exception())
+		var list := {{ .Name }}ObjectList.new()
+		list.cursor = result.cursor
+		for object in result.objects:
+			list.objects.append({{ .Name }}Object._from_api(object))
+		return list
+{{- end }}
+{{ range .Collections }}
+var {{ .Field }} : {{ .Name }}Collection
+{{- end }}
+
+func _init(p_client : NakamaClient):
+{{- range .Collections }}
+	{{ .Field }} = {{ .Name }}Collection.new(p_client)
+{{- end }}
+`
+
+// The typed notifications of a registry, generated with -notifications.
+const notificationTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+
+## The notifications of {{.ClassName}}, by code, with the classes of their content. [br]
+## Create it for a socket, and keep a reference to it: it emits a received_* signal with the typed notification of
+## each notification received. Pass the notifications listed with NakamaClient.list_notifications_async() to
+## dispatch_all(), or convert them with parse().
+class_name {{.ClassName}}Notifications
+
+## The codes of the notifications.
+enum Code {
+{{- range .Notifications }}
+	{{ .Const }} = {{ .Code }},
+{{- end }}
+}
+{{ range .Notifications }}
+## Emitted when a {{ .Name }} is received.
+signal {{ .Signal }}(p_notification : {{ .Name }})
+{{ end }}
+## Emitted when a notification has an unknown code, or a content which can not be decoded.
+signal received_unknown(p_notification : NakamaAPI.ApiNotification)
+
+var _socket : NakamaSocket
+
+func _init(p_socket : NakamaSocket = null):
+	_socket = p_socket
+	if _socket != null:
+		_socket.received_notification.connect(dispatch)
+
+## Emit the signal of the code of a notification.
+func dispatch(p_notification : NakamaAPI.ApiNotification) -> void:
+	match p_notification.code:
+{{- range .Notifications }}
+		Code.{{ .Const }}:
+			var parsed := {{ .Name }}._from_api(p_notification)
+			if not parsed.is_exception():
+				{{ .Signal }}.emit(parsed)
+				return
+{{- end }}
+	received_unknown.emit(p_notification)
+
+## Emit the signals of notifications, like the ones of a NakamaAPI.ApiNotificationList.
+func dispatch_all(p_notifications : Array) -> void:
+	for n in p_notifications:
+		dispatch(n)
+
+## Convert a notification to the class of its code. Return null when its code is unknown, and an exception when its
+## content can not be decoded.
+static func parse(p_notification : NakamaAPI.ApiNotification) -> BaseNotification:
+	match p_notification.code:
+{{- range .Notifications }}
+		Code.{{ .Const }}:
+			return {{ .Name }}._from_api(p_notification)
+{{- end }}
+	return null
+
+## The fields the notifications of all the codes have.
+class BaseNotification extends NakamaAsyncResult:
+
+	## The ID of the notification.
+	var id : String
+	## The code of the notification.
+	var code : int
+	## The subject of the notification.
+	var subject : String
+	## The ID of the user who sent the notification, empty when the server sent it.
+	var sender_id : String
+	## The time the notification was created.
+	var create_time : String
+	## If the notification is stored until it is deleted, rather than only sent to the users online.
+	var persistent : bool
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	func _set_api(p_notification : NakamaAPI.ApiNotification) -> void:
+		id = p_notification.id
+		code = p_notification.code
+		subject = p_notification.subject
+		sender_id = p_notification.sender_id
+		create_time = p_notification.create_time
+		persistent = p_notification.persistent
+{{- template "schemaEnums" .Enums }}
+{{- range .Notifications }}
+{{ if .Description }}
+## {{ .Description | stripNewlines }}
+{{- end }}
+class {{ .Name }} extends BaseNotification:
+
+	const CODE := Code.{{ .Const }}
+
+	## The content of the notification.
+	var content : {{ .ContentType }}
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	static func _from_api(p_notification : NakamaAPI.ApiNotification) -> {{ .Name }}:
+		var json = JSON.parse_string(p_notification.content) if p_notification.content != "" else {}
+		if not json is Dictionary:
+			return {{ .Name }}.new(NakamaException.new("Invalid content of notification %s: %s" % [p_notification.id, p_notification.content]))
+		var parsed := {{ .Name }}.new()
+		parsed._set_api(p_notification)
+		{{- if .Content }}
+		parsed.content = {{ .Content }}._from_dict(json)
+		{{- else }}
+		parsed.content = json
+		{{- end }}
+		return parsed
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "{{ .Name }}<id=%s, subject=%s, sender_id=%s, content=%s>" % [id, subject, sender_id, content]
+{{- end }}
+{{- template "payloadClasses" .Classes }}
+`
+
+// The typed flags, experiments and live events of a Satori manifest, generated with -satori.
+const satoriTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+
+## The flags, experiments and live events of {{.ClassName}} in Satori, with typed values. [br]
+## Flags have the default of the manifest until they are fetched, and keep it when the server is unreachable or
+## sends a value which can not be parsed.
+class_name {{.ClassName}}Satori
+{{ range .Experiments }}
+## The variants of the experiment {{ .Key }}{{ if .Description }}: {{ .Description | stripNewlines }}{{ end }} [br]
+## NONE when the identity is not in the experiment.
+enum {{ .Pascal }} { NONE,{{ range .Variants }} {{ .Name }},{{ end }} }
+{{ end }}
+var _client : SatoriClient
+
+## The flags, updated by get_flags_async() and get_*_async().
+var flags := Flags.new()
+## The experiments, updated by get_experiments_async().
+var experiments := Experiments.new()
+## The live events, updated by get_live_events_async().
+var live_events := LiveEvents.new()
+
+func _init(p_client : SatoriClient):
+	_client = p_client
+
+## Fetch the flags. [br]
+## p_session - The session of the identity. [br]
+## Returns a task which resolves to the Flags, with their defaults and the exception when the server is unreachable.
+func get_flags_async(p_session : SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> Flags:
+	var result : SatoriAPI.ApiFlagList = await _client.get_flags_async(p_session, Flags.NAMES, p_cancel_token)
+	if result.is_exception():
+		return Flags.new(result.get_exception())
+	flags = Flags.new()
+	flags._set_flags(result.flags)
+	return flags
+{{- range .Flags }}
+
+## Fetch the flag {{ .Key }}{{ if .Description }}: {{ .Description | stripNewlines }}{{ end }} [br]
+## p_session - The session of the identity. [br]
+## Returns a task which resolves to the value of the flag, the last one fetched or its default when the server is
+## unreachable.
+func get_{{ .Name }}_async(p_session : SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> {{ if eq .Kind "json" }}{{ .ClassType }}{{ else }}{{ .Type }}{{ end }}:
+	var result : SatoriAPI.ApiFlagList = await _client.get_flags_async(p_session, ["{{ .Key }}"], p_cancel_token)
+	if not result.is_exception():
+		flags._set_flags(result.flags)
+	return flags.{{ .Name }}
+{{- end }}
+
+## Fetch the experiments the identity is in. [br]
+## p_session - The session of the identity. [br]
+## Returns a task which resolves to the Experiments.
+func get_experiments_async(p_session : SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> Experiments:
+	var result : SatoriAPI.ApiExperimentList = await _client.get_experiments_async(p_session, Experiments.NAMES, p_cancel_token)
+	if result.is_exception():
+		return Experiments.new(result.get_exception())
+	experiments = Experiments.new()
+	experiments._set_experiments(result.experiments)
+	return experiments
+
+## Fetch the live events which are active, or will be. [br]
+## p_session - The session of the identity. [br]
+## Returns a task which resolves to the LiveEvents.
+func get_live_events_async(p_session : SatoriSession, p_cancel_token : SatoriCancellationToken = null) -> LiveEvents:
+	var result : SatoriAPI.ApiLiveEventList = await _client.get_live_events_async(p_session, LiveEvents.NAMES, p_cancel_token)
+	if result.is_exception():
+		return LiveEvents.new(result.get_exception())
+	live_events = LiveEvents.new()
+	live_events._set_live_events(result.live_events)
+	return live_events
+
+## The flags, with their defaults until they are fetched.
+class Flags extends SatoriAsyncResult:
+
+	## The names of the flags.
+	const NAMES := [{{ range $i, $f := .Flags }}{{ if $i }}, {{ end }}"{{ $f.Key }}"{{ end }}]
+{{ range .Flags }}
+	{{- if .Description }}
+	## {{ .Description | stripNewlines }}
+	{{- end }}
+	{{- if and (eq .Kind "json") .Class }}
+	var {{ .Name }} : {{ .Class }}
+	{{- else }}
+	var {{ .Name }} : {{ .Type }} = {{ .Default }}
+	{{- end }}
+{{ end }}
+	## The values of the flags as the server sent them, by name.
+	var values := {}
+
+	func _init(p_exception = null):
+		super(p_exception)
+	{{- range .Flags }}{{ if and (eq .Kind "json") .Class }}
+		{{ .Name }} = {{ .Class }}._from_dict({{ .Default }})
+	{{- end }}{{ end }}
+
+	func _set_flags(p_flags : Array) -> void:
+		for flag in p_flags:
+			var value = flag.get("value")
+			if not value is String:
+				continue
+			values[flag.get("name")] = value
+			match flag.get("name"):
+			{{- range .Flags }}
+				"{{ .Key }}":
+				{{- if eq .Kind "bool" }}
+					if value == "true" or value == "false":
+						{{ .Name }} = value == "true"
+				{{- else if eq .Kind "int" }}
+					if value.is_valid_int():
+						{{ .Name }} = value.to_int()
+				{{- else if eq .Kind "float" }}
+					if value.is_valid_float():
+						{{ .Name }} = value.to_float()
+				{{- else if eq .Kind "string" }}
+					{{ .Name }} = value
+				{{- else }}
+					var json = JSON.parse_string(value)
+					if json is Dictionary:
+						{{ .Name }} = {{ if .Class }}{{ .Class }}._from_dict(json){{ else }}json{{ end }}
+				{{- end }}
+			{{- end }}
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "Flags<{{ range $i, $f := .Flags }}{{ if $i }}, {{ end }}{{ $f.Name }}=%s{{ end }}>" % [{{ range $i, $f := .Flags }}{{ if $i }}, {{ end }}{{ $f.Name }}{{ end }}]
+
+## The variants of the experiments the identity is in, with their config.
+class Experiments extends SatoriAsyncResult:
+
+	## The names of the experiments.
+	const NAMES := [{{ range $i, $e := .Experiments }}{{ if $i }}, {{ end }}"{{ $e.Key }}"{{ end }}]
+	## The variants of the experiments, by name then value.
+	const VARIANTS := {
+	{{- range .Experiments }}{{ $enum := .Pascal }}
+		"{{ .Key }}": { {{- range $i, $v := .Variants }}{{ if $i }}, {{ end }}{{ $v.Value }}: {{ $enum }}.{{ $v.Name }}{{ end -}} },
+	{{- end }}
+	}
+{{ range .Experiments }}
+	{{- if .Description }}
+	## {{ .Description | stripNewlines }}
+	{{- end }}
+	var {{ .Name }} := {{ .Pascal }}.NONE
+	{{- if .Class }}
+	## The value of the variant of {{ .Name }}, decoded as JSON.
+	var {{ .Name }}_config : {{ .Class }}
+	{{- end }}
+{{ end }}
+	## The values of the variants as the server sent them, by name of experiment.
+	var values := {}
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	func _set_experiments(p_experiments : Array) -> void:
+		for experiment in p_experiments:
+			var value = experiment.get("value")
+			if not value is String:
+				continue
+			values[experiment.get("name")] = value
+			match experiment.get("name"):
+			{{- range .Experiments }}
+				"{{ .Key }}":
+					{{ .Name }} = VARIANTS["{{ .Key }}"].get(value, {{ .Pascal }}.NONE)
+				{{- if .Class }}
+					var json = JSON.parse_string(value)
+					if json is Dictionary:
+						{{ .Name }}_config = {{ .Class }}._from_dict(json)
+				{{- end }}
+			{{- end }}
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "Experiments<values=%s>" % [values]
+
+## The live events which are active, or will be, null for the other ones.
+class LiveEvents extends SatoriAsyncResult:
+
+	## The names of the live events.
+	const NAMES := [{{ range $i, $e := .LiveEvents }}{{ if $i }}, {{ end }}"{{ $e.Key }}"{{ end }}]
+{{ range .LiveEvents }}
+	{{- if .Description }}
+	## {{ .Description | stripNewlines }}
+	{{- end }}
+	var {{ .Name }} : {{ .Pascal }}Event
+{{ end }}
+	func _init(p_exception = null):
+		super(p_exception)
+
+	func _set_live_events(p_live_events : Array) -> void:
+		for event in p_live_events:
+			match event.get("name"):
+			{{- range .LiveEvents }}
+				{{ .Pascal }}Event.NAME:
+					{{ .Name }} = {{ .Pascal }}Event._from_api(event)
+			{{- end }}
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "LiveEvents<{{ range $i, $e := .LiveEvents }}{{ if $i }}, {{ end }}{{ $e.Name }}=%s{{ end }}>" % [{{ range $i, $e := .LiveEvents }}{{ if $i }}, {{ end }}{{ $e.Name }}{{ end }}]
+
+## The fields the live events of all the names have.
+class LiveEvent extends SatoriAsyncResult:
+
+	## The ID of the live event.
+	var id : String
+	## The name of the live event.
+	var name : String
+	## The description of the live event.
+	var description : String
+	## The time the current run of the live event starts, in seconds since the Unix epoch.
+	var start_time : int
+	## The time the current run of the live event ends, in seconds since the Unix epoch.
+	var end_time : int
+	## The value of the live event as the server sent it.
+	var value : String
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	## If the current run of the live event has started and not ended.
+	func is_active() -> bool:
+		var now := int(Time.get_unix_time_from_system())
+		return start_time <= now and (end_time == 0 or now < end_time)
+
+	func _set_api(p_event : Dictionary) -> void:
+		id = str(p_event.get("id", ""))
+		name = str(p_event.get("name", ""))
+		description = str(p_event.get("description", ""))
+		start_time = str(p_event.get("active_start_time_sec", "0")).to_int()
+		end_time = str(p_event.get("active_end_time_sec", "0")).to_int()
+		value = str(p_event.get("value", ""))
+{{- range .LiveEvents }}
+{{ if .Description }}
+## {{ .Description | stripNewlines }}
+{{- end }}
+class {{ .Pascal }}Event extends LiveEvent:
+
+	const NAME := "{{ .Key }}"
+
+	## The value of the live event, decoded as JSON.
+	var config : {{ .ClassType }}
+
+	func _init(p_exception = null):
+		super(p_exception)
+
+	static func _from_api(p_event : Dictionary) -> {{ .Pascal }}Event:
+		var event := {{ .Pascal }}Event.new()
+		event._set_api(p_event)
+		var json = JSON.parse_string(event.value) if event.value != "" else {}
+		if not json is Dictionary:
+			json = {}
+		event.config = {{ if .Class }}{{ .Class }}._from_dict(json){{ else }}json{{ end }}
+		return event
+
+	func _to_string() -> String:
+		if is_exception():
+			return get_exception()._to_string()
+		return "{{ .Pascal }}Event<id=%s, start_time=%d, end_time=%d, config=%s>" % [id, start_time, end_time, config]
+{{- end }}
+{{- template "schemaEnums" .Enums }}
+{{- template "payloadClasses" .Classes }}
+`
+
+// The typed events of a Satori event taxonomy, generated with -satori-events.
+const satoriEventsTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+
+## The events {{.ClassName}} sends to Satori, with typed constructors of their metadata. [br]
+## Send them with events_async(), which rejects the events the taxonomy does not have, and the ones with unknown
+## metadata keys or invalid values, before SatoriClient.events_async() is called.
+class_name {{.ClassName}}Events
+
+## The names of the events.
+{{- range .Events }}
+const {{ .Const }} := "{{ .Key }}"
+{{- end }}
+
+## The types of the metadata of the events, by name of event then key.
+const METADATA := {
+{{- range .Events }}
+	{{ .Const }}: { {{- range $i, $m := .Metadata }}{{ if $i }}, {{ end }}"{{ $m.Key }}": {{ $m.TypeConstant }}{{ end -}} },
+{{- end }}
+}
+## The keys of the metadata the events must have, by name of event.
+const REQUIRED := {
+{{- range .Events }}
+	{{ .Const }}: [{{ $n := 0 }}{{ range .Metadata }}{{ if .Required }}{{ if $n }}, {{ end }}{{ $n = 1 }}"{{ .Key }}"{{ end }}{{ end }}],
+{{- end }}
+}
+## The values allowed by the string metadata which have an enum, by name of event then key.
+const ENUMS := {
+{{- range .Events }}
+	{{ .Const }}: { {{- $n := 0 }}{{ range .Metadata }}{{ if .Enum }}{{ if $n }}, {{ end }}{{ $n = 1 }}"{{ .Key }}": {{ .Enum }}{{ end }}{{ end -}} },
+{{- end }}
+}
+## The types of the values of the events which have one, by name of event.
+const VALUES := {
+{{- range .Events }}{{ if .Value }}
+	{{ .Const }}: {{ .ValueConstant }},
+{{- end }}{{ end }}
+}
+
+var _client : SatoriClient
+
+func _init(p_client : SatoriClient):
+	_client = p_client
+
+## Send events, once validate() accepts all of them. [br]
+## p_session - The session of the identity. [br]
+## p_events - The events, built with the constructors of this class. [br]
+## Returns a task which resolves to the result, with an exception and without sending any event when one of them is
+## invalid.
+func events_async(p_session : SatoriSession, p_events : Array, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
+	var errors := PackedStringArray()
+	for event in p_events:
+		errors.append_array(validate(event))
+	if not errors.is_empty():
+		return SatoriAsyncResult.new(SatoriException.new("Invalid events: %s" % "; ".join(errors)))
+	return await _client.events_async(p_session, p_events, p_cancel_token)
+
+## Send an event, once validate() accepts it. [br]
+## p_session - The session of the identity. [br]
+## p_event - The event, built with a constructor of this class. [br]
+## Returns a task which resolves to the result, with an exception and without sending the event when it is invalid.
+func event_async(p_session : SatoriSession, p_event : Event, p_cancel_token : SatoriCancellationToken = null) -> SatoriAsyncResult:
+	return await events_async(p_session, [p_event], p_cancel_token)
+
+## Return the errors of an event: a name the taxonomy does not have, unknown or missing metadata keys, or values
+## which do not have the type of the taxonomy. Empty when there are none.
+static func validate(p_event : Event) -> PackedStringArray:
+	var errors := PackedStringArray()
+	if not METADATA.has(p_event.name):
+		errors.append("%s: unknown event" % p_event.name)
+		return errors
+	var types : Dictionary = METADATA[p_event.name]
+	var enums : Dictionary = ENUMS[p_event.name]
+	for key in p_event.metadata:
+		var value := str(p_event.metadata[key])
+		if not types.has(key):
+			errors.append("%s: unknown metadata %s" % [p_event.name, key])
+		elif not _is_valid(value, types[key]):
+			errors.append("%s: metadata %s is not a %s: %s" % [p_event.name, key, type_string(types[key]), value])
+		elif enums.has(key) and not value in enums[key]:
+			errors.append("%s: metadata %s is not one of %s: %s" % [p_event.name, key, enums[key], value])
+	for key in REQUIRED[p_event.name]:
+		if not p_event.metadata.has(key):
+			errors.append("%s: missing metadata %s" % [p_event.name, key])
+	if VALUES.has(p_event.name) and not _is_valid(p_event.value, VALUES[p_event.name]):
+		errors.append("%s: value is not a %s: %s" % [p_event.name, type_string(VALUES[p_event.name]), p_event.value])
+	return errors
+
+static func _is_valid(p_value : String, p_type : int) -> bool:
+	match p_type:
+		TYPE_BOOL:
+			return p_value == "true" or p_value == "false"
+		TYPE_INT:
+			return p_value.is_valid_int()
+		TYPE_FLOAT:
+			return p_value.is_valid_float()
+	return true
+{{- range .Events }}
+
+## {{ if .Description }}{{ .Description | stripNewlines }}{{ else }}The {{ .Key }} event.{{ end }} [br]
+{{- range .Metadata }}{{ if .Required }}
+## p_{{ .Name }} - {{ .Doc }} [br]
+{{- end }}{{ end }}
+{{- if .Value }}
+## p_value - The value of the event. [br]
+{{- end }}
+{{- range .Metadata }}{{ if not .Required }}
+## p_{{ .Name }} - {{ .Doc }} [br]
+{{- end }}{{ end }}
+## Returns the Event, stamped with the current time.
+static func {{ .Name }}(
+	{{- $n := 0 }}{{ range .Metadata }}{{ if .Required }}{{ if $n }}, {{ end }}{{ $n = 1 }}p_{{ .Name }} : {{ .Type }}{{ end }}{{ end }}
+	{{- if .Value }}{{ if $n }}, {{ end }}{{ $n = 1 }}p_value : {{ .Value }}{{ end }}
+	{{- range .Metadata }}{{ if not .Required }}{{ if $n }}, {{ end }}{{ $n = 1 }}p_{{ .Name }} = null{{ end }}{{ end -}}
+) -> Event:
+	{{- if .HasRequired }}
+	var metadata := {
+	{{- range .Metadata }}{{ if .Required }}
+		"{{ .Key }}": {{ if eq .Type "String" }}p_{{ .Name }}{{ else }}str(p_{{ .Name }}){{ end }},
+	{{- end }}{{ end }}
+	}
+	{{- else }}
+	var metadata := {}
+	{{- end }}
+	{{- range .Metadata }}{{ if not .Required }}
+	if p_{{ .Name }} != null:
+		metadata["{{ .Key }}"] = str(p_{{ .Name }})
+	{{- end }}{{ end }}
+	return Event.new({{ .Const }}, Time.get_unix_time_from_system(), {{ if .Value }}{{ if eq .Value "String" }}p_value{{ else }}str(p_value){{ end }}{{ else }}""{{ end }}, metadata)
+{{- end }}
+`
+
+// The typed RPCs of a Nakama Go runtime module, generated with -rpcs.
+const rpcTemplate string = `### Code generated by codegen/main.go. DO NOT EDIT. ###
+
+extends RefCounted
+class_name {{.ClassName}}Rpcs
+
+## The RPCs registered by a Nakama Go runtime module, with typed requests and responses. Call them with a
+## NakamaClient and a session:
+##     var rpcs := {{.ClassName}}Rpcs.Client.new(client)
+##     var result = await rpcs.<id>_async(session, request)
+## or over a NakamaSocket:
+##     var rpcs := {{.ClassName}}Rpcs.Socket.new(socket)
+##     var result = await rpcs.<id>_async(request)
+## Responses which are not JSON objects of their class are exceptions.
+{{- template "payloadClasses" .Classes }}
+
+# Parses the payload of RPC results into their response classes.
+class RpcCaller extends RefCounted:
+
+	static func _parse(p_id : String, p_result : NakamaAPI.ApiRpc, p_type) -> NakamaAsyncResult:
+		if p_result.is_exception():
+			return p_type.new(p_result.get_exception())
+		var json = JSON.parse_string(p_result.payload)
+		if not json is Dictionary:
+			return p_type.new(NakamaException.new("Invalid response of RPC %s: %s" % [p_id, p_result.payload]))
+		return p_type._from_dict(json)
+
+## Calls the RPCs with a NakamaClient.
+class Client extends RpcCaller:
+
+	var _client : NakamaClient
+
+	func _init(p_client : NakamaClient):
+		_client = p_client
+{{- range .Rpcs }}
+{{ range .Description }}
+	## {{ . }} [br]
+{{- end }}
+	## p_session - The session of the user. [br]
+	{{- if .Request }}
+	## {{ .RequestParam }} - {{ .RequestDoc }} [br]
+	{{- end }}
+	## Returns a task which resolves to {{ .ResponseDoc }}.
+	func {{ .Name }}_async(p_session : NakamaSession{{ if .Request }}, {{ .RequestParam }} : {{ .Request }}{{ end }}, p_cancel_token : NakamaCancellationToken = null) -> {{ .ReturnType }}:
+		var result : NakamaAPI.ApiRpc = await _client.rpc_async(p_session, "{{ .ID }}", {{ .Payload }}, p_cancel_token)
+	{{- if .Parse }}
+		return _parse("{{ .ID }}", result, {{ .Response }}) as {{ .Response }}
+	{{- else }}
+		return result
+	{{- end }}
+{{- end }}
+
+## Calls the RPCs over a NakamaSocket.
+class Socket extends RpcCaller:
+
+	var _socket : NakamaSocket
+
+	func _init(p_socket : NakamaSocket):
+		_socket = p_socket
+{{- range .Rpcs }}
+{{ range .Description }}
+	## {{ . }} [br]
+{{- end }}
+	{{- if .Request }}
+	## {{ .RequestParam }} - {{ .RequestDoc }} [br]
+	{{- end }}
+	## Returns a task which resolves to {{ .ResponseDoc }}.
+	func {{ .Name }}_async({{ if .Request }}{{ .RequestParam }} : {{ .Request }}{{ end }}) -> {{ .ReturnType }}:
+		var result : NakamaAPI.ApiRpc = await _socket.rpc_async("{{ .ID }}", {{ .Payload }})
+	{{- if .Parse }}
+		return _parse("{{ .ID }}", result, {{ .Response }}) as {{ .Response }}
+	{{- else }}
+		return result
+	{{- end }}
+{{- end }}
+`
+
+func convertRefToClassName(input string) (className string) {
+	cleanRef := strings.TrimPrefix(input, "#/definitions/")
+	className = strings.Title(cleanRef)
+	return
+}
+
+func stripNewlines(input string) (output string) {
+	output = strings.Replace(input, "\n", " ", -1)
+	return
+}
+
+func prependParameter(input string) (output string) {
+	output = "p_" + pascalToSnake(input)
+	return
+}
+
+func pascalToSnake(input string) (output string) {
+	output = ""
+	prev_low := false
+	for _, v := range input {
+		is_cap := v >= 'A' && v <= 'Z'
+		is_low := v >= 'a' && v <= 'z'
+		if is_cap && prev_low {
+			output = output + "_"
+		}
+		output += strings.ToLower(string(v))
+		prev_low = is_low
+	}
+	return
+}
+
+func apiFuncName(input string) (output string) {
+	output = pascalToSnake(input[7:])
+	return
+}
+
+func godotType(p_type string, p_ref string, p_item_type string, p_extra string, p_is_enum bool) (out string) {
+
+	is_array := false
+	is_dict := false
+	switch p_type {
+	case "integer":
+		out = "int"
+	case "string":
+		out = "String"
+	case "boolean":
+		out = "bool"
+	case "array":
+		is_array = true
+	case "object":
+		is_dict = true
+	default:
+		if p_is_enum {
+			out = "int"
+		} else {
+			out = convertRefToClassName(p_ref)
+		}
+	}
+
+	if is_array && typedArrays {
+		switch p_item_type {
+		case "integer":
+			out = "PackedInt64Array"
+		case "string":
+			out = "PackedStringArray"
+		case "boolean":
+			out = "Array[bool]"
+		case "number":
+			out = "PackedFloat64Array"
+		default:
+			out = "Array[" + convertRefToClassName(p_extra) + "]"
+		}
+		return
+	}
+
+	if is_array {
+		switch p_item_type {
+		case "integer":
+			out = "PackedIntArray"
+			return
+		case "string":
+			out = "PackedStringArray"
+			return
+		case "boolean":
+			out = "PackedIntArray"
+			return
+		default:
+			out = "Array"
+		}
+	}
+	if is_dict {
+		out = "Dictionary"
+	}
+	return
+}
+
+// godotMapType returns the type of a map property, with typed values when targeting Godot 4.4 or newer.
+func godotMapType(p_value_type string) string {
+	if !typedDictionaries {
+		return "Dictionary"
+	}
+	return "Dictionary[String, " + godotType(p_value_type, "", "", "", false) + "]"
+}
+
+func godotDef(p_type string) (out string) {
+	switch p_type {
+	case "bool":
+		out = "false"
+	case "int":
+		out = "0"
+	case "String":
+		out = "\"\""
+	case "PackedIntArray":
+		out = "PackedIntArray()"
+	case "PackedStringArray":
+		out = "PackedStringArray()"
+	case "Array":
+		out = "Array()"
+	case "Dictionary":
+		out = "Dictionary()"
+	case "PackedInt64Array", "PackedFloat64Array":
+		out = p_type + "()"
+	default:
+		if strings.HasPrefix(p_type, "Array[") {
+			out = "[]"
+		} else if strings.HasPrefix(p_type, "Dictionary[") {
+			out = "{}"
+		} else {
+			out = "null"
+		}
+	}
+	return
+}
+
+// decl returns the type annotation of a variable, loop variable or parameter
+// which is only typed in strict mode.
+func decl(p_type string) string {
+	if !strict {
+		return ""
+	}
+	return " : " + p_type
+}
+
+// isOption reports whether a parameter is passed in the Options object of its operation.
+func isOption(p Parameter) bool {
+	return optionObjects && !p.Required && p.In == "query"
+}
+
+// optionalParams returns the parameters of an operation passed in its Options object.
+func optionalParams(op Operation) (out []Parameter) {
+	for _, p := range op.Parameters {
+		if isOption(p) {
+			out = append(out, p)
+		}
+	}
+	return
+}
+
+// optionsClass returns the name of the class holding the optional parameters of an operation.
+func optionsClass(op Operation) string {
+	return op.OperationId[7:] + "Options"
+}
+
+// isTypedContainer reports whether a Godot type is an array or dictionary with typed elements.
+func isTypedContainer(p_type string) bool {
+	return strings.HasPrefix(p_type, "Array[") || strings.HasPrefix(p_type, "Dictionary[")
+}
+
+func godotLooseType(p_type string) (out string) {
+	switch p_type {
+	case "PackedStringArray", "PackedIntArray":
+		out = "Array"
+	default:
+		out = p_type
+	}
+	return
+}
+
+func godotSchemaType(p_type string) (out string) {
+	out = "TYPE_"
+	switch p_type {
+	case "bool":
+		out += "BOOL"
+	case "int":
 		out += "INT"
 	case "String":
-		out += "STRING"
-	case "PackedIntArray":
-		out += "ARRAY"
-	case "PackedStringArray":
-		out += "ARRAY"
-	case "Array":
-		out += "ARRAY"
+		out += "STRING"
+	case "PackedIntArray":
+		out += "ARRAY"
+	case "PackedStringArray":
+		out += "ARRAY"
+	case "Array":
+		out += "ARRAY"
+	case "Dictionary":
+		out += "DICTIONARY"
+	case "PackedInt64Array", "PackedFloat64Array":
+		out += "ARRAY"
+	default:
+		switch {
+		case strings.HasPrefix(p_type, "Array["):
+			out += "ARRAY"
+		case strings.HasPrefix(p_type, "Dictionary["):
+			out += "DICTIONARY"
+		default:
+			out = "\"" + p_type + "\""
+		}
+	}
+	return
+}
+
+func pascalToCamel(input string) (camelCase string) {
+	if input == "" {
+		return ""
+	}
+
+	camelCase = strings.ToLower(string(input[0]))
+	camelCase += string(input[1:])
+	return camelCase
+}
+
+func camelToPascal(camelCase string) (pascalCase string) {
+
+	if len(camelCase) <= 0 {
+		return ""
+	}
+
+	pascalCase = strings.ToUpper(string(camelCase[0])) + camelCase[1:]
+	return
+}
+
+func enumSummary(def Definition) string {
+	// quirk of swagger generation: if enum doesn't have a title
+	// then the title can be found as the first entry in the split description.
+	if def.Title != "" {
+		return def.Title
+	}
+
+	split := strings.Split(def.Description, "\n")
+
+	if len(split) <= 0 {
+		panic("No newlines in enum description found.")
+	}
+
+	return split[0]
+}
+
+func enumDescriptions(def Definition) (output []string) {
+
+	split := strings.Split(def.Description, "\n")
+
+	if len(split) <= 0 {
+		panic("No newlines in enum description found.")
+	}
+
+	if def.Title != "" {
+		return split
+	}
+
+	// quirk of swagger generation: if enum doesn't have a title
+	// then the title can be found as the first entry in the split description.
+	// so ignore for individual enum descriptions.
+	return split[2:]
+}
+
+// enumValueDoc returns the description of the enum value name, from its " - NAME: description" line.
+func enumValueDoc(def Definition, name string) string {
+	prefix := "- " + name + ":"
+	for _, line := range enumDescriptions(def) {
+		if line = strings.TrimSpace(line); strings.HasPrefix(line, prefix) {
+			return strings.TrimSpace(line[len(prefix):])
+		}
+	}
+	return ""
+}
+
+type Property struct {
+	Type  string
+	Ref   string   `json:"$ref"` // used with object
+	Items struct { // used with type "array"
+		Type string
+		Ref  string `json:"$ref"`
+	}
+	AdditionalProperties struct {
+		Type string // used with type "map"
+	}
+	Format      string // used with type "boolean"
+	Description string
+}
+
+type Parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Type     string   // used with primitives
+	Items    struct { // used with type "array"
+		Type string
+	}
+	Schema struct { // used with http body
+		Type string
+		Ref  string `json:"$ref"`
+	}
+	Format      string // used with type "boolean"
+	Description string
+}
+
+type Operation struct {
+	Summary     string
+	OperationId string
+	Responses   struct {
+		Ok struct {
+			Schema struct {
+				Ref string `json:"$ref"`
+			}
+		} `json:"200"`
+	}
+	Parameters []Parameter
+	Security   []map[string][]struct {
+	}
+	Retryable *bool `json:"x-retryable"` // Overrides whether the operation is safe to retry.
+	Timeout   int   `json:"x-timeout"`   // The request timeout in seconds, when not the adapter one.
+}
+
+// RequestPolicies overrides how operations are sent, by operation ID without the API prefix.
+// "Authenticate*" style patterns apply first, like in the client overlay.
+type RequestPolicies struct {
+	Operations map[string]RequestPolicy
+}
+
+type RequestPolicy struct {
+	Retryable *bool // Whether a failed request may be sent again.
+	Timeout   int   // The request timeout in seconds, or 0 for the adapter timeout.
+}
+
+// idempotentMethods are the HTTP methods whose requests are retried unless an operation says otherwise.
+var idempotentMethods = map[string]bool{"get": true, "head": true, "put": true, "delete": true, "options": true}
+
+// Pagination describes how to walk the pages of a list operation.
+type Pagination struct {
+	Ok       bool
+	Cursor   string // The parameter holding the cursor.
+	Carrier  string // "options" or "body" when the cursor is not passed as an argument.
+	Body     string // The body argument, when Carrier is "body".
+	Next     string // The response field holding the cursor of the next page.
+	Items    string // The response field holding the items.
+	Response string
+	Args     string // The arguments of the _async call fetching a page.
+	// The Options class of the operation and its fields, copied when Carrier is "options".
+	Options      string
+	OptionFields []string
+}
+
+// Overlay customizes the client facade generated with -client.
+type Overlay struct {
+	// Operations implemented by hand in Extra, or not exposed at all.
+	Skip []string
+	// A GDScript file, relative to the overlay, whose methods are merged by name with the generated ones.
+	// The declarations before its first method follow the class name. It is executed as a template first.
+	Extra string
+	// Responses converted before being returned, by response class.
+	Results map[string]struct {
+		Type string // The type returned instead.
+		Wrap string // The function converting the response.
+	}
+	// Rules per operation ID, without the API prefix. "Authenticate*" style patterns apply first.
+	Operations map[string]OverlayRule
+}
+
+// OverlayRule customizes the method of an operation. Parameters are named without their p_ prefix,
+// after Rename for the ones of the facade.
+type OverlayRule struct {
+	Name     string            // The method name, without _async.
+	Doc      []string          // The lines of the doc comment, instead of the summary and parameter descriptions.
+	Rename   map[string]string // Parameter names of the facade, by body field or API parameter.
+	Required []string          // Optional parameters which become required.
+	Defaults map[string]string // Default values of optional parameters, which become typed.
+	Types    map[string]string // Parameter types, instead of the ones of the spec.
+	Order    []string          // The order of the parameters, the required ones and the optional ones apart.
+	Break    []string          // Parameters starting a new line of the signature.
+	Omit     []string          // Body fields and optional API parameters left out of the facade, sent only with a value.
+	Values   map[string]string // Expressions of body fields or API parameters, instead of their parameter.
+	Nested   []string          // Object body fields whose own fields are parameters, like the ones of the body.
+	Inline   *bool             // Build the body on the line of the call.
+	Flatten  *bool             // Pass the body as one parameter when false.
+}
+
+// ClientParam is a parameter of a client facade method.
+type ClientParam struct {
+	Name        string
+	Type        string
+	Default     string
+	Description string
+	Break       bool // Starts a new line of the signature.
+	required    bool
+}
+
+func (p ClientParam) Declaration() string {
+	switch {
+	case p.required:
+		return p.Name + " : " + p.Type
+	case p.Default != "":
+		return p.Name + " : " + p.Type + " = " + p.Default
+	}
+	return p.Name + " = null"
+}
+
+// ClientMethod is a client facade method forwarding to ApiClient, or written by hand in Code.
+type ClientMethod struct {
+	Name     string
+	Api      string
+	Summary  string
+	Doc      []string
+	Params   []ClientParam
+	Setup    []string // Statements building the arguments, indented relative to the method body.
+	Args     string
+	Return   string
+	Typed    bool // Whether Return is declared, rather than documented in a comment.
+	Wrap     string
+	Resolves string
+	Code     string
+}
+
+type Definition struct {
+	Properties  map[string]Property
+	Enum        []string
+	Description string
+	// used only by enums
+	Title string
+}
+
+func godotClassUtils(p_name string) string {
+	if val, ok := utilities[p_name]; ok {
+		return val
+	}
+	return ""
+}
+
+// coerceValue returns the GDScript statements which coerce v to a value of a JSON type with the rules of _from_dict(),
+// ints to the strings int64 values are sent as included, or push an error and return when it is not one. The lines
+// after the first one are indented with indent. It is empty for the types which are not checked.
+func coerceValue(jsonType, label, indent string) string {
+	var lines []string
+	check := func(condition, godotType string) {
+		lines = append(lines, "if not ("+condition+"):", "\tpush_error(\"Invalid "+godotType+" value for "+label+": %s\" % [v])", "\treturn")
+	}
+	switch jsonType {
+	case "integer":
+		lines = append(lines, "if v is String and str(v).is_valid_int():", "\tv = str(v).to_int()")
+		check("v is int or v is float", "int")
+		lines = append(lines, "v = int(v)")
+	case "number":
+		check("v is float or v is int", "float")
+		lines = append(lines, "v = float(v)")
+	case "string":
+		check("v is String or v is StringName or v is int", "String")
+		lines = append(lines, "v = str(v)")
+	case "boolean":
+		check("v is bool", "bool")
+	case "object":
+		check("v is Dictionary", "Dictionary")
+	default:
+		return ""
+	}
+	return strings.Join(lines, "\n"+indent)
+}
+
+func commentLines(description string) string {
+	lines := strings.Split(description, "\n")
+	for i, line := range lines {
+		lines[i] = "\t## " + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// clientMethods builds the client facade methods of every operation not skipped by the overlay.
+func clientMethods(paths map[string]map[string]Operation, definitions map[string]Definition, overlay Overlay, className string, propType func(Property) string) (methods []ClientMethod) {
+	classes := map[string]bool{}
+	for name := range definitions {
+		classes[strings.Title(name)] = true
+	}
+	var qualify func(t string) string
+	qualify = func(t string) string {
+		if strings.HasPrefix(t, "Array[") {
+			return "Array[" + qualify(strings.TrimSuffix(strings.TrimPrefix(t, "Array["), "]")) + "]"
+		}
+		if classes[t] {
+			return className + "API." + t
+		}
+		return t
+	}
+	skip := map[string]bool{}
+	for _, id := range overlay.Skip {
+		skip[id] = true
+	}
+	contains := func(names []string, name string) bool {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, path := range paths {
+		for _, op := range path {
+			id := op.OperationId[7:]
+			if skip[id] {
+				continue
+			}
+			// Patterns first, so the rules of the operation itself win.
+			var keys []string
+			for key := range overlay.Operations {
+				if matched, _ := filepath.Match(key, id); matched && key != id {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			if _, ok := overlay.Operations[id]; ok {
+				keys = append(keys, id)
+			}
+			rule := OverlayRule{Rename: map[string]string{}, Defaults: map[string]string{}, Types: map[string]string{}, Values: map[string]string{}}
+			for _, key := range keys {
+				r := overlay.Operations[key]
+				if r.Name != "" {
+					rule.Name = r.Name
+				}
+				if r.Doc != nil {
+					rule.Doc = r.Doc
+				}
+				rule.Required = append(rule.Required, r.Required...)
+				rule.Break = append(rule.Break, r.Break...)
+				rule.Omit = append(rule.Omit, r.Omit...)
+				rule.Nested = append(rule.Nested, r.Nested...)
+				for _, m := range []struct{ to, from map[string]string }{{rule.Rename, r.Rename}, {rule.Defaults, r.Defaults}, {rule.Types, r.Types}, {rule.Values, r.Values}} {
+					for k, v := range m.from {
+						m.to[k] = v
+					}
+				}
+				if r.Order != nil {
+					rule.Order = r.Order
+				}
+				if r.Inline != nil {
+					rule.Inline = r.Inline
+				}
+				if r.Flatten != nil {
+					rule.Flatten = r.Flatten
+				}
+			}
+			// The facade name of a body field or API parameter.
+			rename := func(name string) string {
+				if to, ok := rule.Rename[name]; ok {
+					return to
+				}
+				return name
+			}
+
+			m := ClientMethod{Name: rule.Name, Api: apiFuncName(op.OperationId), Summary: op.Summary, Doc: rule.Doc, Return: className + "AsyncResult", Typed: true}
+			if m.Name == "" {
+				m.Name = m.Api
+			}
+			if ref := op.Responses.Ok.Schema.Ref; ref != "" {
+				response := convertRefToClassName(ref)
+				m.Return = qualify(response)
+				m.Resolves = m.Return
+				m.Typed = false
+				if result, ok := overlay.Results[response]; ok {
+					m.Return = result.Type
+					m.Resolves = result.Type
+					m.Wrap = result.Wrap
+					m.Typed = true
+				}
+			}
+
+			var args []string
+			var leading, required, optional []ClientParam
+			if len(op.Security) > 0 {
+				for key := range op.Security[0] {
+					switch key {
+					case "BasicAuth":
+						args = append(args, "server_key", `""`)
+					case "HttpKeyAuth":
+						leading = append(leading, ClientParam{Name: "p_bearer_token", Type: "String", Description: "The token sent as bearer authorization.", required: true})
+						args = append(args, "p_bearer_token")
+					}
+				}
+			} else {
+				leading = append(leading, ClientParam{Name: "p_session", Type: className + "Session", Description: "The session of the user.", required: true})
+				args = append(args, "p_session")
+			}
+			add := func(p ClientParam, gdType string, isRequired bool) ClientParam {
+				name := strings.TrimPrefix(p.Name, "p_")
+				p.Type = gdType
+				if t, ok := rule.Types[name]; ok {
+					p.Type = t
+				}
+				p.Break = contains(rule.Break, name)
+				if isRequired || contains(rule.Required, name) {
+					p.required = true
+					required = append(required, p)
+					return p
+				}
+				if def, ok := rule.Defaults[name]; ok {
+					p.Default = def
+				}
+				optional = append(optional, p)
+				return p
+			}
+			// The fields of the Options object, set to their value, directly when it is typed.
+			type option struct {
+				field, value string
+				typed        bool
+			}
+			var options []option
+			named := map[string]bool{}
+			for _, param := range op.Parameters {
+				named[rename(pascalToSnake(param.Name))] = true
+			}
+			// The entries of the dictionary of a body definition, adding the parameters of its fields.
+			var fields func(ref string) []string
+			fields = func(ref string) []string {
+				def := definitions[strings.TrimPrefix(ref, "#/definitions/")]
+				names := make([]string, 0, len(def.Properties))
+				for field := range def.Properties {
+					names = append(names, field)
+				}
+				sort.Strings(names)
+				var entries []string
+				for _, field := range names {
+					prop := def.Properties[field]
+					snake := pascalToSnake(field)
+					value, ok := rule.Values[snake]
+					if contains(rule.Omit, snake) {
+						if ok { // Sent with its value, without a parameter.
+							entries = append(entries, strconv.Quote(snake)+": "+value)
+						}
+						continue
+					}
+					if contains(rule.Nested, snake) && prop.Ref != "" {
+						entries = append(entries, strconv.Quote(snake)+": {"+strings.Join(fields(prop.Ref), ", ")+"}")
+						continue
+					}
+					facade := rename(snake)
+					if !ok {
+						value = "p_" + facade
+					}
+					entries = append(entries, strconv.Quote(snake)+": "+value)
+					if !named[facade] { // Not also a path parameter, like the group ID of UpdateGroup.
+						add(ClientParam{Name: "p_" + facade, Description: prop.Description}, qualify(propType(prop)), false)
+					}
+				}
+				return entries
+			}
+			body, create := -1, ""
+			for _, param := range op.Parameters {
+				snake := pascalToSnake(param.Name)
+				name := "p_" + rename(snake)
+				value, hasValue := rule.Values[snake]
+				if !hasValue {
+					value = name
+				}
+				switch {
+				case param.In == "body" && param.Schema.Ref != "" && (rule.Flatten == nil || *rule.Flatten):
+					entries := fields(param.Schema.Ref)
+					indent := "\t\t"
+					if rule.Inline != nil && *rule.Inline {
+						indent = "\t"
+					}
+					create = qualify(convertRefToClassName(param.Schema.Ref)) + ".create(" + className + "API, {\n" +
+						indent + "\t" + strings.Join(entries, ",\n"+indent+"\t") + "\n" + indent + "})"
+					body = len(args)
+					args = append(args, "")
+				case param.In == "body" && param.Schema.Ref != "":
+					add(ClientParam{Name: name, Description: param.Description}, qualify(convertRefToClassName(param.Schema.Ref)), true)
+					args = append(args, name)
+				case param.In == "body":
+					add(ClientParam{Name: name, Description: param.Description}, "String", true)
+					args = append(args, name)
+				case !param.Required && contains(rule.Omit, snake):
+					// Sent with its value, if any, without a parameter.
+					if isOption(param) {
+						if hasValue {
+							options = append(options, option{snake, value, true})
+						}
+					} else if hasValue {
+						args = append(args, value)
+					} else {
+						args = append(args, "null")
+					}
+				case isOption(param):
+					p := add(ClientParam{Name: name, Description: param.Description}, godotType(param.Type, "", param.Items.Type, "", false), false)
+					options = append(options, option{snake, value, p.required || p.Default != "" || hasValue})
+				default:
+					add(ClientParam{Name: name, Description: param.Description}, godotType(param.Type, "", param.Items.Type, "", false), param.Required)
+					args = append(args, value)
+				}
+			}
+			if len(options) > 0 {
+				m.Setup = append(m.Setup, "var options := "+className+"API."+optionsClass(op)+".new()")
+				for _, o := range options {
+					if o.typed {
+						m.Setup = append(m.Setup, "options."+o.field+" = "+o.value)
+					} else {
+						m.Setup = append(m.Setup, "if "+o.value+" != null:", "\toptions."+o.field+" = "+o.value)
+					}
+				}
+				args = append(args, "options")
+			}
+
+			index := func(p ClientParam) int {
+				for i, name := range rule.Order {
+					if "p_"+name == p.Name {
+						return i
+					}
+				}
+				return len(rule.Order)
+			}
+			sort.SliceStable(required, func(i, j int) bool {
+				return index(required[i]) < index(required[j])
+			})
+			sort.SliceStable(optional, func(i, j int) bool {
+				return index(optional[i]) < index(optional[j])
+			})
+			m.Params = append(append(leading, required...), optional...)
+			m.Params = append(m.Params, ClientParam{Name: "p_cancel_token", Type: className + "CancellationToken", Default: "null", Description: "A token to cancel the request.", Break: contains(rule.Break, "cancel_token")})
+			args = append(args, "p_cancel_token")
+			if body < 0 {
+				m.Args = strings.Join(args, ", ")
+			} else if rule.Inline != nil && *rule.Inline {
+				m.Args = strings.Join(args[:body], ", ") + ", " + create + ", " + strings.Join(args[body+1:], ", ")
+			} else {
+				m.Args = strings.Join(args[:body], ", ") + ",\n\t\t" + create + ", " + strings.Join(args[body+1:], ", ")
+			}
+			methods = append(methods, m)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+	return
+}
+
+// clientExtra splits the hand-written part of a client facade into the declarations before its first method,
+// without the leading comment of the file, and its methods.
+func clientExtra(source string) (preamble string, methods []ClientMethod) {
+	lines := strings.Split(strings.TrimSpace(source), "\n")
+	for len(lines) > 0 && strings.HasPrefix(lines[0], "#") && !strings.HasPrefix(lines[0], "##") {
+		lines = lines[1:]
+	}
+	// Blocks are separated by an empty line followed by an unindented one.
+	var blocks []string
+	start := 0
+	for i := 1; i < len(lines); i++ {
+		if lines[i-1] == "" && lines[i] != "" && !strings.HasPrefix(lines[i], "\t") && !strings.HasPrefix(lines[i], " ") {
+			blocks = append(blocks, strings.TrimSpace(strings.Join(lines[start:i], "\n")))
+			start = i
+		}
+	}
+	blocks = append(blocks, strings.TrimSpace(strings.Join(lines[start:], "\n")))
+	funcName := regexp.MustCompile(`(?m)^(?:static )?func (\w+)\(`)
+	var declarations []string
+	for _, block := range blocks {
+		if block == "" {
+			continue
+		}
+		match := funcName.FindStringSubmatch(block)
+		if match == nil {
+			if len(methods) == 0 {
+				declarations = append(declarations, block)
+				continue
+			}
+			methods[len(methods)-1].Code += "\n\n" + block
+			continue
+		}
+		methods = append(methods, ClientMethod{Name: strings.TrimSuffix(match[1], "_async"), Code: block})
+	}
+	return strings.Join(declarations, "\n\n"), methods
+}
+
+// mergeMethods merges the hand-written methods, in their order, into the generated ones sorted by name.
+func mergeMethods(generated, written []ClientMethod) (methods []ClientMethod) {
+	for len(generated) > 0 || len(written) > 0 {
+		if len(written) > 0 && (len(generated) == 0 || written[0].Name < generated[0].Name) {
+			methods = append(methods, written[0])
+			written = written[1:]
+		} else {
+			methods = append(methods, generated[0])
+			generated = generated[1:]
+		}
+	}
+	return
+}
+
+// OpcodeSchema describes the messages of a game sent as match state or party data, by opcode.
+type OpcodeSchema struct {
+	// Messages used as fields of other messages, by name.
+	Types map[string]OpcodeMessage
+	// Messages sent with an opcode.
+	Opcodes []OpcodeMessage
+}
+
+type OpcodeMessage struct {
+	Code        int
+	Name        string
+	Description string
+	Fields      []OpcodeField
+	Encoding    string // json, the default, or binary.
+	Delta       bool   // Binary messages sent as the changes from the last one acknowledged.
+	Sender      string // client, server, or both, the default: who sends the message to the match handler.
+}
+
+type OpcodeField struct {
+	Name        string // snake_case, as in the JSON payload.
+	Type        string // bool, int, float, string, bytes, or the name of a type.
+	Repeated    bool
+	Description string
+	// The binary encoding of ints and floats: see opcodePacking.
+	Min       *float64
+	Max       *float64
+	Precision float64
+	Bits      int
+	// Binary fields which are often their default value, sent after a flag only when they are not.
+	Optional bool
+}
+
+// opcodePacking is how a field is written in the binary encoding.
+// Ints are zigzag varints, or Bits wide from Min when they have a range.
+// Floats are 64 bits, or quantised to Steps steps of Precision from Min when they have a range.
+type opcodePacking struct {
+	Min       float64
+	Precision float64
+	Steps     uint64
+	Bits      int
+}
+
+// packing validates the binary encoding attributes of a field and returns its packing.
+func (f OpcodeField) packing() (p opcodePacking, err error) {
+	ranged := f.Min != nil || f.Max != nil || f.Precision != 0 || f.Bits != 0
+	if !ranged {
+		return p, nil
+	}
+	if f.Min != nil {
+		p.Min = *f.Min
+	}
+	switch f.Type {
+	case "int":
+		switch {
+		case f.Precision != 0:
+			return p, fmt.Errorf("an int has no precision")
+		case p.Min != math.Trunc(p.Min) || math.Abs(p.Min) > 1<<53:
+			return p, fmt.Errorf("min %v is not an int", p.Min)
+		case f.Max != nil && f.Bits != 0:
+			return p, fmt.Errorf("set either max or bits")
+		case f.Max != nil:
+			if *f.Max <= p.Min || *f.Max != math.Trunc(*f.Max) || *f.Max-p.Min > 1<<53 {
+				return p, fmt.Errorf("max %v is not an int above min %v", *f.Max, p.Min)
+			}
+			p.Steps = uint64(*f.Max - p.Min)
+			p.Bits = bits.Len64(p.Steps)
+		default:
+			p.Bits = f.Bits
+			p.Steps = 1<<p.Bits - 1
+		}
+		if p.Bits < 1 || p.Bits > 62 {
+			return p, fmt.Errorf("bits must be between 1 and 62, not %d", p.Bits)
+		}
+	case "float":
+		if f.Min == nil || f.Max == nil || *f.Max <= *f.Min || math.IsInf(*f.Max-*f.Min, 0) {
+			return p, fmt.Errorf("a quantised float needs a min and a greater max")
+		}
+		switch {
+		case f.Precision != 0 && f.Bits != 0:
+			return p, fmt.Errorf("set either precision or bits")
+		case f.Precision > 0:
+			p.Precision = f.Precision
+			steps := math.Round((*f.Max - p.Min) / p.Precision)
+			if steps > 1<<53 {
+				return p, fmt.Errorf("precision %v is too small for the range", f.Precision)
+			}
+			p.Steps = uint64(math.Max(steps, 1))
+			p.Bits = bits.Len64(p.Steps)
+		case f.Bits >= 1 && f.Bits <= 53:
+			p.Bits = f.Bits
+			p.Steps = 1<<p.Bits - 1
+			p.Precision = (*f.Max - p.Min) / float64(p.Steps)
+		default:
+			return p, fmt.Errorf("a quantised float needs a positive precision, or bits between 1 and 53")
+		}
+	default:
+		return p, fmt.Errorf("only ints and floats have a min, max, precision or bits")
+	}
+	return p, nil
+}
+
+// OpcodeClass is a generated message class.
+type OpcodeClass struct {
+	OpcodeMessage
+	Const  string // The OpCode enum value, empty for types.
+	Snake  string
+	Signal string
+	Send   string
+	Binary bool // If it has write() and read(), as a binary opcode or a type used by one.
+	Fields []OpcodeClassField
+}
+
+type OpcodeClassField struct {
+	OpcodeField
+	GodotType string
+	Default   string
+	ToDict    string   // The expression of the field in to_dict().
+	FromDict  []string // The statements reading the field from p_dict into out.
+	Differs   string   // If the field differs from the one of p_baseline.
+	Write     []string // The statements writing the field to p_writer.
+	Read      []string // The statements reading the field from p_reader into out.
+}
+
+// opcodeScalars maps the scalar field types of opcode schemas to GDScript types and default values.
+var opcodeScalars = map[string][2]string{
+	"bool":   {"bool", "false"},
+	"int":    {"int", "0"},
+	"float":  {"float", "0.0"},
+	"string": {"String", `""`},
+	"bytes":  {"PackedByteArray", "PackedByteArray()"},
+}
+
+// opcodeMessages validates an opcode schema and returns its types, then its opcodes, as classes.
+func opcodeMessages(schema OpcodeSchema) (classes []OpcodeClass, err error) {
+	names := map[string]bool{}
+	codes := map[int]string{}
+	var types []string
+	for name := range schema.Types {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+	var messages []OpcodeMessage
+	for _, name := range types {
+		message := schema.Types[name]
+		message.Name = name
+		message.Code = 0
+		messages = append(messages, message)
+	}
+	for _, message := range schema.Opcodes {
+		if message.Code <= 0 {
+			return nil, fmt.Errorf("opcode %s must be a positive number, not %d", message.Name, message.Code)
+		}
+		if other, ok := codes[message.Code]; ok {
+			return nil, fmt.Errorf("opcode %d is used by both %s and %s", message.Code, other, message.Name)
+		}
+		codes[message.Code] = message.Name
+		switch message.Sender {
+		case "", "both", "client", "server":
+		default:
+			return nil, fmt.Errorf("opcode %s is sent by client, server or both, not %q", message.Name, message.Sender)
+		}
+		messages = append(messages, message)
+	}
+	for _, message := range messages {
+		if message.Name == "" || names[message.Name] {
+			return nil, fmt.Errorf("message name %q is empty or not unique", message.Name)
+		}
+		names[message.Name] = true
+	}
+	binary, err := opcodeBinaryMessages(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, message := range messages {
+		class := OpcodeClass{OpcodeMessage: message, Binary: binary[message.Name]}
+		if message.Code > 0 {
+			class.Snake = pascalToSnake(message.Name)
+			class.Const = strings.ToUpper(class.Snake)
+			class.Signal = "received_" + class.Snake
+			class.Send = "send_" + class.Snake
+		}
+		for _, field := range message.Fields {
+			f := OpcodeClassField{OpcodeField: field}
+			key := fmt.Sprintf("%q", field.Name)
+			scalar, isScalar := opcodeScalars[field.Type]
+			if !isScalar && !names[field.Type] {
+				return nil, fmt.Errorf("field %s.%s has an unknown type %q", message.Name, field.Name, field.Type)
+			}
+			packing, err := field.packing()
+			if err != nil {
+				return nil, fmt.Errorf("field %s.%s: %s", message.Name, field.Name, err)
+			}
+			element := field.Type
+			if isScalar {
+				element = scalar[0]
+			}
+			// Converts a JSON value v of the field type.
+			read := map[string]string{
+				"bool":   "bool(v)",
+				"int":    "int(v)",
+				"float":  "float(v)",
+				"string": "str(v)",
+				"bytes":  "Marshalls.base64_to_raw(str(v))",
+			}[field.Type]
+			write := map[string]string{
+				"bytes": "Marshalls.raw_to_base64(v)",
+			}[field.Type]
+			if !isScalar {
+				read = field.Type + ".from_dict(v)"
+				write = "v.to_dict()"
+			}
+			switch {
+			case field.Repeated:
+				f.GodotType = "Array"
+				if typedArrays {
+					f.GodotType = "Array[" + element + "]"
+				}
+				f.Default = "[]"
+				f.ToDict = field.Name
+				if write != "" {
+					f.ToDict = field.Name + ".map(func(v): return " + write + ")"
+				}
+				check := ""
+				if !isScalar {
+					check = "if v is Dictionary: "
+				}
+				f.FromDict = []string{
+					"if p_dict.get(" + key + ") is Array:",
+					"\tfor v in p_dict[" + key + "]:",
+					"\t\t" + check + "out." + field.Name + ".append(" + read + ")",
+				}
+			case isScalar:
+				f.GodotType, f.Default = scalar[0], scalar[1]
+				f.ToDict = field.Name
+				if write != "" {
+					f.ToDict = strings.Replace(write, "(v)", "("+field.Name+")", 1)
+				}
+				f.FromDict = []string{
+					"if p_dict.get(" + key + ") != null:",
+					"\tvar v = p_dict[" + key + "]",
+					"\tout." + field.Name + " = " + read,
+				}
+			default:
+				f.GodotType, f.Default = field.Type, "null"
+				f.ToDict = field.Name + ".to_dict() if " + field.Name + " != null else null"
+				f.FromDict = []string{
+					"if p_dict.get(" + key + ") is Dictionary:",
+					"\tout." + field.Name + " = " + field.Type + ".from_dict(p_dict[" + key + "])",
+				}
+			}
+			if class.Binary {
+				opcodeBinaryField(&f, packing)
+			}
+			class.Fields = append(class.Fields, f)
+		}
+		classes = append(classes, class)
+	}
+	return classes, nil
+}
+
+// opcodeBinaryMessages validates the encodings of an opcode schema, and returns the names of its binary opcodes
+// and of the types they use.
+func opcodeBinaryMessages(schema OpcodeSchema) (map[string]bool, error) {
+	binary := map[string]bool{}
+	// path is the types which contain name without an array, none of which name can contain.
+	var add func(name string, path []string) error
+	add = func(name string, path []string) error {
+		for _, other := range path {
+			if other == name {
+				return fmt.Errorf("binary type %s contains itself: %s", name, strings.Join(append(path, name), "."))
+			}
+		}
+		if binary[name] && path == nil {
+			return nil
+		}
+		binary[name] = true
+		for _, field := range schema.Types[name].Fields {
+			if _, ok := schema.Types[field.Type]; ok {
+				next := append(path, name)
+				if field.Repeated {
+					next = nil
+				}
+				if err := add(field.Type, next); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, message := range schema.Opcodes {
+		switch message.Encoding {
+		case "", "json":
+			if message.Delta {
+				return nil, fmt.Errorf("opcode %s must be binary to be sent as changes", message.Name)
+			}
+		case "binary":
+			for _, field := range message.Fields {
+				if _, ok := schema.Types[field.Type]; ok {
+					if err := add(field.Type, nil); err != nil {
+						return nil, err
+					}
+				}
+			}
+			binary[message.Name] = true
+		default:
+			return nil, fmt.Errorf("opcode %s has an unknown encoding %q", message.Name, message.Encoding)
+		}
+	}
+	return binary, nil
+}
+
+// opcodeBinaryField sets the GDScript statements comparing, writing and reading a field in the binary encoding.
+func opcodeBinaryField(f *OpcodeClassField, p opcodePacking) {
+	name := f.Name
+	_, isScalar := opcodeScalars[f.Type]
+	// Write the element {v}, and read one.
+	var write, read string
+	switch {
+	case f.Type == "bool":
+		write, read = "p_writer.write_flag({v})", "p_reader.read_flag()"
+	case f.Type == "int" && p.Bits > 0:
+		args := fmt.Sprintf("%d, %d, %d", int64(p.Min), p.Steps, p.Bits)
+		write = "p_writer.write_ranged({v}, " + args + ")"
+		read = "p_reader.read_ranged(" + args + ")"
+	case f.Type == "int":
+		write, read = "p_writer.write_int({v})", "p_reader.read_int()"
+	case f.Type == "float" && p.Bits > 0:
+		args := fmt.Sprintf("%s, %s, %d, %d", gdFloat(p.Min), gdFloat(p.Precision), p.Steps, p.Bits)
+		write = "p_writer.write_quantised({v}, " + args + ")"
+		read = "p_reader.read_quantised(" + args + ")"
+	case f.Type == "float":
+		write, read = "p_writer.write_double({v})", "p_reader.read_double()"
+	case f.Type == "string":
+		write, read = "p_writer.write_string({v})", "p_reader.read_string()"
+	case f.Type == "bytes":
+		write, read = "p_writer.write_bytes({v})", "p_reader.read_bytes()"
+	default:
+		// A null object is written as a new one.
+		write = "({v} if {v} != null else " + f.Type + ".new()).write(p_writer)"
+		read = f.Type + ".read(p_reader)"
+	}
+	// Objects are compared by their dictionaries.
+	compared := "%s"
+	switch {
+	case !isScalar && f.Repeated:
+		compared = "%s.map(func(v): return v.to_dict())"
+	case !isScalar:
+		compared = "(%[1]s.to_dict() if %[1]s != null else null)"
+	}
+	f.Differs = fmt.Sprintf(compared, name) + " != " + fmt.Sprintf(compared, "p_baseline."+name)
+
+	present, reset := name+" != "+f.Default, "out."+name+" = "+f.Default
+	var values, reads []string
+	switch {
+	case f.Repeated:
+		present, reset = "not "+name+".is_empty()", "out."+name+".clear()"
+		values = []string{
+			"p_writer.write_uint(" + name + ".size())",
+			"for v in " + name + ":",
+			"\t" + strings.Replace(write, "{v}", "v", -1),
+		}
+		reads = []string{
+			"out." + name + ".clear()",
+			"for i in p_reader.read_count():",
+			"\tout." + name + ".append(" + read + ")",
+		}
+	default:
+		values = []string{strings.Replace(write, "{v}", name, -1)}
+		reads = []string{"out." + name + " = " + read}
+		if f.Type == "bytes" {
+			present = "not " + name + ".is_empty()"
+		}
+	}
+	if !f.Optional {
+		f.Write, f.Read = values, reads
+		return
+	}
+	f.Write = []string{"if p_writer.write_flag(" + present + "):"}
+	for _, line := range values {
+		f.Write = append(f.Write, "\t"+line)
+	}
+	f.Read = []string{"if p_reader.read_flag():"}
+	for _, line := range reads {
+		f.Read = append(f.Read, "\t"+line)
+	}
+	f.Read = append(f.Read, "else:", "\t"+reset)
+}
+
+// GoOpcodeFile is the Go code of the messages of an opcode schema, for a Nakama Go runtime module.
+type GoOpcodeFile struct {
+	Package   string
+	ClassName string
+	Imports   []string
+	Classes   []GoOpcodeClass
+	Binary    bool // If a message has the binary encoding.
+	Delta     bool // If a message is sent as changes.
+}
+
+type GoOpcodeClass struct {
+	OpcodeClass
+	GoConst string // The opcode constant, empty for types.
+	Handled bool   // If clients send the message, so the match handler has a method for it.
+	Fields  []GoOpcodeField
+}
+
+type GoOpcodeField struct {
+	OpcodeField
+	GoName  string
+	GoType  string
+	Tag     string
+	Differs string   // If the field of m differs from the one of baseline.
+	Write   []string // The statements writing the field of m to w.
+	Read    []string // The statements reading the field from r into m.
+	Clone   []string // The statements deep copying the field of m into c.
+}
+
+// goInitialisms are the words of field names which are upper case in Go names.
+var goInitialisms = map[string]bool{"id": true, "ip": true, "url": true, "uri": true, "json": true, "http": true, "api": true}
+
+// goName returns the exported Go name of a snake_case name.
+func goName(snake string) string {
+	var out string
+	for _, word := range strings.Split(snake, "_") {
+		if goInitialisms[word] {
+			out += strings.ToUpper(word)
+		} else {
+			out += camelToPascal(word)
+		}
+	}
+	return out
+}
+
+// goOpcodeFile returns the Go code of the messages of an opcode schema, validated by opcodeMessages.
+func goOpcodeFile(classes []OpcodeClass, pkg, className string) GoOpcodeFile {
+	file := GoOpcodeFile{Package: pkg, ClassName: className}
+	imports := map[string]bool{"context": true, "database/sql": true}
+	for _, class := range classes {
+		c := GoOpcodeClass{OpcodeClass: class}
+		if class.Code > 0 {
+			c.GoConst = "OpCode" + class.Name
+			c.Handled = class.Sender != "server"
+			if !class.Binary {
+				imports["encoding/json"] = true
+			}
+		}
+		file.Binary = file.Binary || class.Binary
+		file.Delta = file.Delta || class.Delta
+		for _, field := range class.OpcodeMessage.Fields {
+			c.Fields = append(c.Fields, goOpcodeField(field))
+		}
+		file.Classes = append(file.Classes, c)
+	}
+	if file.Binary {
+		imports["errors"], imports["math"], imports["reflect"] = true, true, true
+	}
+	for name := range imports {
+		file.Imports = append(file.Imports, name)
+	}
+	sort.Strings(file.Imports)
+	return file
+}
+
+func goOpcodeField(field OpcodeField) GoOpcodeField {
+	f := GoOpcodeField{OpcodeField: field, GoName: goName(field.Name)}
+	p, _ := field.packing()
+	name := "m." + f.GoName
+	element := map[string]string{
+		"bool": "bool", "int": "int64", "float": "float64", "string": "string", "bytes": "[]byte",
+	}[field.Type]
+	zero := map[string]string{
+		"bool": "false", "int": "0", "float": "0", "string": `""`,
+	}[field.Type]
+	// Write the element {v}, read one, and deep copy {v}.
+	var write, read, clone string
+	switch {
+	case field.Type == "bool":
+		write, read = "w.WriteFlag({v})", "r.ReadFlag()"
+	case field.Type == "int" && p.Bits > 0:
+		args := fmt.Sprintf("%d, %d, %d", int64(p.Min), p.Steps, p.Bits)
+		write, read = "w.WriteRanged({v}, "+args+")", "r.ReadRanged("+args+")"
+	case field.Type == "int":
+		write, read = "w.WriteInt({v})", "r.ReadInt()"
+	case field.Type == "float" && p.Bits > 0:
+		args := fmt.Sprintf("%s, %s, %d, %d", strconv.FormatFloat(p.Min, 'g', -1, 64), strconv.FormatFloat(p.Precision, 'g', -1, 64), p.Steps, p.Bits)
+		write, read = "w.WriteQuantised({v}, "+args+")", "r.ReadQuantised("+args+")"
+	case field.Type == "float":
+		write, read = "w.WriteDouble({v})", "r.ReadDouble()"
+	case field.Type == "string":
+		write, read = "w.WriteString({v})", "r.ReadString()"
+	case field.Type == "bytes":
+		write, read, clone = "w.WriteBytes({v})", "r.ReadBytes()", "append([]byte(nil), {v}...)"
+		zero = "nil"
+	default:
+		element = "*" + field.Type
+		write, read, clone = "{v}.Write(w, nil)", "Read"+field.Type+"(r, nil)", "{v}.Clone()"
+		zero = "nil"
+	}
+	f.GoType = element
+	f.Differs = name + " != baseline." + f.GoName
+	present := name + " != " + zero
+	if zero == "nil" {
+		f.Differs = "changed(" + name + ", baseline." + f.GoName + ")"
+	}
+	if field.Type == "bytes" {
+		present = "len(" + name + ") > 0"
+	}
+	tag := field.Name
+	if field.Optional {
+		tag += ",omitempty"
+	}
+	f.Tag = "`json:\"" + tag + "\"`"
+
+	if !field.Repeated {
+		f.Write = []string{strings.Replace(write, "{v}", name, -1)}
+		f.Read = []string{name + " = " + read}
+		if clone != "" {
+			f.Clone = []string{"c." + f.GoName + " = " + strings.Replace(clone, "{v}", name, -1)}
+		}
+	} else {
+		f.GoType = "[]" + element
+		f.Differs = "changed(" + name + ", baseline." + f.GoName + ")"
+		present, zero = "len("+name+") > 0", "nil"
+		f.Write = []string{
+			"w.WriteUint(uint64(len(" + name + ")))",
+			"for _, v := range " + name + " {",
+			"\t" + strings.Replace(write, "{v}", "v", -1),
+			"}",
+		}
+		f.Read = []string{
+			name + " = nil",
+			"for i, n := uint64(0), r.ReadCount(); i < n; i++ {",
+			"\t" + name + " = append(" + name + ", " + read + ")",
+			"}",
+		}
+		f.Clone = []string{"c." + f.GoName + " = append([]" + element + "(nil), " + name + "...)"}
+		if clone != "" {
+			f.Clone = []string{
+				"c." + f.GoName + " = nil",
+				"for _, v := range " + name + " {",
+				"\tc." + f.GoName + " = append(c." + f.GoName + ", " + strings.Replace(clone, "{v}", "v", -1) + ")",
+				"}",
+			}
+		}
+	}
+	if field.Optional {
+		f.Write = append([]string{"if w.WriteFlag(" + present + ") {"}, indentLines(f.Write)...)
+		f.Write = append(f.Write, "}")
+		f.Read = append([]string{"if r.ReadFlag() {"}, indentLines(f.Read)...)
+		f.Read = append(f.Read, "} else {", "\t"+name+" = "+zero, "}")
+	}
+	return f
+}
+
+func indentLines(lines []string) (out []string) {
+	for _, line := range lines {
+		out = append(out, "\t"+line)
+	}
+	return out
+}
+
+// gdFloat formats a float as a GDScript float literal which reads back exactly.
+func gdFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+// PayloadClass is a class of a JSON payload, like the request or the response of an RPC.
+type PayloadClass struct {
+	Name        string
+	Description string
+	// Extends is the class it extends, NakamaAsyncResult when empty.
+	Extends string
+	Fields  []PayloadField
+	// Validate is set to generate validate() with the checks of the fields.
+	Validate bool
+}
+
+// PayloadField is a field of a PayloadClass: its JSON key, and the GDScript property it is in.
+type PayloadField struct {
+	Key         string
+	Name        string
+	Description string
+	// Kind is how the field is converted: scalar, object, array, object_array, map or object_map.
+	Kind string
+	// Type is the GDScript type of the property, and Element the one of the elements of arrays and maps.
+	Type    string
+	Element string
+	// Enum is the class of the enum of a schema the values are one of, if any.
+	Enum string
+	// Always is set for the fields which are sent even when they are not set, with their default value, or
+	// null when they are Nullable.
+	Always   bool
+	Nullable bool
+	Required bool
+	// Fallback is the default value of the property instead of the one of its type.
+	Fallback string
+	// Checks are the GDScript lines of validate() which append the errors of the field.
+	Checks []string
+}
+
+var godotTypeConstants = map[string]string{
+	"bool": "TYPE_BOOL", "int": "TYPE_INT", "float": "TYPE_FLOAT", "String": "TYPE_STRING",
+	"Dictionary": "TYPE_DICTIONARY", "Array": "TYPE_ARRAY", "Variant": "TYPE_NIL",
+	"PackedStringArray": "TYPE_PACKED_STRING_ARRAY", "PackedInt64Array": "TYPE_PACKED_INT64_ARRAY",
+	"PackedFloat64Array": "TYPE_PACKED_FLOAT64_ARRAY",
+}
+
+// SchemaType is the type of the field in _SCHEMA: a TYPE_* constant, or the name of a class.
+func (f PayloadField) SchemaType() string {
+	if f.Kind == "object" {
+		return strconv.Quote(f.Type)
+	}
+	return godotTypeConstants[f.Type]
+}
+
+// Content is the type of the elements of arrays and maps in _SCHEMA.
+func (f PayloadField) Content() string {
+	switch f.Kind {
+	case "object_array", "object_map":
+		return strconv.Quote(f.Element)
+	case "array", "map":
+		return godotTypeConstants[f.Element]
+	}
+	return ""
+}
+
+// Default is the value of the property when the field is not set.
+func (f PayloadField) Default() string {
+	if f.Fallback != "" {
+		return f.Fallback
+	}
+	switch f.Type {
+	case "bool":
+		return "false"
+	case "int":
+		return "0"
+	case "float":
+		return "0.0"
+	case "String":
+		return `""`
+	case "Dictionary":
+		return "{}"
+	case "Array":
+		return "[]"
+	case "PackedStringArray", "PackedInt64Array", "PackedFloat64Array":
+		return f.Type + "()"
+	}
+	return "null"
+}
+
+// Convert is the conversion of the elements of arrays and maps of scalars.
+func (f PayloadField) Convert() string {
+	if f.Element == "String" {
+		return "str"
+	}
+	return f.Element
+}
+
+// ClassSetter describes the setter of a property: Kind is enum, map, object_map, object_array, array, or scalar for
+// the other values, which are coerced when they have a JSONType.
+type ClassSetter struct {
+	// Field is the backing field, and Label the property in the errors.
+	Field string
+	Label string
+	Kind  string
+	// JSONType is the JSON type of the value, or of the elements of arrays and maps: integer, number, string, boolean
+	// or object.
+	JSONType string
+	// Class is the class of the elements of objects, or the enum, and Values the expression of the values of the enum.
+	Class    string
+	Values   string
+	Nullable bool
+	// ArrayDecl is the declaration of the array the elements are coerced into, after its name.
+	ArrayDecl string
+	// Lazy is set for the arrays of objects which are deserialized on first access.
+	Lazy bool
+}
+
+// jsonTypes are the JSON types of the GDScript types of the values of payloads.
+var jsonTypes = map[string]string{"int": "integer", "float": "number", "String": "string", "bool": "boolean", "Dictionary": "object"}
+
+// Setter returns the setter of the property of the field.
+func (f PayloadField) Setter() ClassSetter {
+	setter := ClassSetter{Field: "_" + f.Name, Label: f.Name, Kind: f.Kind, Nullable: f.Nullable}
+	switch f.Kind {
+	case "scalar":
+		if f.Enum != "" {
+			setter.Kind, setter.Class, setter.Values = "enum", f.Enum, f.Enum+".VALUES"
+		} else {
+			setter.JSONType = jsonTypes[f.Type]
+		}
+	case "object":
+		setter.Kind = "scalar"
+	case "array":
+		setter.JSONType, setter.ArrayDecl = jsonTypes[f.Element], " := "+f.Default()
+	case "map":
+		setter.JSONType = jsonTypes[f.Element]
+	default:
+		setter.Class = f.Element
+	}
+	return setter
+}
+
+// scalarArrays are the GDScript types of the arrays of scalars.
+var scalarArrays = map[string]string{
+	"String": "PackedStringArray", "int": "PackedInt64Array", "float": "PackedFloat64Array", "bool": "Array",
+}
+
+// newPayloadField builds the field of a value of GDScript type element, in an array or a map when container is
+// "array" or "map".
+func newPayloadField(key, container, element string, class bool) PayloadField {
+	f := PayloadField{Key: key, Name: payloadName(key), Kind: "scalar", Type: element}
+	switch {
+	case container == "" && class:
+		f.Kind = "object"
+	case container == "":
+	case element == "Variant" || element == "Array" || element == "Dictionary":
+		// Nested containers are kept as they are decoded.
+		f.Type = map[string]string{"array": "Array", "map": "Dictionary"}[container]
+	case class:
+		f.Kind, f.Element = "object_"+container, element
+		f.Type = map[string]string{"array": "Array", "map": "Dictionary"}[container]
+	case container == "array":
+		f.Kind, f.Type, f.Element = "array", scalarArrays[element], element
+	default:
+		f.Kind, f.Type, f.Element = "map", "Dictionary", element
+	}
+	return f
+}
+
+// payloadName is the GDScript property of a JSON key.
+func payloadName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, pascalToSnake(key))
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// GoSource is a Go package parsed without type checking, like a Nakama Go runtime module.
+type GoSource struct {
+	fset  *token.FileSet
+	files []*ast.File
+	// The types, functions and string constants declared at the top level, and the methods by name.
+	types   map[string]*ast.TypeSpec
+	docs    map[string]*ast.CommentGroup
+	funcs   map[string]*ast.FuncDecl
+	methods map[string]*ast.FuncDecl
+	consts  map[string]string
+	// The paths of the packages imported, by the name they are imported as.
+	imports map[string]string
+}
+
+// parseGoSource parses a Go file, or the Go files of a directory except tests.
+func parseGoSource(path string) (*GoSource, error) {
+	paths := []string{path}
+	if info, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if info.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(path, "*.go")); err != nil {
+			return nil, err
+		}
+	}
+	src := &GoSource{
+		fset:    token.NewFileSet(),
+		types:   map[string]*ast.TypeSpec{},
+		docs:    map[string]*ast.CommentGroup{},
+		funcs:   map[string]*ast.FuncDecl{},
+		methods: map[string]*ast.FuncDecl{},
+		consts:  map[string]string{},
+		imports: map[string]string{},
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(src.fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		src.files = append(src.files, file)
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			src.imports[name] = path
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					src.methods[decl.Name.Name] = decl
+				} else {
+					src.funcs[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						src.types[spec.Name.Name] = spec
+						if src.docs[spec.Name.Name] = spec.Doc; spec.Doc == nil && !decl.Lparen.IsValid() {
+							src.docs[spec.Name.Name] = decl.Doc
+						}
+					case *ast.ValueSpec:
+						for i, name := range spec.Names {
+							if i < len(spec.Values) && decl.Tok == token.CONST {
+								if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+									src.consts[name.Name], _ = strconv.Unquote(lit.Value)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	if len(src.files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", path)
+	}
+	return src, nil
+}
+
+// structType returns the struct a type name is declared as, if it is one.
+func (src *GoSource) structType(name string) *ast.StructType {
+	if spec, ok := src.types[name]; ok {
+		if st, ok := spec.Type.(*ast.StructType); ok {
+			return st
+		}
+	}
+	return nil
+}
+
+// goBasicTypes are the GDScript types of the predeclared Go types.
+var goBasicTypes = map[string]string{
+	"bool": "bool", "string": "String", "float32": "float", "float64": "float",
+	"int": "int", "int8": "int", "int16": "int", "int32": "int", "int64": "int", "rune": "int",
+	"uint": "int", "uint8": "int", "uint16": "int", "uint32": "int", "uint64": "int", "byte": "int",
+	"any": "Variant",
+}
+
+// godotType returns the GDScript type of a Go type which is not an array or a map, and if it is a class.
+func (src *GoSource) godotType(expr ast.Expr) (string, bool, bool) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return src.godotType(expr.X)
+	case *ast.Ident:
+		if t, ok := goBasicTypes[expr.Name]; ok {
+			return t, false, true
+		}
+		if src.structType(expr.Name) != nil {
+			return expr.Name, true, true
+		}
+		if spec, ok := src.types[expr.Name]; ok {
+			return src.godotType(spec.Type)
+		}
+	case *ast.SelectorExpr:
+		pkg, _ := expr.X.(*ast.Ident)
+		if pkg == nil {
+			break
+		}
+		switch src.imports[pkg.Name] + "." + expr.Sel.Name {
+		case "time.Time":
+			return "String", false, true // Encoded in RFC 3339
+		case "time.Duration":
+			return "int", false, true // In nanoseconds
+		case "encoding/json.RawMessage":
+			return "Variant", false, true
+		}
+	case *ast.InterfaceType:
+		return "Variant", false, true
+	case *ast.StructType:
+		return "Dictionary", false, true
+	case *ast.ArrayType:
+		if ident, ok := expr.Elt.(*ast.Ident); ok && (ident.Name == "byte" || ident.Name == "uint8") {
+			return "String", false, true // Encoded in base64
+		}
+		return "Array", false, true
+	case *ast.MapType:
+		return "Dictionary", false, true
+	}
+	return "", false, false
+}
+
+// payloadField converts a Go type to the field of a JSON key.
+func (src *GoSource) payloadField(key string, expr ast.Expr) (PayloadField, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok && src.structType(ident.Name) == nil {
+		// A named array or map.
+		if spec, ok := src.types[ident.Name]; ok {
+			switch spec.Type.(type) {
+			case *ast.ArrayType, *ast.MapType:
+				return src.payloadField(key, spec.Type)
+			}
+		}
+	}
+	container := ""
+	switch t := expr.(type) {
+	case *ast.ArrayType:
+		if element, _, _ := src.godotType(t); element == "Array" {
+			container, expr = "array", t.Elt
+		}
+	case *ast.MapType:
+		container, expr = "map", t.Value
+	}
+	element, class, ok := src.godotType(expr)
+	if !ok {
+		return PayloadField{}, false
+	}
+	return newPayloadField(key, container, element, class), true
+}
+
+// goField is a field of a struct encoded by encoding/json.
+type goField struct {
+	Key       string
+	Type      ast.Expr
+	Doc       string
+	OmitEmpty bool
+	// The depth of the struct the field is embedded from, 0 for the fields of the struct itself.
+	depth int
+}
+
+// structFields returns the fields of a struct which encoding/json encodes, with their keys: the exported ones,
+// without the ones tagged "-", and the fields of the structs embedded without a key in place of them, unless
+// a field closer to the struct has the same key. It fails on a struct embedded from another package, as its
+// fields are unknown.
+func (src *GoSource) structFields(name string, depth int) (fields []goField, err error) {
+	st := src.structType(name)
+	if st == nil || depth > 8 {
+		return nil, nil
+	}
+	for _, field := range st.Fields.List {
+		tag := ""
+		if field.Tag != nil {
+			tag, _ = strconv.Unquote(field.Tag.Value)
+		}
+		options := strings.Split(reflect.StructTag(tag).Get("json"), ",")
+		if options[0] == "-" && len(options) == 1 {
+			continue
+		}
+		f := goField{Key: options[0], Type: field.Type, Doc: goDoc(field.Doc), depth: depth}
+		if f.Doc == "" {
+			f.Doc = goDoc(field.Comment)
+		}
+		for _, option := range options[1:] {
+			f.OmitEmpty = f.OmitEmpty || option == "omitempty"
+		}
+		if len(field.Names) == 0 {
+			embedded := field.Type
+			if star, ok := embedded.(*ast.StarExpr); ok {
+				embedded = star.X
+			}
+			ident, ok := embedded.(*ast.Ident)
+			switch {
+			case !ok && f.Key != "":
+				fields = append(fields, f)
+			case !ok:
+				return nil, fmt.Errorf("%s embeds %s, which is not declared in the package: tag it with a JSON key, or json:\"-\"", name, types.ExprString(embedded))
+			case f.Key != "" && ident.IsExported():
+				fields = append(fields, f)
+			case f.Key == "" && src.structType(ident.Name) != nil:
+				embeddedFields, err := src.structFields(ident.Name, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, embeddedFields...)
+			case ident.IsExported():
+				f.Key = ident.Name
+				fields = append(fields, f)
+			}
+			continue
+		}
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				if f.Key == "" || len(field.Names) > 1 {
+					f.Key = ident.Name
+				}
+				fields = append(fields, f)
+			}
+		}
+	}
+	if depth > 0 {
+		return fields, nil
+	}
+	// Fields embedded deeper are hidden by the ones of the same key.
+	closest := map[string]int{}
+	for _, f := range fields {
+		if d, ok := closest[f.Key]; !ok || f.depth < d {
+			closest[f.Key] = f.depth
+		}
+	}
+	out := fields[:0]
+	for _, f := range fields {
+		if f.depth == closest[f.Key] {
+			out = append(out, f)
+			closest[f.Key] = -1
+		}
+	}
+	return out, nil
+}
+
+// payloadClasses converts the structs named, and the ones their fields use, to classes. It fails on a field of a
+// type which is neither declared in the package nor one of another package which godotType knows, rather than
+// leave it out of the class.
+func (src *GoSource) payloadClasses(names []string) (classes []PayloadClass, err error) {
+	done := map[string]bool{}
+	for len(names) > 0 {
+		name := names[0]
+		names = names[1:]
+		if done[name] || src.structType(name) == nil {
+			continue
+		}
+		done[name] = true
+		class := PayloadClass{Name: name, Description: goDoc(src.docs[name])}
+		fields, err := src.structFields(name, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range fields {
+			f, ok := src.payloadField(field.Key, field.Type)
+			if !ok || !src.resolved(field.Type) {
+				return nil, fmt.Errorf("the type %s of %s.%s is not declared in the package: declare it, or tag the field json:\"-\"", types.ExprString(field.Type), name, field.Key)
+			}
+			f.Description = field.Doc
+			// Like encoding/json, the scalars which are not pointers are sent even when they are not set.
+			_, pointer := field.Type.(*ast.StarExpr)
+			_, bytes := field.Type.(*ast.ArrayType)
+			f.Always = !field.OmitEmpty && !pointer && !bytes && f.Kind == "scalar" && f.Type != "Variant" &&
+				f.Type != "Array" && f.Type != "Dictionary" && !src.isTime(field.Type)
+			if f.Kind == "object" {
+				names = append(names, f.Type)
+			} else if f.Kind == "object_array" || f.Kind == "object_map" {
+				names = append(names, f.Element)
+			}
+			class.Fields = append(class.Fields, f)
+		}
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i].Name < classes[j].Name })
+	return classes, nil
+}
+
+// resolved returns if godotType knows a type, and the types of its elements when it is an array or a map.
+func (src *GoSource) resolved(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return src.resolved(t.X)
+	case *ast.ArrayType:
+		return src.resolved(t.Elt)
+	case *ast.MapType:
+		return src.resolved(t.Key) && src.resolved(t.Value)
+	}
+	_, _, ok := src.godotType(expr)
+	return ok
+}
+
+// isTime returns if a type is time.Time, which is not sent when it is not set as its zero value is not "".
+func (src *GoSource) isTime(expr ast.Expr) bool {
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		if pkg, ok := sel.X.(*ast.Ident); ok {
+			return src.imports[pkg.Name] == "time" && sel.Sel.Name == "Time"
+		}
+	}
+	return false
+}
+
+// exportedStructs returns the names of the exported structs of the package which are not generic.
+func (src *GoSource) exportedStructs() (names []string) {
+	for name, spec := range src.types {
+		if spec.Name.IsExported() && spec.TypeParams == nil && src.structType(name) != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// goDoc returns a comment as a line of text.
+func goDoc(comment *ast.CommentGroup) string {
+	return strings.Join(strings.Fields(comment.Text()), " ")
+}
+
+// GoRpc is an RPC registered by a Nakama Go runtime module, with the payloads of its handler.
+type GoRpc struct {
+	ID          string
+	Name        string
+	Description []string
+	// The class of the request, Dictionary or String, and the one of the response, or empty when there is none.
+	Request  string
+	Response string
+}
+
+// RequestParam is the name of the parameter of the request in the wrapper of the RPC.
+func (rpc GoRpc) RequestParam() string {
+	if rpc.Request == "String" {
+		return "p_payload"
+	}
+	return "p_request"
+}
+
+func (rpc GoRpc) RequestDoc() string {
+	switch rpc.Request {
+	case "String":
+		return "The payload to send."
 	case "Dictionary":
-		out += "DICTIONARY"
+		return "The request to send, encoded as JSON."
+	}
+	return fmt.Sprintf("The %s to send.", rpc.Request)
+}
+
+// Payload is the payload of the wrapper of the RPC, as an argument of rpc_async.
+func (rpc GoRpc) Payload() string {
+	switch rpc.Request {
+	case "":
+		return "null"
+	case "String":
+		return "p_payload"
+	case "Dictionary":
+		return "JSON.stringify(p_request)"
+	}
+	return "JSON.stringify(p_request.serialize())"
+}
+
+// Parse returns if the response is parsed into its class.
+func (rpc GoRpc) Parse() bool {
+	return rpc.Response != "" && rpc.Response != "Dictionary" && rpc.Response != "String"
+}
+
+// ReturnType is the return type of the wrapper of the RPC.
+func (rpc GoRpc) ReturnType() string {
+	if rpc.Parse() {
+		return rpc.Response
+	}
+	return "NakamaAPI.ApiRpc"
+}
+
+func (rpc GoRpc) ResponseDoc() string {
+	if rpc.Parse() {
+		return "a " + rpc.Response
+	}
+	return "the RPC response object"
+}
+
+// rpcs finds the RPCs registered with RegisterRpc, and infers the request and the response of their
+// handlers from the values they decode and encode with encoding/json. //codegen:request and
+// //codegen:response comments on the handler, or on the RegisterRpc call, name them instead.
+func (src *GoSource) rpcs() (rpcs []GoRpc, err error) {
+	names := map[string]string{}
+	for _, file := range src.files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 || err != nil {
+				return err == nil
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "RegisterRpc" {
+				return true
+			}
+			position := src.fset.Position(call.Pos())
+			rpc := GoRpc{ID: src.stringValue(call.Args[0])}
+			if rpc.ID == "" {
+				fmt.Fprintf(os.Stderr, "Skipping the RPC registered at %s: its ID is not a string constant\n", position)
+				return true
+			}
+			rpc.Name = rpcMethodName(rpc.ID)
+			if other, ok := names[rpc.Name]; ok {
+				err = fmt.Errorf("%s: the RPCs %s and %s have the same name %s", position, other, rpc.ID, rpc.Name)
+				return false
+			}
+			names[rpc.Name] = rpc.ID
+
+			fn, doc := src.rpcHandler(call.Args[1])
+			if doc == nil {
+				doc = src.commentAbove(file, position.Line)
+			}
+			if fn != nil {
+				rpc.Request, rpc.Response = src.rpcPayloads(fn)
+			}
+			rpc.Description = []string{fmt.Sprintf("Execute the RPC %s.", rpc.ID)}
+			if text := doc.Text(); text != "" {
+				rpc.Description = strings.Split(strings.TrimSpace(text), "\n")
+			}
+			for _, comment := range append(src.commentAbove(file, position.Line).List, doc.List...) {
+				for directive, payload := range map[string]*string{"//codegen:request ": &rpc.Request, "//codegen:response ": &rpc.Response} {
+					if strings.HasPrefix(comment.Text, directive) {
+						*payload = strings.TrimSpace(strings.TrimPrefix(comment.Text, directive))
+						if *payload == "-" {
+							*payload = ""
+						} else if *payload != "Dictionary" && *payload != "String" && src.structType(*payload) == nil {
+							err = fmt.Errorf("%s: %s is not a struct of the package", src.fset.Position(comment.Pos()), *payload)
+						}
+					}
+				}
+			}
+			rpcs = append(rpcs, rpc)
+			return true
+		})
+	}
+	return rpcs, err
+}
+
+// stringValue returns the value of a string literal or constant, or an empty string.
+func (src *GoSource) stringValue(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if value, err := strconv.Unquote(expr.Value); err == nil && expr.Kind == token.STRING {
+			return value
+		}
+	case *ast.Ident:
+		return src.consts[expr.Name]
+	}
+	return ""
+}
+
+// rpcHandler returns the function registered as an RPC: a function, a method value, a function literal, or the
+// function literal returned by a call, with the doc comment of its declaration.
+func (src *GoSource) rpcHandler(expr ast.Expr) (*ast.FuncLit, *ast.CommentGroup) {
+	var decl *ast.FuncDecl
+	switch expr := expr.(type) {
+	case *ast.FuncLit:
+		return expr, nil
+	case *ast.Ident:
+		decl = src.funcs[expr.Name]
+	case *ast.SelectorExpr:
+		decl = src.methods[expr.Sel.Name]
+	case *ast.CallExpr:
+		fn, doc := src.rpcHandler(expr.Fun)
+		if fn == nil {
+			return nil, nil
+		}
+		for _, stmt := range fn.Body.List {
+			if ret, ok := stmt.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+				if lit, ok := ret.Results[0].(*ast.FuncLit); ok {
+					return lit, doc
+				}
+			}
+		}
+		return nil, doc
+	}
+	if decl == nil || decl.Body == nil {
+		return nil, nil
+	}
+	return &ast.FuncLit{Type: decl.Type, Body: decl.Body}, decl.Doc
+}
+
+// commentAbove returns the comment on the line above line, if there is one.
+func (src *GoSource) commentAbove(file *ast.File, line int) *ast.CommentGroup {
+	for _, comment := range file.Comments {
+		if src.fset.Position(comment.End()).Line == line-1 {
+			return comment
+		}
+	}
+	return &ast.CommentGroup{}
+}
+
+// rpcPayloads returns the request which fn decodes and the response which it encodes, if they are structs of
+// the package or maps.
+func (src *GoSource) rpcPayloads(fn *ast.FuncLit) (request, response string) {
+	// The types of the parameters and local variables, by name.
+	locals := map[string]ast.Expr{}
+	for _, param := range fn.Type.Params.List {
+		for _, name := range param.Names {
+			locals[name.Name] = param.Type
+		}
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if n.Type != nil {
+					locals[name.Name] = n.Type
+				} else if i < len(n.Values) {
+					locals[name.Name] = valueType(n.Values[i], locals)
+				}
+			}
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE && len(n.Lhs) == len(n.Rhs) {
+				for i, lhs := range n.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						locals[ident.Name] = valueType(n.Rhs[i], locals)
+					}
+				}
+			}
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkg, _ := sel.X.(*ast.Ident)
+			fromJson := pkg != nil && src.imports[pkg.Name] == "encoding/json"
+			switch {
+			case fromJson && sel.Sel.Name == "Unmarshal" && len(n.Args) == 2 && request == "":
+				request = src.payloadType(valueType(n.Args[1], locals))
+			case sel.Sel.Name == "Decode" && len(n.Args) == 1 && request == "":
+				// json.NewDecoder(...).Decode(&request)
+				if decoder, ok := sel.X.(*ast.CallExpr); ok {
+					if sel, ok := decoder.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "NewDecoder" {
+						request = src.payloadType(valueType(n.Args[0], locals))
+					}
+				}
+			case fromJson && (sel.Sel.Name == "Marshal" || sel.Sel.Name == "MarshalIndent") && len(n.Args) > 0 && response == "":
+				response = src.payloadType(valueType(n.Args[0], locals))
+			}
+		}
+		return true
+	})
+	return request, response
+}
+
+// valueType returns the type of an expression from its syntax: composite literals, new(T), and the address or
+// the name of a variable.
+func valueType(expr ast.Expr, locals map[string]ast.Expr) ast.Expr {
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return valueType(expr.X, locals)
+	case *ast.UnaryExpr:
+		if expr.Op == token.AND {
+			return valueType(expr.X, locals)
+		}
+	case *ast.CompositeLit:
+		return expr.Type
+	case *ast.Ident:
+		return locals[expr.Name]
+	case *ast.CallExpr:
+		if ident, ok := expr.Fun.(*ast.Ident); ok && ident.Name == "new" && len(expr.Args) == 1 {
+			return expr.Args[0]
+		}
+	}
+	return nil
+}
+
+// payloadType returns the payload of a Go type: the name of a struct of the package, Dictionary for maps,
+// String for strings, or an empty string.
+func (src *GoSource) payloadType(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return src.payloadType(expr.X)
+	case *ast.MapType:
+		return "Dictionary"
+	case *ast.Ident:
+		if src.structType(expr.Name) != nil {
+			return expr.Name
+		}
+		if expr.Name == "string" {
+			return "String"
+		}
+		if spec, ok := src.types[expr.Name]; ok {
+			if _, ok := spec.Type.(*ast.MapType); ok {
+				return "Dictionary"
+			}
+		}
+	}
+	return ""
+}
+
+// rpcMethodName is the name of the wrapper of an RPC, in snake case.
+func rpcMethodName(id string) string {
+	var name []rune
+	for _, r := range pascalToSnake(id) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			name = append(name, r)
+		} else if len(name) > 0 && name[len(name)-1] != '_' {
+			name = append(name, '_')
+		}
+	}
+	out := strings.TrimRight(string(name), "_")
+	if out == "" || out[0] >= '0' && out[0] <= '9' {
+		out = "rpc_" + out
+	}
+	return out
+}
+
+// JSONSchema is a JSON Schema, draft 2020-12 or draft-07, of a JSON payload.
+type JSONSchema struct {
+	Ref         string `json:"$ref"`
+	Title       string
+	Description string
+	// Type is a type name, or an array of them.
+	Type json.RawMessage
+	// Nullable is the OpenAPI 3.0 way to allow null.
+	Nullable             bool
+	Properties           jsonSchemaMap
+	Required             []string
+	AdditionalProperties *JSONSchema
+	Items                *JSONSchema
+	AllOf                []*JSONSchema
+	AnyOf                []*JSONSchema
+	OneOf                []*JSONSchema
+	Enum                 []json.RawMessage
+	Const                json.RawMessage
+	Default              json.RawMessage
+	Pattern              string
+	Format               string
+	MinLength            *int
+	MaxLength            *int
+	Minimum              *float64
+	Maximum              *float64
+	ExclusiveMinimum     *float64
+	ExclusiveMaximum     *float64
+	Defs                 jsonSchemaMap `json:"$defs"`
+	Definitions          jsonSchemaMap
+	// The name of the file of the schema, which its relative references start from.
+	file string
+}
+
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	switch bytes.TrimSpace(data)[0] {
+	case 't', 'f', '[':
+		// Boolean schemas allow any value or none, and draft-07 tuples are arrays of any value.
+		return nil
+	}
+	type schema JSONSchema
+	return json.Unmarshal(data, (*schema)(s))
+}
+
+// types returns the names of the types of the schema.
+func (s *JSONSchema) types() []string {
+	var names []string
+	if err := json.Unmarshal(s.Type, &names); err != nil {
+		var name string
+		if json.Unmarshal(s.Type, &name) == nil {
+			names = []string{name}
+		}
+	}
+	return names
+}
+
+// jsonSchemaMap is an object of schemas, like properties or $defs, in the order they are written.
+type jsonSchemaMap struct {
+	Names   []string
+	Schemas map[string]*JSONSchema
+}
+
+func (m *jsonSchemaMap) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("expected an object of schemas")
+	}
+	m.Schemas = map[string]*JSONSchema{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+		schema := &JSONSchema{}
+		if err := dec.Decode(schema); err != nil {
+			return err
+		}
+		if _, ok := m.Schemas[name]; !ok {
+			m.Names = append(m.Names, name)
+		}
+		m.Schemas[name] = schema
+	}
+	return nil
+}
+
+// SchemaEnum is a schema of $defs which only allows some values, generated as a class of constants.
+type SchemaEnum struct {
+	Name        string
+	Description string
+	Constants   []SchemaConstant
+	// Values is the GDScript array of the values allowed.
+	Values string
+}
+
+type SchemaConstant struct {
+	Name  string
+	Value string
+}
+
+// jsonSchemaFormats are the regular expressions of the formats checked by validate(). The other formats are only
+// annotations.
+var jsonSchemaFormats = map[string]string{
+	"date-time": `^\d{4}-\d{2}-\d{2}[Tt ]\d{2}:\d{2}:\d{2}(\.\d+)?([Zz]|[+-]\d{2}:\d{2})$`,
+	"date":      `^\d{4}-\d{2}-\d{2}$`,
+	"time":      `^\d{2}:\d{2}:\d{2}(\.\d+)?([Zz]|[+-]\d{2}:\d{2})?$`,
+	"email":     `^[^@\s]+@[^@\s]+$`,
+	"uuid":      `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+	"uri":       `^[A-Za-z][A-Za-z0-9+.-]*:`,
+	"ipv4":      `^((25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(25[0-5]|2[0-4]\d|1?\d?\d)$`,
+	"hostname":  `^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*$`,
+}
+
+// JSONSchemaSet is a set of JSON Schema files, which reference the schemas of each other by file name.
+type JSONSchemaSet struct {
+	// The root schemas of the files, by file name.
+	files map[string]*JSONSchema
+	// The names of the classes and enums of the schemas which have one.
+	names map[*JSONSchema]string
+	enums map[*JSONSchema]bool
+	// The classes named but not generated yet.
+	pending []*JSONSchema
+}
+
+func newJSONSchemaSet() *JSONSchemaSet {
+	return &JSONSchemaSet{files: map[string]*JSONSchema{}, names: map[*JSONSchema]string{}, enums: map[*JSONSchema]bool{}}
+}
+
+// loadJSONSchemas reads a JSON Schema file, or the JSON files of a directory.
+func loadJSONSchemas(path string) (*JSONSchemaSet, error) {
+	paths := []string{path}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(path, "*.json")); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(paths)
+	set := newJSONSchemaSet()
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		root := &JSONSchema{}
+		if err := json.Unmarshal(content, root); err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		file := filepath.Base(path)
+		set.files[file] = root
+		root.walk(func(s *JSONSchema) { s.file = file })
+	}
+	if len(set.files) == 0 {
+		return nil, fmt.Errorf("no JSON Schema in %s", path)
+	}
+	return set, nil
+}
+
+// walk calls fn with the schema and all the schemas it contains.
+func (s *JSONSchema) walk(fn func(*JSONSchema)) {
+	if s == nil {
+		return
+	}
+	fn(s)
+	for _, m := range []jsonSchemaMap{s.Properties, s.Defs, s.Definitions} {
+		for _, name := range m.Names {
+			m.Schemas[name].walk(fn)
+		}
+	}
+	for _, list := range [][]*JSONSchema{s.AllOf, s.AnyOf, s.OneOf} {
+		for _, child := range list {
+			child.walk(fn)
+		}
+	}
+	s.Items.walk(fn)
+	s.AdditionalProperties.walk(fn)
+}
+
+// resolve returns the schema a reference points to, in the file of the schema or another one of the set.
+func (set *JSONSchemaSet) resolve(from *JSONSchema, ref string) *JSONSchema {
+	file, pointer, _ := strings.Cut(ref, "#")
+	if file == "" {
+		file = from.file
+	}
+	s := set.files[filepath.Base(file)]
+	unescape := strings.NewReplacer("~1", "/", "~0", "~").Replace
+	segments := strings.Split(strings.Trim(pointer, "/"), "/")
+	for i := 0; s != nil && i < len(segments) && segments[0] != ""; i++ {
+		switch key := unescape(segments[i]); key {
+		case "items":
+			s = s.Items
+		case "additionalProperties":
+			s = s.AdditionalProperties
+		case "$defs", "definitions", "properties":
+			if i++; i == len(segments) {
+				return nil
+			}
+			s = map[string]jsonSchemaMap{"$defs": s.Defs, "definitions": s.Definitions, "properties": s.Properties}[key].Schemas[unescape(segments[i])]
+		default:
+			return nil
+		}
+	}
+	return s
+}
+
+// deref follows the references of a schema to the one it is.
+func (set *JSONSchemaSet) deref(s *JSONSchema) *JSONSchema {
+	for i := 0; s != nil && s.Ref != "" && i < 32; i++ {
+		target := set.resolve(s, s.Ref)
+		if target == nil {
+			fmt.Fprintf(os.Stderr, "Unresolved reference %s in %s: using any value\n", s.Ref, s.file)
+			return &JSONSchema{file: s.file}
+		}
+		s = target
+	}
+	if s == nil {
+		return &JSONSchema{}
+	}
+	return s
+}
+
+// nonNull returns the schema of the values other than null, and if null is allowed: with a "null" type, nullable,
+// an enum with null, or in an anyOf or oneOf with a schema of the null type.
+func (set *JSONSchemaSet) nonNull(s *JSONSchema) (*JSONSchema, bool) {
+	s = set.deref(s)
+	nullable := s.Nullable
+	for _, name := range s.types() {
+		nullable = nullable || name == "null"
+	}
+	for _, value := range s.Enum {
+		nullable = nullable || string(value) == "null"
+	}
+	for _, list := range [][]*JSONSchema{s.AnyOf, s.OneOf} {
+		if len(list) != 2 {
+			continue
+		}
+		for i, option := range list {
+			if types := set.deref(option).types(); len(types) == 1 && types[0] == "null" {
+				return set.deref(list[1-i]), true
+			}
+		}
+	}
+	return s, nullable
+}
+
+// properties returns the properties of an object schema, and the ones of the schemas of its allOf.
+func (set *JSONSchemaSet) properties(s *JSONSchema) (names []string, props map[string]*JSONSchema, required map[string]bool) {
+	props, required = map[string]*JSONSchema{}, map[string]bool{}
+	var add func(s *JSONSchema, depth int)
+	add = func(s *JSONSchema, depth int) {
+		s = set.deref(s)
+		if depth > 8 {
+			return
+		}
+		for _, name := range s.Properties.Names {
+			if _, ok := props[name]; !ok {
+				names = append(names, name)
+			}
+			props[name] = s.Properties.Schemas[name]
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+		for _, child := range s.AllOf {
+			add(child, depth+1)
+		}
+	}
+	add(s, 0)
+	return names, props, required
+}
+
+// isObject returns if a schema is an object with properties, generated as a class.
+func (set *JSONSchemaSet) isObject(s *JSONSchema) bool {
+	names, _, _ := set.properties(s)
+	return len(names) > 0
+}
+
+// scalarType returns the GDScript type of a schema which is not an object with properties or an array.
+func (set *JSONSchemaSet) scalarType(s *JSONSchema) string {
+	var types []string
+	for _, name := range s.types() {
+		if name != "null" {
+			types = append(types, name)
+		}
+	}
+	// Without a type, it is the one of the values allowed.
+	values := s.Enum
+	if len(s.Const) > 0 {
+		values = []json.RawMessage{s.Const}
+	}
+	for _, value := range values {
+		name := ""
+		switch v := jsonValue(value).(type) {
+		case string:
+			name = "string"
+		case bool:
+			name = "boolean"
+		case json.Number:
+			name = "number"
+			if _, err := v.Int64(); err == nil {
+				name = "integer"
+			}
+		}
+		if len(s.Type) == 0 && name != "" && (len(types) == 0 || types[0] == "integer" && name == "number") {
+			types = []string{name}
+		}
+	}
+	if len(types) != 1 {
+		return "Variant"
+	}
+	switch types[0] {
+	case "string":
+		return "String"
+	case "integer":
+		return "int"
+	case "number":
+		return "float"
+	case "boolean":
+		return "bool"
+	case "object":
+		return "Dictionary"
+	case "array":
+		return "Array"
+	}
+	return "Variant"
+}
+
+// godotType returns the GDScript type of the values of a schema, in an array or a map when container is "array" or
+// "map", and the schema of the values. Objects with properties are classes, named name unless they have one.
+func (set *JSONSchemaSet) godotType(name string, s *JSONSchema) (container, element string, class bool, value *JSONSchema) {
+	if named, ok := set.names[s]; ok && !set.enums[s] {
+		return "", named, true, s
+	}
+	if set.isObject(s) {
+		set.names[s] = name
+		set.pending = append(set.pending, s)
+		return "", name, true, s
+	}
+	types := s.types()
+	isType := func(want string) bool {
+		for _, t := range types {
+			if t == want {
+				return true
+			}
+		}
+		return len(types) == 0
+	}
+	var items *JSONSchema
+	switch {
+	case s.Items != nil && isType("array"):
+		container, items = "array", s.Items
+	case s.AdditionalProperties != nil && isType("object"):
+		container, items = "map", s.AdditionalProperties
 	default:
-		out = "\"" + p_type + "\""
+		return "", set.scalarType(s), false, s
+	}
+	item, _ := set.nonNull(items)
+	itemContainer, element, class, _ := set.godotType(name, item)
+	if itemContainer != "" {
+		// Nested containers are kept as they are decoded.
+		element, class = map[string]string{"array": "Array", "map": "Dictionary"}[itemContainer], false
+	}
+	return container, element, class, item
+}
+
+// jsonValue decodes a JSON value, with its numbers as json.Number.
+func jsonValue(raw json.RawMessage) (v interface{}) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	dec.Decode(&v)
+	return v
+}
+
+// gdLiteral returns a JSON value as a GDScript literal, with its numbers as floats when typ is float.
+func gdLiteral(raw json.RawMessage, typ string) string {
+	switch v := jsonValue(raw).(type) {
+	case string:
+		return gdString(v)
+	case json.Number:
+		if f, err := v.Float64(); err == nil && typ == "float" {
+			return gdFloat(f)
+		}
+		return v.String()
+	case nil:
+		return "null"
+	}
+	var compact bytes.Buffer
+	json.Compact(&compact, raw)
+	return compact.String()
+}
+
+// gdString returns a string as a GDScript string literal.
+func gdString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s) + `"`
+}
+
+// schemaClassName returns the name of the class of a schema, in Pascal case.
+func schemaClassName(name string) string {
+	var out string
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		out += camelToPascal(word)
+	}
+	if out == "" || out[0] >= '0' && out[0] <= '9' {
+		out = "Schema" + out
+	}
+	return out
+}
+
+// enumValues returns the GDScript array of the values an enum allows.
+func enumValues(s *JSONSchema, typ string) string {
+	var values []string
+	for _, value := range s.Enum {
+		values = append(values, gdLiteral(value, typ))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// valueChecks returns the lines of validate() which check a value of type typ, the GDScript expression value,
+// against the constraints of its schema. The errors start with label, a GDScript expression of the path of the value.
+func (set *JSONSchemaSet) valueChecks(s *JSONSchema, typ, value, label string) (checks []string) {
+	check := func(condition, message string) {
+		checks = append(checks, fmt.Sprintf("if %s: errors.append(%s + %s)", condition, label, gdString(" "+message)))
+	}
+	number := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	if len(s.Enum) > 0 {
+		values := enumValues(s, typ)
+		if set.enums[s] {
+			values = set.names[s] + ".VALUES"
+		}
+		var allowed bytes.Buffer
+		json.Compact(&allowed, []byte(enumValues(s, "")))
+		check(fmt.Sprintf("%s != null and not %s in %s", value, value, values), "is not one of "+allowed.String())
+	}
+	if len(s.Const) > 0 {
+		check(fmt.Sprintf("%s != null and %s != %s", value, value, gdLiteral(s.Const, typ)), "is not "+string(s.Const))
+	}
+	isString := value + " is String and "
+	if s.MinLength != nil {
+		check(fmt.Sprintf("%s%s.length() < %d", isString, value, *s.MinLength), fmt.Sprintf("is shorter than %d characters", *s.MinLength))
+	}
+	if s.MaxLength != nil {
+		check(fmt.Sprintf("%s%s.length() > %d", isString, value, *s.MaxLength), fmt.Sprintf("is longer than %d characters", *s.MaxLength))
+	}
+	if s.Pattern != "" {
+		check(fmt.Sprintf("%sRegEx.create_from_string(%s).search(%s) == null", isString, gdString(s.Pattern), value), "does not match "+s.Pattern)
+	}
+	if pattern, ok := jsonSchemaFormats[s.Format]; ok {
+		check(fmt.Sprintf("%sRegEx.create_from_string(%s).search(%s) == null", isString, gdString(pattern), value), "is not a valid "+s.Format)
+	}
+	isNumber := fmt.Sprintf("(%s is int or %s is float) and ", value, value)
+	if s.Minimum != nil {
+		check(fmt.Sprintf("%s%s < %s", isNumber, value, number(*s.Minimum)), "is less than "+number(*s.Minimum))
+	}
+	if s.ExclusiveMinimum != nil {
+		check(fmt.Sprintf("%s%s <= %s", isNumber, value, number(*s.ExclusiveMinimum)), "is not greater than "+number(*s.ExclusiveMinimum))
+	}
+	if s.Maximum != nil {
+		check(fmt.Sprintf("%s%s > %s", isNumber, value, number(*s.Maximum)), "is greater than "+number(*s.Maximum))
+	}
+	if s.ExclusiveMaximum != nil {
+		check(fmt.Sprintf("%s%s >= %s", isNumber, value, number(*s.ExclusiveMaximum)), "is not less than "+number(*s.ExclusiveMaximum))
+	}
+	return checks
+}
+
+// field converts a property of an object schema to a field of its class.
+func (set *JSONSchemaSet) field(owner, key string, prop *JSONSchema, required bool) PayloadField {
+	s, nullable := set.nonNull(prop)
+	container, element, class, value := set.godotType(owner+schemaClassName(key), s)
+	f := newPayloadField(key, container, element, class)
+	f.Description = prop.Description
+	if f.Description == "" && set.names[s] == "" {
+		f.Description = s.Description
+	}
+	f.Nullable = nullable
+	f.Required = required
+	if set.enums[value] {
+		f.Enum = set.names[value]
+	}
+	f.Always = (required || len(s.Const) > 0) && f.Kind == "scalar" && (f.Type != "Variant" || nullable)
+	if f.Kind == "scalar" && f.Type != "Variant" {
+		if len(s.Const) > 0 {
+			f.Fallback = gdLiteral(s.Const, f.Type)
+		} else if len(s.Default) > 0 && string(s.Default) != "null" {
+			f.Fallback = gdLiteral(s.Default, f.Type)
+		}
+	}
+
+	name, label := "_"+f.Name, gdString(key)
+	// Nullable fields are sent as null, and the ones with a const with their value, when they are not set.
+	if required && !nullable && len(s.Const) == 0 {
+		f.Checks = append(f.Checks, fmt.Sprintf("if %s == null: errors.append(%s)", name, gdString(key+" is required")))
+	}
+	indent := func(lines []string, tabs string) []string {
+		for i := range lines {
+			lines[i] = tabs + lines[i]
+		}
+		return lines
+	}
+	switch f.Kind {
+	case "scalar":
+		f.Checks = append(f.Checks, set.valueChecks(value, f.Type, name, label)...)
+	case "object":
+		f.Checks = append(f.Checks,
+			fmt.Sprintf("if %s is %s:", name, f.Type),
+			fmt.Sprintf("\tfor e in %s.validate(): errors.append(%s + e)", name, gdString(key+".")))
+	case "object_array":
+		f.Checks = append(f.Checks,
+			fmt.Sprintf("if %s is Array:", name),
+			fmt.Sprintf("\tfor i in %s.size():", name),
+			fmt.Sprintf("\t\tif %s[i] is %s:", name, f.Element),
+			fmt.Sprintf("\t\t\tfor e in %s[i].validate(): errors.append(%s %% i + e)", name, gdString(key+"[%d].")))
+	case "object_map":
+		f.Checks = append(f.Checks,
+			fmt.Sprintf("if %s is Dictionary:", name),
+			fmt.Sprintf("\tfor k in %s:", name),
+			fmt.Sprintf("\t\tif %s[k] is %s:", name, f.Element),
+			fmt.Sprintf("\t\t\tfor e in %s[k].validate(): errors.append(%s %% k + e)", name, gdString(key+".%s.")))
+	case "array":
+		if checks := set.valueChecks(value, f.Element, name+"[i]", gdString(key+"[%d]")+" % i"); len(checks) > 0 {
+			f.Checks = append(f.Checks, fmt.Sprintf("if %s != null:", name), fmt.Sprintf("\tfor i in %s.size():", name))
+			f.Checks = append(f.Checks, indent(checks, "\t\t")...)
+		}
+	case "map":
+		if checks := set.valueChecks(value, f.Element, name+"[k]", gdString(key+".%s")+" % k"); len(checks) > 0 {
+			f.Checks = append(f.Checks, fmt.Sprintf("if %s is Dictionary:", name), fmt.Sprintf("\tfor k in %s:", name))
+			f.Checks = append(f.Checks, indent(checks, "\t\t")...)
+		}
+	}
+	return f
+}
+
+// name names the class of a schema, unless another schema has the same name.
+func (set *JSONSchemaSet) name(s *JSONSchema, name string) bool {
+	for _, other := range set.names {
+		if other == name {
+			fmt.Fprintf(os.Stderr, "Skipping schema %s of %s: another schema has the same name\n", name, s.file)
+			return false
+		}
+	}
+	set.names[s] = name
+	return true
+}
+
+// add adds an object schema which is not in a file of the set, like one inline in a manifest, as the class name.
+func (set *JSONSchemaSet) add(s *JSONSchema, name string) bool {
+	if !set.isObject(s) || !set.name(s, name) {
+		return false
+	}
+	set.pending = append(set.pending, s)
+	return true
+}
+
+// addInline adds the object schema of a manifest which is written inline rather than referenced, as the class name.
+// It returns nil when the schema is a reference, or is not set.
+func (set *JSONSchemaSet) addInline(raw json.RawMessage, name string) (*JSONSchema, error) {
+	if raw = bytes.TrimSpace(raw); len(raw) == 0 || raw[0] != '{' {
+		return nil, nil
+	}
+	s := &JSONSchema{}
+	if err := json.Unmarshal(raw, s); err != nil {
+		return nil, err
+	}
+	if !set.add(s, name) {
+		return nil, fmt.Errorf("not an object schema")
+	}
+	return s, nil
+}
+
+// valueClass returns the class of the schema of a manifest, once classes() named it: the one added by addInline(),
+// or the one referenced. It returns "" when the schema is not set.
+func (set *JSONSchemaSet) valueClass(raw json.RawMessage, inline *JSONSchema) (string, error) {
+	if inline != nil {
+		return set.names[inline], nil
+	}
+	var ref string
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &ref); err != nil {
+			return "", fmt.Errorf("must be a reference or a schema")
+		}
+	}
+	if ref == "" {
+		return "", nil
+	}
+	class := set.className(ref)
+	if class == "" {
+		return "", fmt.Errorf("%s is not an object schema", ref)
+	}
+	return class, nil
+}
+
+// className returns the name of the class of the object schema a reference like "file.json#/$defs/Name" points to,
+// once classes() named it, or "" when it is not one.
+func (set *JSONSchemaSet) className(ref string) string {
+	if file, _, _ := strings.Cut(ref, "#"); file == "" {
+		return ""
+	}
+	s := set.resolve(&JSONSchema{}, ref)
+	if s == nil || set.enums[set.deref(s)] {
+		return ""
+	}
+	return set.names[set.deref(s)]
+}
+
+// classes converts the object schemas of the set, its roots and the ones of their $defs, and the ones added, to
+// classes, with the ones their properties use, and the enums of their $defs to classes of constants.
+func (set *JSONSchemaSet) classes() (classes []PayloadClass, enums []SchemaEnum) {
+	var files []string
+	for file := range set.files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		root := set.files[file]
+		if set.isObject(root) {
+			title := root.Title
+			if title == "" {
+				title = strings.TrimSuffix(strings.TrimSuffix(file, ".json"), ".schema")
+			}
+			if set.name(root, schemaClassName(title)) {
+				set.pending = append(set.pending, root)
+			}
+		}
+		for _, defs := range []jsonSchemaMap{root.Defs, root.Definitions} {
+			for _, key := range defs.Names {
+				s := defs.Schemas[key]
+				switch {
+				case set.isObject(s):
+					if set.name(s, schemaClassName(key)) {
+						set.pending = append(set.pending, s)
+					}
+				case len(s.Enum) > 0 && s.Ref == "":
+					if !set.name(s, schemaClassName(key)) {
+						continue
+					}
+					set.enums[s] = true
+					enum := SchemaEnum{Name: set.names[s], Description: s.Description, Values: enumValues(s, set.scalarType(s))}
+					for _, value := range s.Enum {
+						if v, ok := jsonValue(value).(string); ok && v != "" {
+							constant := strings.ToUpper(payloadName(v))
+							if constant != "VALUES" {
+								enum.Constants = append(enum.Constants, SchemaConstant{constant, gdString(v)})
+							}
+						}
+					}
+					enums = append(enums, enum)
+				}
+			}
+		}
+	}
+	for len(set.pending) > 0 {
+		s := set.pending[0]
+		set.pending = set.pending[1:]
+		class := PayloadClass{Name: set.names[s], Description: s.Description, Validate: true}
+		if class.Description == "" {
+			class.Description = s.Title
+		}
+		names, props, required := set.properties(s)
+		for _, key := range names {
+			class.Fields = append(class.Fields, set.field(class.Name, key, props[key], required[key]))
+		}
+		classes = append(classes, class)
+	}
+	return classes, enums
+}
+
+// usedClasses returns the classes and enums of schemas which the classes named roots use, themselves included,
+// in the same order.
+func usedClasses(classes []PayloadClass, enums []SchemaEnum, roots []string) (used []PayloadClass, usedEnums []SchemaEnum) {
+	byName := map[string]PayloadClass{}
+	for _, class := range classes {
+		byName[class.Name] = class
+	}
+	uses := map[string]bool{}
+	var use func(name string)
+	use = func(name string) {
+		class, ok := byName[name]
+		if !ok || uses[name] {
+			return
+		}
+		uses[name] = true
+		for _, f := range class.Fields {
+			use(f.Type)
+			use(f.Element)
+			if f.Enum != "" {
+				uses[f.Enum] = true
+			}
+		}
+	}
+	for _, root := range roots {
+		use(root)
+	}
+	for _, class := range classes {
+		if uses[class.Name] {
+			used = append(used, class)
+		}
+	}
+	for _, enum := range enums {
+		if uses[enum.Name] {
+			usedEnums = append(usedEnums, enum)
+		}
+	}
+	return used, usedEnums
+}
+
+// StorageManifest is a manifest of the storage collections of a game, with the schemas of their values.
+type StorageManifest struct {
+	// Schemas is the JSON Schema file, or the directory of them, of the values, relative to the manifest.
+	Schemas     string
+	Collections []StorageCollection
+}
+
+type StorageCollection struct {
+	Name        string
+	Description string
+	// Key is the key of the objects, with {placeholders} which are parameters of the accessors.
+	Key string
+	// Value is the reference of the schema of the values in Schemas, like "profile.schema.json" or
+	// "profile.schema.json#/$defs/Item", or empty for any JSON object.
+	Value           string
+	PermissionRead  *int `json:"permission_read"`
+	PermissionWrite *int `json:"permission_write"`
+}
+
+// StorageClass is the accessor of a collection of a StorageManifest.
+type StorageClass struct {
+	Name        string
+	Field       string
+	Collection  string
+	Description string
+	// KeyFormat is the GDScript string literal of the format of the keys, and KeyParams the parameters of its placeholders.
+	KeyFormat string
+	KeyParams []string
+	// Value is the class of the values, empty for dictionaries.
+	Value           string
+	PermissionRead  int
+	PermissionWrite int
+}
+
+// KeyDeclarations are the declarations of the parameters of the key, each after a comma.
+func (c StorageClass) KeyDeclarations() string {
+	var out string
+	for _, param := range c.KeyParams {
+		out += ", " + param + " : String"
+	}
+	return out
+}
+
+// KeyArguments are the parameters of the key, separated by commas.
+func (c StorageClass) KeyArguments() string {
+	return strings.Join(c.KeyParams, ", ")
+}
+
+// ValueType is the GDScript type of the values.
+func (c StorageClass) ValueType() string {
+	if c.Value == "" {
+		return "Dictionary"
+	}
+	return c.Value
+}
+
+// storageClasses validates a storage manifest, read from dir, and returns the classes and enums of its schemas,
+// then the accessors of its collections.
+func storageClasses(manifest StorageManifest, dir string) (classes []PayloadClass, enums []SchemaEnum, collections []StorageClass, err error) {
+	set := newJSONSchemaSet()
+	if manifest.Schemas != "" {
+		if set, err = loadJSONSchemas(filepath.Join(dir, manifest.Schemas)); err != nil {
+			return nil, nil, nil, err
+		}
+		classes, enums = set.classes()
+	}
+	names := map[string]bool{}
+	for _, c := range manifest.Collections {
+		class := StorageClass{
+			Name:            schemaClassName(c.Name),
+			Field:           payloadName(c.Name),
+			Collection:      c.Name,
+			Description:     c.Description,
+			PermissionRead:  1,
+			PermissionWrite: 1,
+		}
+		if c.Name == "" || names[class.Name] {
+			return nil, nil, nil, fmt.Errorf("collection name %q is empty or not unique", c.Name)
+		}
+		names[class.Name] = true
+		if c.PermissionRead != nil {
+			class.PermissionRead = *c.PermissionRead
+		}
+		if c.PermissionWrite != nil {
+			class.PermissionWrite = *c.PermissionWrite
+		}
+		if class.PermissionRead < 0 || class.PermissionRead > 2 || class.PermissionWrite < 0 || class.PermissionWrite > 1 {
+			return nil, nil, nil, fmt.Errorf("collection %s: permission_read must be 0, 1 or 2, and permission_write 0 or 1", c.Name)
+		}
+		if c.Key == "" {
+			return nil, nil, nil, fmt.Errorf("collection %s has no key", c.Name)
+		}
+		for rest := c.Key; rest != ""; {
+			open := strings.IndexByte(rest, '{')
+			if open < 0 {
+				class.KeyFormat += strings.ReplaceAll(rest, "%", "%%")
+				break
+			}
+			end := strings.IndexByte(rest[open:], '}')
+			if end < 0 {
+				return nil, nil, nil, fmt.Errorf("collection %s: unclosed placeholder in key %q", c.Name, c.Key)
+			}
+			placeholder := rest[open+1 : open+end]
+			if placeholder == "" || payloadName(placeholder) != placeholder {
+				return nil, nil, nil, fmt.Errorf("collection %s: placeholder {%s} of key %q is not a snake_case name", c.Name, placeholder, c.Key)
+			}
+			class.KeyFormat += strings.ReplaceAll(rest[:open], "%", "%%") + "%s"
+			class.KeyParams = append(class.KeyParams, "p_"+placeholder)
+			rest = rest[open+end+1:]
+		}
+		class.KeyFormat = gdString(class.KeyFormat)
+		if c.Value != "" {
+			if class.Value = set.className(c.Value); class.Value == "" {
+				return nil, nil, nil, fmt.Errorf("collection %s: value %s is not an object schema of %s", c.Name, c.Value, manifest.Schemas)
+			}
+		}
+		collections = append(collections, class)
+	}
+	return classes, enums, collections, nil
+}
+
+// NotificationRegistry is the notifications a server sends, by code, with the schemas of their content.
+type NotificationRegistry struct {
+	// Schemas is the JSON Schema file, or the directory of them, of the contents, relative to the registry.
+	Schemas       string
+	Notifications []RegisteredNotification
+}
+
+type RegisteredNotification struct {
+	Code        int
+	Name        string // snake_case.
+	Description string
+	// Content is the reference of the schema of the content in Schemas, like "gift.schema.json", a JSON Schema, or
+	// empty for any JSON object.
+	Content json.RawMessage
+}
+
+// NotificationClass is the class of the notifications of a code.
+type NotificationClass struct {
+	Code        int
+	Name        string
+	Const       string
+	Signal      string
+	Description string
+	// Content is the class of the content, empty for dictionaries.
+	Content string
+}
+
+// ContentType is the GDScript type of the content.
+func (n NotificationClass) ContentType() string {
+	if n.Content == "" {
+		return "Dictionary"
+	}
+	return n.Content
+}
+
+// notificationClasses validates a notification registry, read from dir, and returns the classes and enums of its
+// schemas, then the classes of its notifications.
+func notificationClasses(registry NotificationRegistry, dir string) (classes []PayloadClass, enums []SchemaEnum, notifications []NotificationClass, err error) {
+	set := newJSONSchemaSet()
+	if registry.Schemas != "" {
+		if set, err = loadJSONSchemas(filepath.Join(dir, registry.Schemas)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	names := map[string]bool{}
+	codes := map[int]string{}
+	inline := map[string]*JSONSchema{}
+	for _, n := range registry.Notifications {
+		if n.Name == "" || payloadName(n.Name) != n.Name || names[n.Name] {
+			return nil, nil, nil, fmt.Errorf("notification name %q is not snake_case or not unique", n.Name)
+		}
+		names[n.Name] = true
+		if other, ok := codes[n.Code]; ok {
+			return nil, nil, nil, fmt.Errorf("code %d is used by both %s and %s", n.Code, other, n.Name)
+		}
+		codes[n.Code] = n.Name
+		if inline[n.Name], err = set.addInline(n.Content, schemaClassName(n.Name)+"Content"); err != nil {
+			return nil, nil, nil, fmt.Errorf("content of %s: %s", n.Name, err)
+		}
+	}
+	classes, enums = set.classes()
+	taken := map[string]bool{}
+	for _, class := range classes {
+		taken[class.Name] = true
+	}
+	for _, n := range registry.Notifications {
+		class := NotificationClass{
+			Code:        n.Code,
+			Name:        schemaClassName(n.Name) + "Notification",
+			Const:       strings.ToUpper(n.Name),
+			Signal:      "received_" + n.Name,
+			Description: n.Description,
+		}
+		if taken[class.Name] {
+			return nil, nil, nil, fmt.Errorf("class %s of notification %s is also the one of a schema", class.Name, n.Name)
+		}
+		if class.Content, err = set.valueClass(n.Content, inline[n.Name]); err != nil {
+			return nil, nil, nil, fmt.Errorf("content of %s: %s", n.Name, err)
+		}
+		notifications = append(notifications, class)
+	}
+	return classes, enums, notifications, nil
+}
+
+// SatoriManifest is the flags, experiments and live events of a game in Satori, with the schemas of their values.
+type SatoriManifest struct {
+	// Schemas is the JSON Schema file, or the directory of them, of the values, relative to the manifest.
+	Schemas     string
+	Flags       []SatoriFlag
+	Experiments []SatoriExperiment
+	LiveEvents  []SatoriLiveEvent `json:"live_events"`
+}
+
+type SatoriFlag struct {
+	Name        string
+	Description string
+	// Type is bool, int, float, string or json.
+	Type    string
+	Default json.RawMessage
+	// Schema is the reference or the JSON Schema of the values of json flags, which are dictionaries without one.
+	Schema json.RawMessage
+}
+
+type SatoriExperiment struct {
+	Name        string
+	Description string
+	Variants    []SatoriVariant
+	// Config is the reference or the JSON Schema of the values of the variants, when they are JSON objects.
+	Config json.RawMessage
+}
+
+// SatoriVariant is a variant of an experiment, and the value the server sends for it, its name by default.
+type SatoriVariant struct {
+	Name  string
+	Value string
+}
+
+func (v *SatoriVariant) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &v.Name); err == nil {
+		return nil
+	}
+	type variant SatoriVariant
+	return json.Unmarshal(data, (*variant)(v))
+}
+
+type SatoriLiveEvent struct {
+	Name        string
+	Description string
+	// Config is the reference or the JSON Schema of the value of the live event, a dictionary without one.
+	Config json.RawMessage
+}
+
+// SatoriField is a flag, an experiment or a live event of a SatoriManifest, as a property of the class of its kind.
+type SatoriField struct {
+	Name string
+	// Key is the name of the flag, experiment or live event in Satori.
+	Key         string
+	Description string
+	// Type is the GDScript type of a flag, and Kind how its value is parsed: bool, int, float, string or json.
+	Type    string
+	Kind    string
+	Default string
+	// Class is the class of the JSON objects of a flag, an experiment or a live event, empty for dictionaries.
+	Class string
+	// Pascal is the name in Pascal case, of the enum of the variants of an experiment or the class of a live event.
+	Pascal string
+	// Variants is the values of the variants of an experiment.
+	Variants []SchemaConstant
+}
+
+// ClassType is the GDScript type of the JSON objects.
+func (f SatoriField) ClassType() string {
+	if f.Class == "" {
+		return "Dictionary"
+	}
+	return f.Class
+}
+
+// satoriFlagTypes are the GDScript types of the flags.
+var satoriFlagTypes = map[string]string{"bool": "bool", "int": "int", "float": "float", "string": "String", "json": "Dictionary"}
+
+// satoriFields validates a Satori manifest, read from dir, and returns the classes and enums of its schemas, then
+// its flags, experiments and live events.
+func satoriFields(manifest SatoriManifest, dir string) (classes []PayloadClass, enums []SchemaEnum, flags, experiments, events []SatoriField, err error) {
+	set := newJSONSchemaSet()
+	if manifest.Schemas != "" {
+		if set, err = loadJSONSchemas(filepath.Join(dir, manifest.Schemas)); err != nil {
+			return nil, nil, nil, nil, nil, err
+		}
+	}
+	fail := func(format string, args ...interface{}) ([]PayloadClass, []SchemaEnum, []SatoriField, []SatoriField, []SatoriField, error) {
+		return nil, nil, nil, nil, nil, fmt.Errorf(format, args...)
+	}
+	// The schemas written inline, by the name of their flag, experiment or live event.
+	inline := map[string]*JSONSchema{}
+	names := map[string]bool{}
+	field := func(kind, name, description string) (SatoriField, error) {
+		f := SatoriField{Name: payloadName(name), Key: name, Description: description}
+		if name == "" || names[kind+" "+f.Name] {
+			return f, fmt.Errorf("%s name %q is empty or not unique", kind, name)
+		}
+		names[kind+" "+f.Name] = true
+		return f, nil
+	}
+
+	for _, flag := range manifest.Flags {
+		f, err := field("flag", flag.Name, flag.Description)
+		if err != nil {
+			return fail("%s", err)
+		}
+		if f.Type = satoriFlagTypes[flag.Type]; f.Type == "" {
+			return fail("flag %s: type %q is not bool, int, float, string or json", flag.Name, flag.Type)
+		}
+		f.Kind = flag.Type
+		f.Default = map[string]string{"bool": "false", "int": "0", "float": "0.0", "string": `""`, "json": "{}"}[f.Kind]
+		if len(flag.Default) > 0 {
+			var ok bool
+			switch v := jsonValue(flag.Default).(type) {
+			case bool:
+				ok = f.Kind == "bool"
+			case json.Number:
+				ok = f.Kind == "float" || f.Kind == "int" && !strings.ContainsAny(v.String(), ".eE")
+			case string:
+				ok = f.Kind == "string"
+			case map[string]interface{}:
+				ok = f.Kind == "json"
+			}
+			if !ok {
+				return fail("flag %s: default %s is not of type %s", flag.Name, flag.Default, f.Kind)
+			}
+			f.Default = gdLiteral(flag.Default, f.Type)
+		}
+		if f.Kind == "json" {
+			if inline["flag "+f.Name], err = set.addInline(flag.Schema, schemaClassName(flag.Name)+"Flag"); err != nil {
+				return fail("schema of flag %s: %s", flag.Name, err)
+			}
+		} else if len(flag.Schema) > 0 {
+			return fail("flag %s: only json flags have a schema", flag.Name)
+		}
+		flags = append(flags, f)
+	}
+
+	for _, experiment := range manifest.Experiments {
+		f, err := field("experiment", experiment.Name, experiment.Description)
+		if err != nil {
+			return fail("%s", err)
+		}
+		f.Pascal = schemaClassName(experiment.Name)
+		values := map[string]bool{}
+		for _, variant := range experiment.Variants {
+			constant := strings.ToUpper(payloadName(variant.Name))
+			if variant.Value == "" {
+				variant.Value = variant.Name
+			}
+			if variant.Name == "" || constant == "NONE" || values[constant] || values["value "+variant.Value] {
+				return fail("experiment %s: variant %q is empty, NONE, or not unique", experiment.Name, variant.Name)
+			}
+			values[constant], values["value "+variant.Value] = true, true
+			f.Variants = append(f.Variants, SchemaConstant{constant, gdString(variant.Value)})
+		}
+		if len(f.Variants) == 0 {
+			return fail("experiment %s has no variants", experiment.Name)
+		}
+		if inline["experiment "+f.Name], err = set.addInline(experiment.Config, f.Pascal+"Config"); err != nil {
+			return fail("config of experiment %s: %s", experiment.Name, err)
+		}
+		experiments = append(experiments, f)
+	}
+
+	for _, event := range manifest.LiveEvents {
+		f, err := field("live event", event.Name, event.Description)
+		if err != nil {
+			return fail("%s", err)
+		}
+		f.Pascal = schemaClassName(event.Name)
+		if inline["live event "+f.Name], err = set.addInline(event.Config, f.Pascal+"Config"); err != nil {
+			return fail("config of live event %s: %s", event.Name, err)
+		}
+		events = append(events, f)
+	}
+
+	classes, enums = set.classes()
+	for i := range flags {
+		if flags[i].Kind == "json" {
+			if flags[i].Class, err = set.valueClass(manifest.Flags[i].Schema, inline["flag "+flags[i].Name]); err != nil {
+				return fail("schema of flag %s: %s", flags[i].Key, err)
+			}
+		}
+	}
+	for i := range experiments {
+		if experiments[i].Class, err = set.valueClass(manifest.Experiments[i].Config, inline["experiment "+experiments[i].Name]); err != nil {
+			return fail("config of experiment %s: %s", experiments[i].Key, err)
+		}
+	}
+	for i := range events {
+		if events[i].Class, err = set.valueClass(manifest.LiveEvents[i].Config, inline["live event "+events[i].Name]); err != nil {
+			return fail("config of live event %s: %s", events[i].Key, err)
+		}
+	}
+	var roots []string
+	for _, fields := range [][]SatoriField{flags, experiments, events} {
+		for _, f := range fields {
+			roots = append(roots, f.Class)
+		}
+	}
+	classes, enums = usedClasses(classes, enums, roots)
+	taken := map[string]bool{"Flags": true, "Experiments": true, "LiveEvent": true, "LiveEvents": true}
+	for i := range classes {
+		if taken[classes[i].Name] {
+			return fail("class %s of a schema is also a class of the flags, experiments or live events", classes[i].Name)
+		}
+		taken[classes[i].Name] = true
+		classes[i].Extends = "SatoriAsyncResult"
 	}
-	return
-}
-
-func pascalToCamel(input string) (camelCase string) {
-	if input == "" {
-		return ""
+	for _, enum := range enums {
+		taken[enum.Name] = true
 	}
-
-	camelCase = strings.ToLower(string(input[0]))
-	camelCase += string(input[1:])
-	return camelCase
+	for _, f := range experiments {
+		if taken[f.Pascal] {
+			return fail("enum %s of experiment %s is also a class of a schema, or of another experiment", f.Pascal, f.Key)
+		}
+		taken[f.Pascal] = true
+	}
+	for _, f := range events {
+		if taken[f.Pascal+"Event"] {
+			return fail("class %sEvent of live event %s is also the one of a schema, or of an experiment", f.Pascal, f.Key)
+		}
+		taken[f.Pascal+"Event"] = true
+	}
+	return classes, enums, flags, experiments, events, nil
 }
 
-func camelToPascal(camelCase string) (pascalCase string) {
-
-	if len(camelCase) <= 0 {
-		return ""
-	}
+// SatoriTaxonomy is the events a game sends to Satori, with the keys and the types of their metadata.
+type SatoriTaxonomy struct {
+	Events []TaxonomyEvent
+}
 
-	pascalCase = strings.ToUpper(string(camelCase[0])) + camelCase[1:]
-	return
+type TaxonomyEvent struct {
+	Name        string
+	Description string
+	// Value is the type of the value of the event, empty when it has none.
+	Value    string
+	Metadata []TaxonomyMetadata
 }
 
-func enumSummary(def Definition) string {
-	// quirk of swagger generation: if enum doesn't have a title
-	// then the title can be found as the first entry in the split description.
-	if def.Title != "" {
-		return def.Title
-	}
+type TaxonomyMetadata struct {
+	Key         string
+	Description string
+	// Type is bool, int, float or string, the type of the value before it is sent as a string.
+	Type     string
+	Required bool
+	// Enum is the values a string allows, all of them when empty.
+	Enum []string
+}
 
-	split := strings.Split(def.Description, "\n")
+// SatoriEvent is the constructor of the events of a name of a SatoriTaxonomy.
+type SatoriEvent struct {
+	Name        string
+	Key         string
+	Const       string
+	Description string
+	// Value is the GDScript type of the value, and ValueKind its type in the taxonomy, empty when it has none.
+	Value     string
+	ValueKind string
+	// Metadata is the required metadata, then the optional ones, in the order of the parameters of the constructor.
+	Metadata []SatoriMetadata
+}
 
-	if len(split) <= 0 {
-		panic("No newlines in enum description found.")
-	}
+// HasRequired is true when the event has required metadata.
+func (e SatoriEvent) HasRequired() bool {
+	return len(e.Metadata) > 0 && e.Metadata[0].Required
+}
 
-	return split[0]
+// ValueConstant is the TYPE_* constant of the type of the value.
+func (e SatoriEvent) ValueConstant() string {
+	return godotTypeConstants[e.Value]
 }
 
-func enumDescriptions(def Definition) (output []string) {
+type SatoriMetadata struct {
+	Name        string
+	Key         string
+	Description string
+	Type        string
+	Kind        string
+	Required    bool
+	// Enum is the GDScript array of the values a string allows, empty for all of them.
+	Enum string
+}
 
-	split := strings.Split(def.Description, "\n")
+// TypeConstant is the TYPE_* constant of the type of the metadata.
+func (m SatoriMetadata) TypeConstant() string {
+	return godotTypeConstants[m.Type]
+}
 
-	if len(split) <= 0 {
-		panic("No newlines in enum description found.")
+// Doc is the description of the parameter of the metadata.
+func (m SatoriMetadata) Doc() string {
+	doc := stripNewlines(m.Description)
+	if doc == "" {
+		doc = "The " + m.Key + " metadata."
 	}
-
-	if def.Title != "" {
-		return split
+	if m.Enum != "" {
+		doc += " One of " + m.Enum + "."
 	}
-
-	// quirk of swagger generation: if enum doesn't have a title
-	// then the title can be found as the first entry in the split description.
-	// so ignore for individual enum descriptions.
-	return split[2:]
+	if !m.Required {
+		doc += " Optional, a " + m.Type + ", or null to omit it."
+	}
+	return doc
 }
 
-type Definition struct {
-	Properties map[string]struct {
-		Type  string
-		Ref   string   `json:"$ref"` // used with object
-		Items struct { // used with type "array"
-			Type string
-			Ref  string `json:"$ref"`
+// satoriEventTypes are the GDScript types of the values and the metadata of the events.
+var satoriEventTypes = map[string]string{"bool": "bool", "int": "int", "float": "float", "string": "String"}
+
+// satoriEvents validates an event taxonomy and returns the constructors of its events.
+func satoriEvents(taxonomy SatoriTaxonomy) (events []SatoriEvent, err error) {
+	// The names of the constructors, which can not be the ones of the other functions of the class.
+	names := map[string]bool{"new": true, "validate": true, "event_async": true, "events_async": true,
+		"metadata": true, "required": true, "enums": true, "values": true}
+	for _, e := range taxonomy.Events {
+		event := SatoriEvent{Name: payloadName(e.Name), Key: e.Name, Description: e.Description}
+		event.Const = strings.ToUpper(event.Name)
+		if e.Name == "" || names[event.Name] || strings.HasPrefix(event.Name, "_") {
+			return nil, fmt.Errorf("event name %q is empty, reserved or not unique", e.Name)
 		}
-		AdditionalProperties struct {
-			Type string // used with type "map"
+		names[event.Name] = true
+		if e.Value != "" {
+			if event.Value = satoriEventTypes[e.Value]; event.Value == "" {
+				return nil, fmt.Errorf("event %s: value type %q is not bool, int, float or string", e.Name, e.Value)
+			}
+			event.ValueKind = e.Value
 		}
-		Format      string // used with type "boolean"
-		Description string
-	}
-	Enum        []string
-	Description string
-	// used only by enums
-	Title string
-}
-
-func godotClassUtils(p_name string) string {
-	if val, ok := utilities[p_name]; ok {
-		return val
+		params := map[string]bool{"value": e.Value != ""}
+		var required, optional []SatoriMetadata
+		for _, m := range e.Metadata {
+			metadata := SatoriMetadata{Name: payloadName(m.Key), Key: m.Key, Description: m.Description, Kind: m.Type, Required: m.Required}
+			if m.Key == "" || params[metadata.Name] {
+				return nil, fmt.Errorf("event %s: metadata key %q is empty, reserved or not unique", e.Name, m.Key)
+			}
+			params[metadata.Name] = true
+			if metadata.Type = satoriEventTypes[m.Type]; metadata.Type == "" {
+				return nil, fmt.Errorf("event %s: type %q of metadata %s is not bool, int, float or string", e.Name, m.Type, m.Key)
+			}
+			if len(m.Enum) > 0 {
+				if m.Type != "string" {
+					return nil, fmt.Errorf("event %s: metadata %s has an enum but is not a string", e.Name, m.Key)
+				}
+				var values []string
+				for _, v := range m.Enum {
+					values = append(values, gdString(v))
+				}
+				metadata.Enum = "[" + strings.Join(values, ", ") + "]"
+			}
+			if m.Required {
+				required = append(required, metadata)
+			} else {
+				optional = append(optional, metadata)
+			}
+		}
+		event.Metadata = append(required, optional...)
+		events = append(events, event)
 	}
-	return ""
-}
-
-func commentLines(description string) string {
-	lines := strings.Split(description, "\n")
-	for i, line := range lines {
-		lines[i] = "\t# " + line
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no events")
 	}
-	return strings.Join(lines, "\n")
+	return events, nil
 }
 
 func main() {
 	var output = flag.String("output", "", "Specify the output file for the generated code.")
+	var godotVersion = flag.String("godot-version", "4.0", "Minimum Godot version targeted by the generated code: 4.0, 4.2 (typed arrays) or 4.4 (typed arrays and dictionaries).")
+	flag.BoolVar(&optionObjects, "options", false, "Pass optional query parameters of each operation in a typed <Operation>Options object.")
+	var clientOverlay = flag.String("client", "", "Generate the high-level <class name>Client facade instead of the API, customized by this overlay file.")
+	var policyFile = flag.String("retry-policy", "", "Override which operations are retried and their timeouts with this JSON file.")
+	var rpcs = flag.Bool("rpcs", false, "The input is a Go file or the directory of a Nakama Go runtime module: generate typed wrappers of the RPCs it registers in <class name>Rpcs instead of an API.")
+	var fromGo = flag.Bool("from-go", false, "The input is a Go file or the directory of a Go package: generate the classes of its exported structs in <class name>Types instead of an API.")
+	var jsonSchema = flag.Bool("json-schema", false, "The input is a JSON Schema file, or a directory of them: generate the classes of its objects and the ones of its $defs in <class name>Types instead of an API.")
+	var storage = flag.Bool("storage", false, "The input is a manifest of storage collections: generate typed accessors of the collections, with the classes of the JSON Schema of their values, in <class name>Storage instead of an API.")
+	var notifications = flag.Bool("notifications", false, "The input is a notification registry: generate the codes, the classes of the content and a dispatcher of the notifications in <class name>Notifications instead of an API.")
+	var satori = flag.Bool("satori", false, "The input is a manifest of Satori flags, experiments and live events: generate typed accessors of their values, with the classes of the JSON Schema of their configs, in <class name>Satori instead of an API.")
+	var satoriEventTaxonomy = flag.Bool("satori-events", false, "The input is a taxonomy of Satori events: generate typed constructors of the events, and a validation of their metadata before they are sent, in <class name>Events instead of an API.")
+	var opcodes = flag.Bool("opcodes", false, "The input is an opcode schema: generate the typed match and party messages of <class name> instead of an API.")
+	var goPackage = flag.String("go-package", "", "With -opcodes, generate the messages and a runtime.Match in this Go package for a Nakama Go runtime module instead.")
+	flag.BoolVar(&strict, "strict", false, "Emit fully typed code which passes the untyped_declaration and unsafe_* warnings. Requires -godot-version 4.2 or newer.")
 	flag.Parse()
 
+	switch *godotVersion {
+	case "4.0":
+	case "4.2":
+		typedArrays = true
+	case "4.4":
+		typedArrays = true
+		typedDictionaries = true
+	default:
+		fmt.Printf("Unsupported Godot version: %s\n", *godotVersion)
+		return
+	}
+	if strict && !typedArrays {
+		fmt.Println("Error: -strict requires -godot-version 4.2 or newer, for typed loop variables.")
+		return
+	}
+
 	inputs := flag.Args()
 	if len(inputs) < 2 {
 		fmt.Println("Error: Missing required arguments.")
@@ -567,7 +6428,7 @@ func main() {
 			fmt.Println("  - class name not specified")
 		}
 		fmt.Println("\nCorrect Usage: go run main.go <input file> <class name> > <output file>")
-		fmt.Println("\nExample:\ngo run main.go \"/path/to/swagger.json\" \"ClassName\" > \"/path/to/output/ClassNameAPI.gd\"\n")
+		fmt.Println("\nExample:\ngo run main.go \"/path/to/swagger.json\" \"ClassName\" > \"/path/to/output/ClassNameAPI.gd\"")
 
 		return
 	}
@@ -575,6 +6436,62 @@ func main() {
 	input := inputs[0]
 	className := inputs[1]
 
+	if *fromGo {
+		src, err := parseGoSource(input)
+		if err != nil {
+			fmt.Printf("Unable to parse Go source %s : %s\n", input, err)
+			return
+		}
+		classes, err := src.payloadClasses(src.exportedStructs())
+		if err != nil {
+			fmt.Printf("Unable to convert Go types of %s : %s\n", input, err)
+			return
+		}
+		render(input, strings.Replace(goTypesTemplate, "{{.ClassName}}", className, -1)+payloadClassTemplate, payloadFuncs, struct{ Classes []PayloadClass }{classes}, *output, false)
+		return
+	}
+
+	if *jsonSchema {
+		set, err := loadJSONSchemas(input)
+		if err != nil {
+			fmt.Printf("Unable to read JSON Schema %s : %s\n", input, err)
+			return
+		}
+		classes, enums := set.classes()
+		render(input, strings.Replace(jsonSchemaTemplate, "{{.ClassName}}", className, -1)+payloadClassTemplate, payloadFuncs, struct {
+			Classes []PayloadClass
+			Enums   []SchemaEnum
+		}{classes, enums}, *output, false)
+		return
+	}
+
+	if *rpcs {
+		src, err := parseGoSource(input)
+		if err != nil {
+			fmt.Printf("Unable to parse Go source %s : %s\n", input, err)
+			return
+		}
+		found, err := src.rpcs()
+		if err != nil {
+			fmt.Printf("Invalid RPC registration: %s\n", err)
+			return
+		}
+		var names []string
+		for _, rpc := range found {
+			names = append(names, rpc.Request, rpc.Response)
+		}
+		classes, err := src.payloadClasses(names)
+		if err != nil {
+			fmt.Printf("Unable to convert Go types of %s : %s\n", input, err)
+			return
+		}
+		render(input, strings.Replace(rpcTemplate, "{{.ClassName}}", className, -1)+payloadClassTemplate, payloadFuncs, struct {
+			Classes []PayloadClass
+			Rpcs    []GoRpc
+		}{classes, found}, *output, false)
+		return
+	}
+
 	var err error
 	var content []byte
 	if strings.HasPrefix(input, "https://") {
@@ -592,44 +6509,361 @@ func main() {
 		return
 	}
 
-	var schema struct {
-		Paths map[string]map[string]struct {
-			Summary     string
-			OperationId string
-			Responses   struct {
-				Ok struct {
-					Schema struct {
-						Ref string `json:"$ref"`
+	if *storage {
+		var manifest StorageManifest
+		if err := json.Unmarshal(content, &manifest); err != nil {
+			fmt.Printf("Unable to decode input %s : %s\n", input, err)
+			return
+		}
+		classes, enums, collections, err := storageClasses(manifest, filepath.Dir(input))
+		if err != nil {
+			fmt.Printf("Invalid storage manifest %s : %s\n", input, err)
+			return
+		}
+		render(input, strings.Replace(storageTemplate, "{{.ClassName}}", className, -1)+payloadClassTemplate, payloadFuncs, struct {
+			Classes     []PayloadClass
+			Enums       []SchemaEnum
+			Collections []StorageClass
+		}{classes, enums, collections}, *output, false)
+		return
+	}
+
+	if *notifications {
+		var registry NotificationRegistry
+		if err := json.Unmarshal(content, &registry); err != nil {
+			fmt.Printf("Unable to decode input %s : %s\n", input, err)
+			return
+		}
+		classes, enums, found, err := notificationClasses(registry, filepath.Dir(input))
+		if err != nil {
+			fmt.Printf("Invalid notification registry %s : %s\n", input, err)
+			return
+		}
+		render(input, strings.Replace(notificationTemplate, "{{.ClassName}}", className, -1)+payloadClassTemplate, payloadFuncs, struct {
+			Classes       []PayloadClass
+			Enums         []SchemaEnum
+			Notifications []NotificationClass
+		}{classes, enums, found}, *output, false)
+		return
+	}
+
+	if *satori {
+		var manifest SatoriManifest
+		if err := json.Unmarshal(content, &manifest); err != nil {
+			fmt.Printf("Unable to decode input %s : %s\n", input, err)
+			return
+		}
+		classes, enums, flags, experiments, events, err := satoriFields(manifest, filepath.Dir(input))
+		if err != nil {
+			fmt.Printf("Invalid Satori manifest %s : %s\n", input, err)
+			return
+		}
+		render(input, strings.Replace(satoriTemplate, "{{.ClassName}}", className, -1)+payloadClassTemplate, payloadFuncs, struct {
+			Classes     []PayloadClass
+			Enums       []SchemaEnum
+			Flags       []SatoriField
+			Experiments []SatoriField
+			LiveEvents  []SatoriField
+		}{classes, enums, flags, experiments, events}, *output, false)
+		return
+	}
+
+	if *satoriEventTaxonomy {
+		var taxonomy SatoriTaxonomy
+		if err := json.Unmarshal(content, &taxonomy); err != nil {
+			fmt.Printf("Unable to decode input %s : %s\n", input, err)
+			return
+		}
+		events, err := satoriEvents(taxonomy)
+		if err != nil {
+			fmt.Printf("Invalid event taxonomy %s : %s\n", input, err)
+			return
+		}
+		render(input, strings.Replace(satoriEventsTemplate, "{{.ClassName}}", className, -1), template.FuncMap{
+			"stripNewlines": stripNewlines,
+		}, struct {
+			Events []SatoriEvent
+		}{events}, *output, false)
+		return
+	}
+
+	if *opcodes {
+		var opcodeSchema OpcodeSchema
+		if err := json.Unmarshal(content, &opcodeSchema); err != nil {
+			fmt.Printf("Unable to decode input %s : %s\n", input, err)
+			return
+		}
+		messages, err := opcodeMessages(opcodeSchema)
+		if err != nil {
+			fmt.Printf("Invalid opcode schema %s : %s\n", input, err)
+			return
+		}
+		if *goPackage != "" {
+			render(input, goMatchTemplate, template.FuncMap{
+				"stripNewlines": stripNewlines,
+			}, goOpcodeFile(messages, *goPackage, className), *output, true)
+			return
+		}
+		render(input, strings.Replace(opcodeTemplate, "{{.ClassName}}", className, -1), template.FuncMap{
+			"stripNewlines": stripNewlines,
+			"hasDelta": func(classes []OpcodeClass) bool {
+				for _, class := range classes {
+					if class.Delta {
+						return true
 					}
-				} `json:"200"`
+				}
+				return false
+			},
+		}, messages, *output, false)
+		return
+	}
+
+	var schema struct {
+		Paths       map[string]map[string]Operation
+		Definitions map[string]Definition
+	}
+
+	if err := json.Unmarshal(content, &schema); err != nil {
+		fmt.Printf("Unable to decode input %s : %s\n", input, err)
+		return
+	}
+
+	isRefToEnum := func(ref string) bool {
+		if len(ref) == 0 {
+			return false
+		}
+		// swagger schema definition keys have inconsistent casing
+		var camelOk bool
+		var pascalOk bool
+		var enums []string
+
+		asCamel := pascalToCamel(ref)
+		if _, camelOk = schema.Definitions[asCamel]; camelOk {
+			enums = schema.Definitions[asCamel].Enum
+		}
+
+		asPascal := camelToPascal(ref)
+		if _, pascalOk = schema.Definitions[asPascal]; pascalOk {
+			enums = schema.Definitions[asPascal].Enum
+		}
+
+		if !pascalOk && !camelOk {
+			fmt.Printf("no definition found: %v", ref)
+			return false
+		}
+
+		return len(enums) > 0
+	}
+
+	// propType returns the Godot type of a property, with typed collections when they are enabled.
+	propType := func(p Property) string {
+		if p.Type == "object" {
+			return godotMapType(p.AdditionalProperties.Type)
+		}
+		return godotType(p.Type, p.Ref, p.Items.Type, p.Items.Ref, isRefToEnum(convertRefToClassName(p.Ref)))
+	}
+
+	// fieldKind classifies a property by how it has to be walked when comparing, copying or converting it.
+	fieldKind := func(p Property) string {
+		switch {
+		case p.Ref != "" && isRefToEnum(convertRefToClassName(p.Ref)):
+			return "scalar"
+		case p.Ref != "":
+			return "object"
+		case p.Type == "array" && p.Items.Ref != "" && !isRefToEnum(convertRefToClassName(p.Items.Ref)):
+			return "object_array"
+		case p.Type == "array":
+			return "array"
+		case p.Type == "object":
+			return "map"
+		}
+		return "scalar"
+	}
+
+	// propSetter returns the setter of a property.
+	propSetter := func(p Property, fieldname string) ClassSetter {
+		setter := ClassSetter{Field: "_" + fieldname, Label: fieldname, Kind: fieldKind(p), JSONType: p.Type}
+		switch {
+		case p.Ref != "" && isRefToEnum(convertRefToClassName(p.Ref)):
+			setter.Kind, setter.Class = "enum", convertRefToClassName(p.Ref)
+			setter.Values = setter.Class + ".values()"
+		case setter.Kind == "object_array":
+			setter.Class, setter.Lazy = convertRefToClassName(p.Items.Ref), true
+		case setter.Kind == "array":
+			setter.JSONType = p.Items.Type
+			switch {
+			case p.Items.Type == "string":
+				setter.ArrayDecl = " := PackedStringArray()"
+			case p.Items.Type == "integer" && typedArrays:
+				setter.ArrayDecl = " := PackedInt64Array()"
+			case p.Items.Type == "integer", p.Items.Type == "boolean" && !typedArrays:
+				setter.ArrayDecl = " := PackedInt32Array()"
+			case p.Items.Type == "boolean":
+				setter.ArrayDecl = " : Array[bool] = []"
+			case p.Items.Type == "number" && typedArrays:
+				setter.ArrayDecl = " := PackedFloat64Array()"
+			default:
+				setter.ArrayDecl = " := []"
 			}
-			Parameters []struct {
-				Name     string
-				In       string
-				Required bool
-				Type     string   // used with primitives
-				Items    struct { // used with type "array"
-					Type string
+		case setter.Kind == "map":
+			setter.JSONType = p.AdditionalProperties.Type
+		}
+		return setter
+	}
+
+	// The response of the session refresh operation, if the API has one.
+	refreshResponse := func() string {
+		for _, path := range schema.Paths {
+			for _, operation := range path {
+				if strings.HasSuffix(operation.OperationId, "Refresh") {
+					return convertRefToClassName(operation.Responses.Ok.Schema.Ref)
 				}
-				Schema struct { // used with http body
-					Type string
-					Ref  string `json:"$ref"`
+			}
+		}
+		return className + "AsyncResult"
+	}
+
+	// Detect list operations which return a page of items and a cursor to the next page.
+	pagination := func(op Operation) (out Pagination) {
+		response, ok := schema.Definitions[strings.TrimPrefix(op.Responses.Ok.Schema.Ref, "#/definitions/")]
+		if !ok {
+			return
+		}
+		names := make([]string, 0, len(response.Properties))
+		for name := range response.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// The spec names the properties in snake case, and older ones in camel case.
+	next:
+		for _, next := range []string{"next_cursor", "cursor", "cacheable_cursor"} {
+			for _, name := range names {
+				if pascalToSnake(name) == next && response.Properties[name].Type == "string" {
+					out.Next = next
+					break next
 				}
-				Format string // used with type "boolean"
 			}
-			Security []map[string][]struct {
+		}
+		for _, name := range names {
+			// Owner records are repeated on every page of leaderboard and tournament records.
+			if fieldKind(response.Properties[name]) == "object_array" && !strings.HasPrefix(name, "owner") {
+				out.Items = pascalToSnake(name)
+				break
 			}
 		}
-		Definitions map[string]Definition
-	}
+		if out.Next == "" || out.Items == "" {
+			return
+		}
 
-	if err := json.Unmarshal(content, &schema); err != nil {
-		fmt.Printf("Unable to decode input %s : %s\n", input, err)
+		var args []string
+		if len(op.Security) > 0 {
+			for key := range op.Security[0] {
+				switch key {
+				case "BasicAuth":
+					args = append(args, "p_basic_auth_username", "p_basic_auth_password")
+				case "HttpKeyAuth":
+					args = append(args, "p_bearer_token")
+				}
+			}
+		} else {
+			args = append(args, "p_session")
+		}
+		for _, p := range op.Parameters {
+			name := pascalToSnake(p.Name)
+			switch {
+			case p.In == "query" && (name == "cursor" || name == "cacheable_cursor"):
+				out.Cursor = name
+				if isOption(p) {
+					out.Carrier = "options"
+					args = append(args, "options")
+				} else {
+					args = append(args, "cursor")
+				}
+			case p.In == "body" && p.Schema.Ref != "":
+				body := schema.Definitions[strings.TrimPrefix(p.Schema.Ref, "#/definitions/")]
+				if _, ok := body.Properties["cursor"]; ok {
+					out.Cursor = "cursor"
+					out.Carrier = "body"
+					out.Body = prependParameter(p.Name)
+					args = append(args, "body")
+				} else {
+					args = append(args, prependParameter(p.Name))
+				}
+			case !isOption(p):
+				args = append(args, prependParameter(p.Name))
+			}
+		}
+		if out.Carrier != "options" && len(optionalParams(op)) > 0 {
+			args = append(args, "p_options")
+		}
+		for _, p := range optionalParams(op) {
+			out.OptionFields = append(out.OptionFields, pascalToSnake(p.Name))
+		}
+		if out.Cursor == "" {
+			return
+		}
+		out.Ok = true
+		args = append(args, "p_cancel_token")
+		out.Args = strings.Join(args, ", ")
+		out.Response = convertRefToClassName(op.Responses.Ok.Schema.Ref)
+		out.Options = optionsClass(op)
 		return
 	}
 
+	var policies RequestPolicies
+	if *policyFile != "" {
+		policyContent, err := os.ReadFile(*policyFile)
+		if err != nil {
+			fmt.Printf("Unable to read file: %s\n", err)
+			return
+		}
+		if err := json.Unmarshal(policyContent, &policies); err != nil {
+			fmt.Printf("Unable to decode retry policy: %s\n", err)
+			return
+		}
+	}
+
+	// The extra send_async() arguments of an operation: whether it may be retried and its timeout.
+	// Idempotent HTTP methods are retried, then x-retryable and x-timeout apply, then the policy file.
+	requestPolicy := func(method string, op Operation) string {
+		policy := RequestPolicy{Retryable: op.Retryable, Timeout: op.Timeout}
+		id := op.OperationId[7:]
+		var keys []string
+		for key := range policies.Operations {
+			if matched, _ := filepath.Match(key, id); matched && key != id {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		if _, ok := policies.Operations[id]; ok {
+			keys = append(keys, id)
+		}
+		for _, key := range keys {
+			p := policies.Operations[key]
+			if p.Retryable != nil {
+				policy.Retryable = p.Retryable
+			}
+			if p.Timeout != 0 {
+				policy.Timeout = p.Timeout
+			}
+		}
+		retryable := idempotentMethods[strings.ToLower(method)]
+		if policy.Retryable != nil {
+			retryable = *policy.Retryable
+		}
+		switch {
+		case policy.Timeout > 0:
+			return fmt.Sprintf(", %t, %d", retryable, policy.Timeout)
+		case !retryable:
+			return ", false"
+		}
+		return ""
+	}
+
 	fmap := template.FuncMap{
 		"commentLines":     commentLines,
+		"coerceValue":      coerceValue,
+		"enumValueDoc":     enumValueDoc,
 		"hasSuffix":        strings.HasSuffix,
 		"cleanRef":         convertRefToClassName,
 		"stripNewlines":    stripNewlines,
@@ -639,53 +6873,103 @@ func main() {
 		"pascalToSnake":    pascalToSnake,
 		"apiFuncName":      apiFuncName,
 		"godotType":        godotType,
+		"propType":         propType,
+		"isTypedContainer": isTypedContainer,
+		"typedArrays":      func() bool { return typedArrays },
 		"godotLooseType":   godotLooseType,
 		"godotSchemaType":  godotSchemaType,
 		"godotDef":         godotDef,
-		"isRefToEnum": func(ref string) bool {
-			if len(ref) == 0 {
-				return false
-			}
-			// swagger schema definition keys have inconsistent casing
-			var camelOk bool
-			var pascalOk bool
-			var enums []string
-
-			asCamel := pascalToCamel(ref)
-			if _, camelOk = schema.Definitions[asCamel]; camelOk {
-				enums = schema.Definitions[asCamel].Enum
-			}
-
-			asPascal := camelToPascal(ref)
-			if _, pascalOk = schema.Definitions[asPascal]; pascalOk {
-				enums = schema.Definitions[asPascal].Enum
-			}
-
-			if !pascalOk && !camelOk {
-				fmt.Printf("no definition found: %v", ref)
-				return false
-			}
-
-			return len(enums) > 0
-		},
+		"isRefToEnum":      isRefToEnum,
+		"fieldKind":        fieldKind,
+		"propSetter":       propSetter,
+		"strict":           func() bool { return strict },
+		"optionObjects":    func() bool { return optionObjects },
+		"decl":             decl,
+		"refreshResponse":  refreshResponse,
+		"isOption":         isOption,
+		"optionalParams":   optionalParams,
+		"optionsClass":     optionsClass,
+		"pagination":       pagination,
+		"requestPolicy":    requestPolicy,
 		"enumDescriptions": enumDescriptions,
 		"enumSummary":      enumSummary,
 		"godotClassUtils":  godotClassUtils,
+		"trimPrefix":       func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	}
+
+	codeTemplate := strings.Replace(codeTemplate, "{{.ClassName}}", className, -1) + classSetterTemplate
+	var data interface{} = schema
+	if *clientOverlay != "" {
+		var overlay Overlay
+		overlayContent, err := os.ReadFile(*clientOverlay)
+		if err != nil {
+			fmt.Printf("Unable to read file: %s\n", err)
+			return
+		}
+		if err := json.Unmarshal(overlayContent, &overlay); err != nil {
+			fmt.Printf("Unable to decode overlay: %s\n", err)
+			return
+		}
+		var extra []byte
+		if overlay.Extra != "" {
+			if extra, err = os.ReadFile(filepath.Join(filepath.Dir(*clientOverlay), overlay.Extra)); err != nil {
+				fmt.Printf("Unable to read file: %s\n", err)
+				return
+			}
+		}
+		// The hand-written part is a template too, to follow -options and -strict.
+		tmpl, err := template.New(overlay.Extra).Funcs(fmap).Parse(string(extra))
+		if err != nil {
+			fmt.Printf("Template parse error: %s\n", err)
+			return
+		}
+		var source bytes.Buffer
+		if err := tmpl.Execute(&source, nil); err != nil {
+			fmt.Printf("Template execution error: %s\n", err)
+			return
+		}
+		preamble, written := clientExtra(source.String())
+		data = struct {
+			Preamble string
+			Methods  []ClientMethod
+		}{
+			Preamble: preamble,
+			Methods:  mergeMethods(clientMethods(schema.Paths, schema.Definitions, overlay, className, propType), written),
+		}
+		codeTemplate = strings.Replace(clientTemplate, "{{.ClassName}}", className, -1)
 	}
 
-	codeTemplate := strings.Replace(codeTemplate, "{{.ClassName}}", className, -1)
-	tmpl, err := template.New(input).Funcs(fmap).Parse(codeTemplate)
+	render(input, codeTemplate, fmap, data, *output, false)
+}
+
+// render executes a template to the output file, or to stdout when no output file is given.
+// The code is formatted with gofmt when goSource is set.
+func render(name, text string, fmap template.FuncMap, data interface{}, output string, goSource bool) {
+	tmpl, err := template.New(name).Funcs(fmap).Parse(text)
 	if err != nil {
 		fmt.Printf("Template parse error: %s\n", err)
 		return
 	}
 
-	if len(*output) < 1 {
-		tmpl.Execute(os.Stdout, schema)
+	var code bytes.Buffer
+	if err := tmpl.Execute(&code, data); err != nil {
+		fmt.Printf("Template execution error: %s\n", err)
+		return
+	}
+	content := code.Bytes()
+	if goSource {
+		if content, err = format.Source(content); err != nil {
+			fmt.Printf("Generated Go code is invalid: %s\n", err)
+			return
+		}
+	}
+
+	if len(output) < 1 {
+		os.Stdout.Write(content)
 		return
 	}
 
-	f, err := os.Create(*output)
+	f, err := os.Create(output)
 	if err != nil {
 		fmt.Printf("Unable to create file: %s\n", err)
 		return
@@ -693,6 +6977,6 @@ func main() {
 	defer f.Close()
 
 	writer := bufio.NewWriter(f)
-	tmpl.Execute(writer, schema)
+	writer.Write(content)
 	writer.Flush()
 }
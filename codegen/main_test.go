@@ -0,0 +1,1642 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"text/template"
+	"unicode/utf8"
+)
+
+// testOpcodeSchema has a field of every kind of the binary encoding.
+const testOpcodeSchema = `{
+  "types": {
+    "Point": {
+      "fields": [
+        {"name": "x", "type": "float", "min": -10, "max": 10, "precision": 0.001},
+        {"name": "y", "type": "float", "min": 0, "max": 1, "bits": 5},
+        {"name": "label", "type": "string", "optional": true}
+      ]
+    },
+    "Node": {
+      "fields": [
+        {"name": "id", "type": "int", "min": -3, "max": 12},
+        {"name": "children", "type": "Node", "repeated": true}
+      ]
+    }
+  },
+  "opcodes": [
+    {
+      "code": 1,
+      "name": "Everything",
+      "encoding": "binary",
+      "delta": true,
+      "fields": [
+        {"name": "flag", "type": "bool"},
+        {"name": "count", "type": "int"},
+        {"name": "mask", "type": "int", "bits": 62},
+        {"name": "level", "type": "int", "min": 1, "max": 100, "optional": true},
+        {"name": "ratio", "type": "float"},
+        {"name": "angle", "type": "float", "min": 0, "max": 360, "bits": 10, "optional": true},
+        {"name": "name", "type": "string"},
+        {"name": "blob", "type": "bytes", "optional": true},
+        {"name": "at", "type": "Point"},
+        {"name": "path", "type": "Point", "repeated": true, "optional": true},
+        {"name": "scores", "type": "int", "repeated": true},
+        {"name": "tree", "type": "Node"}
+      ]
+    },
+    {
+      "code": 2,
+      "name": "Empty",
+      "encoding": "binary",
+      "fields": []
+    }
+  ]
+}`
+
+// opcodeCodec is the reference implementation of the binary encoding of the generated messages and of
+// NakamaBitStream, which the tests check the wire format with. Message values are maps of field names to bool,
+// int64, float64, string, []byte, a message value, or a []interface{} of them when repeated.
+type opcodeCodec struct {
+	messages map[string]OpcodeMessage
+}
+
+func newOpcodeCodec(schema OpcodeSchema) (*opcodeCodec, error) {
+	classes, err := opcodeMessages(schema)
+	if err != nil {
+		return nil, err
+	}
+	c := &opcodeCodec{messages: map[string]OpcodeMessage{}}
+	for _, class := range classes {
+		if class.Binary {
+			c.messages[class.Name] = class.OpcodeMessage
+		}
+	}
+	return c, nil
+}
+
+// encode returns the payload of a message, as the changes from baseline when it is not nil.
+func (c *opcodeCodec) encode(name string, value, baseline map[string]interface{}) []byte {
+	w := &bitWriter{}
+	c.write(w, name, value, baseline)
+	return w.bytes
+}
+
+// decode reads a payload, as the changes from baseline when it is not nil.
+func (c *opcodeCodec) decode(name string, payload []byte, baseline map[string]interface{}) (map[string]interface{}, error) {
+	r := &bitReader{bytes: payload}
+	value := c.read(r, name, baseline)
+	if r.failed {
+		return nil, fmt.Errorf("the payload of %s is too short", name)
+	}
+	return value, nil
+}
+
+func (c *opcodeCodec) write(w *bitWriter, name string, value, baseline map[string]interface{}) {
+	for _, f := range c.messages[name].Fields {
+		v := value[f.Name]
+		if v == nil {
+			v = c.zero(f)
+		}
+		if baseline != nil && !w.writeFlag(!reflect.DeepEqual(v, baseline[f.Name])) {
+			continue
+		}
+		if f.Optional && !w.writeFlag(!reflect.DeepEqual(v, c.zero(f))) {
+			continue
+		}
+		if !f.Repeated {
+			c.writeElement(w, f, v)
+			continue
+		}
+		elements := v.([]interface{})
+		w.writeUint(uint64(len(elements)))
+		for _, element := range elements {
+			c.writeElement(w, f, element)
+		}
+	}
+}
+
+func (c *opcodeCodec) writeElement(w *bitWriter, f OpcodeField, v interface{}) {
+	p, _ := f.packing()
+	switch f.Type {
+	case "bool":
+		w.writeFlag(v.(bool))
+	case "int":
+		if p.Bits == 0 {
+			n := v.(int64)
+			w.writeUint(uint64(n<<1) ^ uint64(n>>63))
+			break
+		}
+		low := int64(p.Min)
+		w.write(uint64(max(low, min(low+int64(p.Steps), v.(int64)))-low), p.Bits)
+	case "float":
+		if p.Bits == 0 {
+			w.write(math.Float64bits(v.(float64)), 64)
+			break
+		}
+		step := 0.0
+		if x := v.(float64); !math.IsNaN(x) {
+			step = math.Max(0, math.Min(math.Round((x-p.Min)/p.Precision), float64(p.Steps)))
+		}
+		w.write(uint64(step), p.Bits)
+	case "string":
+		w.writeBytes([]byte(v.(string)))
+	case "bytes":
+		w.writeBytes(v.([]byte))
+	default:
+		// A nil message is written as a new one.
+		m, _ := v.(map[string]interface{})
+		c.write(w, f.Type, m, nil)
+	}
+}
+
+func (c *opcodeCodec) read(r *bitReader, name string, baseline map[string]interface{}) map[string]interface{} {
+	value := map[string]interface{}{}
+	for _, f := range c.messages[name].Fields {
+		value[f.Name] = c.zero(f)
+		if baseline != nil && !r.readFlag() {
+			value[f.Name] = baseline[f.Name]
+			continue
+		}
+		if f.Optional && !r.readFlag() {
+			continue
+		}
+		if !f.Repeated {
+			value[f.Name] = c.readElement(r, f)
+			continue
+		}
+		count := r.readCount()
+		elements := []interface{}{}
+		for i := uint64(0); i < count; i++ {
+			elements = append(elements, c.readElement(r, f))
+		}
+		value[f.Name] = elements
+	}
+	return value
+}
+
+func (c *opcodeCodec) readElement(r *bitReader, f OpcodeField) interface{} {
+	p, _ := f.packing()
+	switch f.Type {
+	case "bool":
+		return r.readFlag()
+	case "int":
+		if p.Bits == 0 {
+			n := r.readUint()
+			return int64(n>>1) ^ -int64(n&1)
+		}
+		return int64(p.Min) + int64(min(r.read(p.Bits), p.Steps))
+	case "float":
+		if p.Bits == 0 {
+			return math.Float64frombits(r.read(64))
+		}
+		step := r.read(p.Bits)
+		if step > p.Steps {
+			step = p.Steps
+		}
+		return p.Min + float64(step)*p.Precision
+	case "string":
+		return string(r.readBytes())
+	case "bytes":
+		return r.readBytes()
+	default:
+		return c.read(r, f.Type, nil)
+	}
+}
+
+// zero returns the default value of a field, which optional fields are not sent with.
+func (c *opcodeCodec) zero(f OpcodeField) interface{} {
+	if f.Repeated {
+		return []interface{}{}
+	}
+	switch f.Type {
+	case "bool":
+		return false
+	case "int":
+		return int64(0)
+	case "float":
+		return 0.0
+	case "string":
+		return ""
+	case "bytes":
+		return []byte{}
+	}
+	return map[string]interface{}(nil)
+}
+
+// bitWriter packs values least significant bit first, like NakamaBitStream.Writer.
+type bitWriter struct {
+	bytes []byte
+	bits  int
+}
+
+func (w *bitWriter) write(v uint64, count int) {
+	for i := 0; i < count; i++ {
+		if w.bits%8 == 0 {
+			w.bytes = append(w.bytes, 0)
+		}
+		w.bytes[w.bits/8] |= byte(v>>i&1) << (w.bits % 8)
+		w.bits++
+	}
+}
+
+func (w *bitWriter) writeFlag(v bool) bool {
+	if v {
+		w.write(1, 1)
+	} else {
+		w.write(0, 1)
+	}
+	return v
+}
+
+func (w *bitWriter) writeUint(v uint64) {
+	for v >= 0x80 {
+		w.write(v&0x7F|0x80, 8)
+		v >>= 7
+	}
+	w.write(v, 8)
+}
+
+func (w *bitWriter) writeBytes(v []byte) {
+	w.writeUint(uint64(len(v)))
+	for _, b := range v {
+		w.write(uint64(b), 8)
+	}
+}
+
+// bitReader unpacks values like NakamaBitStream.Reader: once a read goes past the end, it fails and reads zeros.
+type bitReader struct {
+	bytes  []byte
+	bit    int
+	failed bool
+}
+
+func (r *bitReader) remaining() int {
+	return len(r.bytes)*8 - r.bit
+}
+
+func (r *bitReader) read(count int) (v uint64) {
+	if r.failed || count > r.remaining() {
+		r.failed = true
+		return 0
+	}
+	for i := 0; i < count; i++ {
+		v |= uint64(r.bytes[r.bit/8]>>(r.bit%8)&1) << i
+		r.bit++
+	}
+	return v
+}
+
+func (r *bitReader) readFlag() bool {
+	return r.read(1) == 1
+}
+
+func (r *bitReader) readUint() (v uint64) {
+	for i := 0; i < 10; i++ {
+		group := r.read(8)
+		v |= (group & 0x7F) << (7 * i)
+		if group&0x80 == 0 {
+			return v
+		}
+	}
+	r.failed = true
+	return 0
+}
+
+func (r *bitReader) readCount() uint64 {
+	count := r.readUint()
+	if count > uint64(r.remaining()) {
+		r.failed = true
+		return 0
+	}
+	return count
+}
+
+func (r *bitReader) readBytes() []byte {
+	size := r.readUint()
+	if size > uint64(r.remaining()/8) {
+		r.failed = true
+		return []byte{}
+	}
+	out := make([]byte, size)
+	for i := range out {
+		out[i] = byte(r.read(8))
+	}
+	return out
+}
+
+func testOpcodeCodec(t testing.TB) *opcodeCodec {
+	var schema OpcodeSchema
+	if err := json.Unmarshal([]byte(testOpcodeSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+	codec, err := newOpcodeCodec(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return codec
+}
+
+// fuzzValues makes message values from the bytes of a fuzz input, and zeros once they run out.
+type fuzzValues struct {
+	data []byte
+}
+
+func (f *fuzzValues) byte() byte {
+	if len(f.data) == 0 {
+		return 0
+	}
+	b := f.data[0]
+	f.data = f.data[1:]
+	return b
+}
+
+func (f *fuzzValues) uint64() (v uint64) {
+	for i := 0; i < 8; i++ {
+		v = v<<8 | uint64(f.byte())
+	}
+	return v
+}
+
+func (f *fuzzValues) message(c *opcodeCodec, name string, depth int) map[string]interface{} {
+	value := map[string]interface{}{}
+	for _, field := range c.messages[name].Fields {
+		// Half of the fields keep their default value, so optional fields and changes are both covered.
+		if f.byte()%2 == 0 {
+			value[field.Name] = c.zero(field)
+			continue
+		}
+		if !field.Repeated {
+			value[field.Name] = f.element(c, field, depth)
+			continue
+		}
+		elements := []interface{}{}
+		count := f.byte() % 4
+		if depth >= 4 {
+			count = 0
+		}
+		for i := byte(0); i < count; i++ {
+			elements = append(elements, f.element(c, field, depth))
+		}
+		value[field.Name] = elements
+	}
+	return value
+}
+
+func (f *fuzzValues) element(c *opcodeCodec, field OpcodeField, depth int) interface{} {
+	switch field.Type {
+	case "bool":
+		return f.byte()%2 == 1
+	case "int":
+		return int64(f.uint64())
+	case "float":
+		if f.byte()%2 == 0 {
+			return float64(int8(f.byte())) / 7
+		}
+		return math.Float64frombits(f.uint64())
+	case "string", "bytes":
+		b := make([]byte, f.byte()%8)
+		for i := range b {
+			b[i] = f.byte()
+		}
+		if field.Type == "string" {
+			return string(b)
+		}
+		return b
+	}
+	if f.byte()%8 == 0 {
+		return map[string]interface{}(nil)
+	}
+	return f.message(c, field.Type, depth+1)
+}
+
+// checkDecoded reports the fields of a decoded message which are not the ones encoded, within the precision of
+// quantised floats and the range of ranged ints.
+func checkDecoded(t *testing.T, c *opcodeCodec, name string, encoded, decoded map[string]interface{}) {
+	for _, field := range c.messages[name].Fields {
+		want, got := encoded[field.Name], decoded[field.Name]
+		if field.Optional && reflect.DeepEqual(want, c.zero(field)) {
+			// An optional field with its default value is not sent, even when it is out of its range.
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s.%s: %v decoded, not its default %v", name, field.Name, got, want)
+			}
+			continue
+		}
+		if !field.Repeated {
+			checkElement(t, c, name, field, want, got)
+			continue
+		}
+		wants, gots := want.([]interface{}), got.([]interface{})
+		if len(wants) != len(gots) {
+			t.Fatalf("%s.%s: %d elements decoded, not %d", name, field.Name, len(gots), len(wants))
+		}
+		for i := range wants {
+			checkElement(t, c, name, field, wants[i], gots[i])
+		}
+	}
+}
+
+func checkElement(t *testing.T, c *opcodeCodec, name string, field OpcodeField, want, got interface{}) {
+	p, _ := field.packing()
+	switch field.Type {
+	case "int":
+		if p.Bits > 0 {
+			low, high := int64(p.Min), int64(p.Min)+int64(p.Steps)
+			want = max(low, min(high, want.(int64)))
+		}
+	case "float":
+		w, g := want.(float64), got.(float64)
+		switch {
+		case p.Bits == 0:
+			if math.Float64bits(w) != math.Float64bits(g) {
+				t.Fatalf("%s.%s: %v decoded, not %v", name, field.Name, g, w)
+			}
+		case math.IsNaN(w):
+			if g != p.Min {
+				t.Fatalf("%s.%s: NaN decoded as %v, not the min %v", name, field.Name, g, p.Min)
+			}
+		default:
+			high := p.Min + float64(p.Steps)*p.Precision
+			w = math.Max(p.Min, math.Min(high, w))
+			if math.Abs(w-g) > p.Precision/2*(1+1e-9) {
+				t.Fatalf("%s.%s: %v decoded, not within %v of %v", name, field.Name, g, p.Precision/2, w)
+			}
+		}
+		return
+	case "bool", "string":
+	case "bytes":
+		if !bytes.Equal(want.([]byte), got.([]byte)) {
+			t.Fatalf("%s.%s: %v decoded, not %v", name, field.Name, got, want)
+		}
+		return
+	default:
+		m, _ := want.(map[string]interface{})
+		if m == nil {
+			// A nil message is sent as a new one.
+			m = map[string]interface{}{}
+			for _, f := range c.messages[field.Type].Fields {
+				m[f.Name] = c.zero(f)
+			}
+		}
+		checkDecoded(t, c, field.Type, m, got.(map[string]interface{}))
+		return
+	}
+	if want != got {
+		t.Fatalf("%s.%s: %v decoded, not %v", name, field.Name, got, want)
+	}
+}
+
+// sameValues compares message values like GDScript does, where NaN is equal to itself and -0.0 to 0.0.
+func sameValues(a, b interface{}) bool {
+	switch a := a.(type) {
+	case float64:
+		b, ok := b.(float64)
+		return ok && (a == b || math.IsNaN(a) && math.IsNaN(b))
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !sameValues(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) || (a == nil) != (b == nil) {
+			return false
+		}
+		for k := range a {
+			if !sameValues(a[k], b[k]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+var fuzzSeeds = [][]byte{
+	{},
+	{1, 1, 0, 0, 0, 0, 0, 0, 0, 42},
+	bytes.Repeat([]byte{0xFF}, 64),
+	bytes.Repeat([]byte{1, 3, 0x80, 7}, 40),
+	[]byte("a fuzz seed which sets about half of the fields of the messages to some value"),
+}
+
+func FuzzOpcodeRoundTrip(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	c := testOpcodeCodec(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, name := range []string{"Everything", "Empty", "Point", "Node"} {
+			value := (&fuzzValues{data: data}).message(c, name, 0)
+			payload := c.encode(name, value, nil)
+			decoded, err := c.decode(name, payload, nil)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			checkDecoded(t, c, name, value, decoded)
+			// Decoded values are sent as they are, except quantised optional fields decoded as their default.
+			again := c.encode(name, decoded, nil)
+			redecoded, err := c.decode(name, again, nil)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			if last := c.encode(name, redecoded, nil); !bytes.Equal(again, last) {
+				t.Fatalf("%s: %x encoded again as %x", name, again, last)
+			}
+		}
+	})
+}
+
+func FuzzOpcodeDelta(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed, seed)
+		f.Add(seed, []byte{})
+	}
+	c := testOpcodeCodec(f)
+	f.Fuzz(func(t *testing.T, previous, next []byte) {
+		baseline := (&fuzzValues{data: previous}).message(c, "Everything", 0)
+		value := (&fuzzValues{data: next}).message(c, "Everything", 0)
+		// The receiver has the baseline as it decoded it.
+		received, err := c.decode("Everything", c.encode("Everything", baseline, nil), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		delta := c.encode("Everything", value, baseline)
+		decoded, err := c.decode("Everything", delta, received)
+		if err != nil {
+			t.Fatal(err)
+		}
+		full, err := c.decode("Everything", c.encode("Everything", value, nil), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The changes decode to the message sent in full.
+		if !sameValues(decoded, full) {
+			t.Fatalf("the changes from the baseline decode to %v, not %v", decoded, full)
+		}
+	})
+}
+
+func TestOpcodeDeltaSize(t *testing.T) {
+	c := testOpcodeCodec(t)
+	value := (&fuzzValues{data: bytes.Repeat([]byte{1, 3, 0x80, 7}, 40)}).message(c, "Everything", 0)
+	full := c.encode("Everything", value, nil)
+	// Only a flag per field is sent for a message which did not change, and the changed fields after theirs.
+	if delta := c.encode("Everything", value, value); len(delta) != 2 {
+		t.Errorf("a message of %d bytes is %d bytes as the changes from itself, not 2", len(full), len(delta))
+	}
+	changed := map[string]interface{}{}
+	for k, v := range value {
+		changed[k] = v
+	}
+	changed["flag"] = !value["flag"].(bool)
+	delta := c.encode("Everything", changed, value)
+	if len(delta) != 2 {
+		t.Errorf("a message with a changed flag is %d bytes as changes, not 2", len(delta))
+	}
+	decoded, err := c.decode("Everything", delta, value)
+	if err != nil || decoded["flag"] != changed["flag"] {
+		t.Errorf("the changed flag is decoded as %v, %v", decoded["flag"], err)
+	}
+}
+
+func FuzzOpcodeDecode(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	c := testOpcodeCodec(f)
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		// Any payload is decoded, or fails, without panicking or reading past its end.
+		for _, name := range []string{"Everything", "Node"} {
+			if value, err := c.decode(name, payload, nil); err == nil {
+				c.encode(name, value, nil)
+			}
+		}
+	})
+}
+
+func TestOpcodePacking(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	for _, test := range []struct {
+		field OpcodeField
+		want  opcodePacking
+		err   bool
+	}{
+		{field: OpcodeField{Type: "int"}},
+		{field: OpcodeField{Type: "float"}},
+		{field: OpcodeField{Type: "int", Min: ptr(-1), Max: ptr(1)}, want: opcodePacking{Min: -1, Steps: 2, Bits: 2}},
+		{field: OpcodeField{Type: "int", Bits: 8}, want: opcodePacking{Steps: 255, Bits: 8}},
+		{field: OpcodeField{Type: "float", Min: ptr(0), Max: ptr(1), Precision: 0.1}, want: opcodePacking{Precision: 0.1, Steps: 10, Bits: 4}},
+		{field: OpcodeField{Type: "float", Min: ptr(0), Max: ptr(3), Bits: 2}, want: opcodePacking{Precision: 1, Steps: 3, Bits: 2}},
+		{field: OpcodeField{Type: "int", Min: ptr(0), Max: ptr(1), Bits: 1}, err: true},
+		{field: OpcodeField{Type: "int", Min: ptr(0.5), Bits: 1}, err: true},
+		{field: OpcodeField{Type: "int", Bits: 63}, err: true},
+		{field: OpcodeField{Type: "float", Min: ptr(0), Max: ptr(1)}, err: true},
+		{field: OpcodeField{Type: "float", Min: ptr(1), Max: ptr(0), Bits: 4}, err: true},
+		{field: OpcodeField{Type: "float", Min: ptr(0), Max: ptr(1), Precision: 1e-20}, err: true},
+		{field: OpcodeField{Type: "string", Bits: 4}, err: true},
+	} {
+		got, err := test.field.packing()
+		if (err != nil) != test.err || err == nil && got != test.want {
+			t.Errorf("packing of %+v is %+v, %v, not %+v", test.field, got, err, test.want)
+		}
+	}
+}
+
+func TestOpcodeSchemaErrors(t *testing.T) {
+	for _, schema := range []string{
+		`{"opcodes": [{"code": 1, "name": "A", "delta": true}]}`,
+		`{"opcodes": [{"code": 1, "name": "A", "encoding": "xml"}]}`,
+		`{"opcodes": [{"code": 1, "name": "A", "sender": "player"}]}`,
+		`{"types": {"T": {"fields": [{"name": "t", "type": "T"}]}}, "opcodes": [{"code": 1, "name": "A", "encoding": "binary", "fields": [{"name": "t", "type": "T"}]}]}`,
+		`{"opcodes": [{"code": 1, "name": "A", "fields": [{"name": "x", "type": "float", "min": 0}]}]}`,
+	} {
+		var s OpcodeSchema
+		if err := json.Unmarshal([]byte(schema), &s); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := opcodeMessages(s); err == nil {
+			t.Errorf("schema %s is valid", schema)
+		}
+	}
+}
+
+func TestOpcodeExamples(t *testing.T) {
+	for _, file := range []string{"examples/match_opcodes.json", "examples/match_state.json"} {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var schema OpcodeSchema
+		if err := json.Unmarshal(content, &schema); err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		if _, err := newOpcodeCodec(schema); err != nil {
+			t.Errorf("%s: %s", file, err)
+		}
+	}
+}
+
+func TestGoMatchTemplate(t *testing.T) {
+	tmpl := template.Must(template.New("go").Funcs(template.FuncMap{"stripNewlines": stripNewlines}).Parse(goMatchTemplate))
+	for _, file := range []string{"examples/match_opcodes.json", "examples/match_state.json"} {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var schema OpcodeSchema
+		if err := json.Unmarshal(content, &schema); err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		messages, err := opcodeMessages(schema)
+		if err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		var code bytes.Buffer
+		if err := tmpl.Execute(&code, goOpcodeFile(messages, "match", "Match")); err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		parsed, err := parser.ParseFile(token.NewFileSet(), file, code.Bytes(), 0)
+		if err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		methods := map[string]bool{}
+		for _, decl := range parsed.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+				methods[fmt.Sprintf("%s.%s", types.ExprString(fn.Recv.List[0].Type), fn.Name.Name)] = true
+			}
+		}
+		for _, method := range []string{"MatchInit", "MatchJoinAttempt", "MatchJoin", "MatchLeave", "MatchLoop", "MatchTerminate", "MatchSignal"} {
+			if !methods["*Match."+method] {
+				t.Errorf("%s: Match.%s is missing", file, method)
+			}
+		}
+		handlers := map[string]bool{}
+		for _, decl := range parsed.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+				if spec := gen.Specs[0].(*ast.TypeSpec); spec.Name.Name == "Handlers" {
+					for _, method := range spec.Type.(*ast.InterfaceType).Methods.List {
+						handlers[method.Names[0].Name] = true
+					}
+				}
+			}
+		}
+		for _, class := range messages {
+			if class.Code > 0 && !methods["*"+class.Name+".Encode"] {
+				t.Errorf("%s: %s.Encode is missing", file, class.Name)
+			}
+			if class.Code > 0 && handlers["On"+class.Name] != (class.Sender != "server") {
+				t.Errorf("%s: On%s in Handlers is %v, for a message sent by %q", file, class.Name, handlers["On"+class.Name], class.Sender)
+			}
+		}
+	}
+}
+
+// goMatchTest is a test of the generated Go match code, run in a module next to it: the messages decoded from
+// random payloads, since any payload decodes to one, are encoded and decoded back in full, as the changes from
+// each other, and with a stream. The payloads of vectors.json, encoded by opcodeCodec, are decoded and encoded
+// back to the same bytes.
+const goMatchTest = `package {{ .Package }}
+
+import (
+{{- if .Binary }}
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"testing"
+{{ end }}
+	"github.com/heroiclabs/nakama-common/runtime"
+)
+
+var _ runtime.Match = (*{{ .ClassName }})(nil)
+{{- if .Binary }}
+
+func decodeAll[T any](read func(*BitReader, *T) *T) (messages []*T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		payload := make([]byte, rnd.Intn(64))
+		if i%4 == 0 && len(payload) > 0 {
+			// Mostly zeros, so the counts of repeated fields are small enough to be decoded.
+			payload[rnd.Intn(len(payload))] = byte(rnd.Intn(256))
+		} else if i%4 != 0 {
+			rnd.Read(payload)
+		}
+		r := NewBitReader(payload)
+		if m := read(r, nil); !r.Failed() {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+type codec[T any] struct {
+	write func(*T, *BitWriter, *T)
+	read  func(*BitReader, *T) *T
+}
+
+func (c codec[T]) encode(m, baseline *T) []byte {
+	w := &BitWriter{}
+	c.write(m, w, baseline)
+	return w.Bytes()
+}
+
+func (c codec[T]) decode(payload []byte, baseline *T) (*T, bool) {
+	r := NewBitReader(payload)
+	m := c.read(r, baseline)
+	return m, !r.Failed()
+}
+
+func roundTrip[T any](t *testing.T, write func(*T, *BitWriter, *T), read func(*BitReader, *T) *T) {
+	encode, decode := codec[T]{write, read}.encode, codec[T]{write, read}.decode
+	messages := decodeAll(read)
+	if len(messages) == 0 {
+		t.Fatal("no payload decodes")
+	}
+	for i, m := range messages {
+		full := encode(m, nil)
+		if decoded, ok := decode(full, nil); !ok || !bytes.Equal(encode(decoded, nil), full) {
+			t.Fatalf("%x decodes to %+v, %v", full, decoded, ok)
+		}
+		baseline := messages[(i+1)%len(messages)]
+		before := encode(baseline, nil)
+		delta := encode(m, baseline)
+		if decoded, ok := decode(delta, baseline); !ok || !bytes.Equal(encode(decoded, nil), full) {
+			t.Fatalf("the changes %x from %x decode to %+v, %v, not %x", delta, before, decoded, ok, full)
+		}
+		if after := encode(baseline, nil); !bytes.Equal(after, before) {
+			t.Fatalf("decoding changes modified their baseline %x to %x", before, after)
+		}
+		if unchanged, _ := decode(encode(m, m), m); !bytes.Equal(encode(unchanged, nil), full) {
+			t.Fatalf("%x as the changes from itself decodes to %+v", full, unchanged)
+		}
+	}
+}
+
+// checkVector checks that value and the message of full are encoded to full, and the latter as the changes delta
+// from the message of baseline, like opcodeCodec does.
+func checkVector[T any](t *testing.T, name string, value json.RawMessage, full, baseline, delta []byte, write func(*T, *BitWriter, *T), read func(*BitReader, *T) *T) {
+	c := codec[T]{write, read}
+	var want T
+	if err := json.Unmarshal(value, &want); err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	if got := c.encode(&want, nil); !bytes.Equal(got, full) {
+		t.Fatalf("%s: %s encodes to %x, not %x", name, value, got, full)
+	}
+	m, ok := c.decode(full, nil)
+	if !ok {
+		t.Fatalf("%s: %x does not decode", name, full)
+	}
+	if got := c.encode(m, nil); !bytes.Equal(got, full) {
+		t.Fatalf("%s: %x decodes to %+v, which encodes to %x", name, full, m, got)
+	}
+	b, ok := c.decode(baseline, nil)
+	if !ok {
+		t.Fatalf("%s: %x does not decode", name, baseline)
+	}
+	if got := c.encode(m, b); !bytes.Equal(got, delta) {
+		t.Fatalf("%s: %x encodes as the changes %x from %x, not %x", name, full, got, baseline, delta)
+	}
+	if d, ok := c.decode(delta, b); !ok || !bytes.Equal(c.encode(d, nil), full) {
+		t.Fatalf("%s: the changes %x from %x decode to %+v, %v, not %x", name, delta, baseline, d, ok, full)
+	}
+}
+
+func TestVectors(t *testing.T) {
+	content, err := os.ReadFile("vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors []struct {
+		Name                  string
+		Value                 json.RawMessage
+		Full, Baseline, Delta []byte
+	}
+	if err := json.Unmarshal(content, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		switch v.Name {
+{{- range .Classes }}
+{{- if .Binary }}
+		case "{{ .Name }}":
+			checkVector(t, v.Name, v.Value, v.Full, v.Baseline, v.Delta, (*{{ .Name }}).Write, Read{{ .Name }})
+{{- end }}
+{{- end }}
+		default:
+			t.Fatalf("%s is not a message", v.Name)
+		}
+	}
+}
+{{- range .Classes }}
+{{- if .Binary }}
+
+func Test{{ .Name }}RoundTrip(t *testing.T) {
+	roundTrip(t, (*{{ .Name }}).Write, Read{{ .Name }})
+}
+{{- end }}
+{{- if .Delta }}
+
+func Test{{ .Name }}Stream(t *testing.T) {
+	var sender, receiver {{ .Name }}Stream
+	var last []byte
+	for i, m := range decodeAll(Read{{ .Name }}) {
+		payload := sender.Encode(m)
+		decoded, err := receiver.Decode(payload)
+		if err != nil || !bytes.Equal(decoded.Encode(nil), m.Encode(nil)) {
+			t.Fatalf("%x decodes to %+v, %v, not %+v", payload, decoded, err, m)
+		}
+		if last != nil {
+			if _, err := receiver.Decode(last); err != ErrStaleMessage {
+				t.Fatalf("an older {{ .Name }} decodes with %v", err)
+			}
+		}
+		// Some acknowledgements are lost.
+		if i%3 != 2 {
+			sender.Acknowledge(receiver.Sequence)
+		}
+		last = payload
+	}
+	var late {{ .Name }}Stream
+	if _, err := late.Decode(sender.Encode(&{{ .Name }}{})); err != ErrUnknownBaseline {
+		t.Fatalf("changes from an unknown baseline decode with %v", err)
+	}
+}
+{{- end }}
+{{- end }}
+{{- end }}
+`
+
+type opcodeVector struct {
+	Name                  string
+	Value                 map[string]interface{}
+	Full, Baseline, Delta []byte
+}
+
+// opcodeVectors encodes random messages of each binary message of a schema with opcodeCodec, in full and as the
+// changes from another one. The messages are the ones decoded from their payloads, as the generated code has them,
+// and the ones with a float which is not finite or a string which is not UTF-8 are left out, as JSON does not
+// have them.
+func opcodeVectors(t *testing.T, schema OpcodeSchema) (vectors []opcodeVector) {
+	c, err := newOpcodeCodec(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seeds := append([][]byte{}, fuzzSeeds...)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		seed := make([]byte, rnd.Intn(256))
+		rnd.Read(seed)
+		seeds = append(seeds, seed)
+	}
+	names := []string{}
+	for name := range c.messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		decoded := func(seed []byte) map[string]interface{} {
+			value, err := c.decode(name, c.encode(name, (&fuzzValues{data: seed}).message(c, name, 0), nil), nil)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			return value
+		}
+		for i, seed := range seeds {
+			value, baseline := decoded(seed), decoded(seeds[(i+1)%len(seeds)])
+			if !jsonValues(value) || !jsonValues(baseline) {
+				continue
+			}
+			vectors = append(vectors, opcodeVector{
+				Name:     name,
+				Value:    value,
+				Full:     c.encode(name, value, nil),
+				Baseline: c.encode(name, baseline, nil),
+				Delta:    c.encode(name, value, baseline),
+			})
+		}
+	}
+	return vectors
+}
+
+func jsonValues(v interface{}) bool {
+	switch v := v.(type) {
+	case float64:
+		return !math.IsNaN(v) && !math.IsInf(v, 0)
+	case string:
+		return utf8.ValidString(v)
+	case []interface{}:
+		for _, e := range v {
+			if !jsonValues(e) {
+				return false
+			}
+		}
+	case map[string]interface{}:
+		for _, e := range v {
+			if !jsonValues(e) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestGoMatchCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go test on the generated code")
+	}
+	stub, err := filepath.Abs("testdata/nakama-common")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var test OpcodeSchema
+	if err := json.Unmarshal([]byte(testOpcodeSchema), &test); err != nil {
+		t.Fatal(err)
+	}
+	schemas := map[string]OpcodeSchema{"test": test}
+	for _, file := range []string{"examples/match_opcodes.json", "examples/match_state.json"} {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var schema OpcodeSchema
+		if err := json.Unmarshal(content, &schema); err != nil {
+			t.Fatalf("%s: %s", file, err)
+		}
+		schemas[file] = schema
+	}
+	tmpl := template.Must(template.New("go").Funcs(template.FuncMap{"stripNewlines": stripNewlines}).Parse(goMatchTemplate))
+	testTmpl := template.Must(template.New("test").Parse(goMatchTest))
+	for name, schema := range schemas {
+		messages, err := opcodeMessages(schema)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		file := goOpcodeFile(messages, "match", "Match")
+		dir := t.TempDir()
+		var code, test bytes.Buffer
+		if err := tmpl.Execute(&code, file); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if err := testTmpl.Execute(&test, file); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		mod := "module example.com/match\n\ngo 1.21\n\n" +
+			"require github.com/heroiclabs/nakama-common v0.0.0\n\n" +
+			"replace github.com/heroiclabs/nakama-common => " + stub + "\n"
+		vectors, err := json.Marshal(opcodeVectors(t, schema))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for path, content := range map[string][]byte{"go.mod": []byte(mod), "match.go": code.Bytes(), "match_test.go": test.Bytes(), "vectors.json": vectors} {
+			if err := os.WriteFile(filepath.Join(dir, path), content, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, args := range [][]string{{"vet", "."}, {"test", "."}} {
+			cmd := exec.Command("go", args...)
+			cmd.Dir = dir
+			cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOTOOLCHAIN=local")
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("%s: go %s: %s\n%s", name, strings.Join(args, " "), err, out)
+			}
+		}
+	}
+}
+
+func TestGoRpcs(t *testing.T) {
+	src, err := parseGoSource("examples/guild_module")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rpcs, err := src.rpcs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, rpc := range rpcs {
+		got = append(got, fmt.Sprintf("%s %s %s %s", rpc.ID, rpc.Name, rpc.Request, rpc.Response))
+	}
+	want := []string{
+		"create_guild create_guild CreateGuildRequest Guild",
+		"join_guild join_guild JoinGuildRequest Guild",
+		"guild.search guild_search Dictionary GuildList",
+		"Ping ping  ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got RPCs %q, want %q", got, want)
+	}
+	if rpcs[2].Description[0] != "Search the guilds by name." {
+		t.Errorf("got description %q", rpcs[2].Description)
+	}
+
+	classes, err := src.payloadClasses([]string{"GuildList"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(classes) != 3 || classes[0].Name != "Guild" || classes[2].Name != "GuildMember" {
+		t.Fatalf("got classes %+v", classes)
+	}
+	fields := map[string]PayloadField{}
+	for _, f := range classes[0].Fields {
+		fields[f.Key] = f
+	}
+	for key, want := range map[string]string{
+		"id":      "scalar String",
+		"level":   "scalar int",
+		"tags":    "array PackedStringArray",
+		"members": "object_array Array",
+		"stats":   "map Dictionary",
+		"leader":  "object GuildMember",
+		"emblem":  "scalar String",
+	} {
+		if got := fields[key].Kind + " " + fields[key].Type; got != want {
+			t.Errorf("field %s: got %s, want %s", key, got, want)
+		}
+	}
+	if _, ok := fields["Secret"]; ok || len(fields) != 12 {
+		t.Errorf("got fields %+v", fields)
+	}
+}
+
+func TestRpcMethodName(t *testing.T) {
+	for id, want := range map[string]string{
+		"create_guild":    "create_guild",
+		"guild.search":    "guild_search",
+		"GetProfile":      "get_profile",
+		"clan-join--fast": "clan_join_fast",
+		"2fa":             "rpc_2fa",
+	} {
+		if got := rpcMethodName(id); got != want {
+			t.Errorf("rpcMethodName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestGoStructFields(t *testing.T) {
+	src, err := parseGoSource("examples/guild_module")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := src.exportedStructs()
+	if len(names) != 8 || names[0] != "Audit" {
+		t.Errorf("got structs %q", names)
+	}
+	classes, err := src.payloadClasses([]string{"PlayerProfile"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, class := range classes {
+		if class.Name != "PlayerProfile" {
+			continue
+		}
+		for _, f := range class.Fields {
+			got = append(got, fmt.Sprintf("%s %s %s %t", f.Key, f.Kind, f.Type, f.Always))
+		}
+	}
+	// The fields of Audit are embedded, except the editor which PlayerProfile hides.
+	want := []string{
+		"created_at scalar String false",
+		"updated_at scalar String false",
+		"display_name scalar String true",
+		"editor scalar String true",
+		"guild object Guild false",
+		"cooldown scalar int true",
+		"settings scalar Dictionary false",
+		"inventory object_map Dictionary false",
+		"friends array PackedStringArray false",
+		"scores scalar Array false",
+		"extra scalar Variant false",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got fields %q, want %q", got, want)
+	}
+}
+
+func TestGoStructUnresolved(t *testing.T) {
+	for _, test := range []struct {
+		fields string
+		err    string
+	}{
+		{fields: "At time.Time `json:\"at\"`\n\tAccount *api.Account `json:\"-\"`\n\ttime.Time `json:\"since\"`"},
+		{fields: "Account *api.Account `json:\"account\"`", err: "the type *api.Account of Payload.account"},
+		{fields: "Accounts map[string][]api.Account", err: "the type map[string][]api.Account of Payload.Accounts"},
+		{fields: "api.Account", err: "Payload embeds api.Account"},
+	} {
+		path := filepath.Join(t.TempDir(), "module.go")
+		source := "package module\n\nimport (\n\t\"time\"\n\n\t\"github.com/heroiclabs/nakama-common/api\"\n)\n\n" +
+			"type Payload struct {\n\t" + test.fields + "\n}\n"
+		if err := os.WriteFile(path, []byte(source), 0644); err != nil {
+			t.Fatal(err)
+		}
+		src, err := parseGoSource(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		classes, err := src.payloadClasses([]string{"Payload"})
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: %s", test.fields, err)
+		case test.err == "" && len(classes[0].Fields) != 2:
+			t.Errorf("%s: got fields %+v", test.fields, classes[0].Fields)
+		case test.err != "" && (err == nil || !strings.HasPrefix(err.Error(), test.err)):
+			t.Errorf("%s: got error %v, want %s", test.fields, err, test.err)
+		}
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	set, err := loadJSONSchemas("examples/schemas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, enums := set.classes()
+	if len(enums) != 1 || enums[0].Name != "Rank" || enums[0].Constants[3].Name != "GRAND_MASTER" {
+		t.Errorf("got enums %+v", enums)
+	}
+	var names []string
+	fields := map[string]PayloadField{}
+	for _, class := range classes {
+		names = append(names, class.Name)
+		for _, f := range class.Fields {
+			fields[class.Name+"."+f.Key] = f
+		}
+	}
+	// The roots and the $defs are classes, followed by the inline objects, and Item is shared by both files.
+	want := []string{"Sender", "GiftNotification", "RewardNotification", "PlayerProfile", "Item", "PlayerProfileSettings"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("got classes %q, want %q", names, want)
+	}
+	for key, want := range map[string]string{
+		"PlayerProfile.version":       "scalar int true false 2",
+		"PlayerProfile.rank":          "scalar String true false ",
+		"PlayerProfile.guild_id":      "scalar String true true ",
+		"PlayerProfile.level":         "scalar int false false 1",
+		"PlayerProfile.inventory":     "object_map Dictionary false false ",
+		"PlayerProfile.settings":      "object PlayerProfileSettings false false ",
+		"PlayerProfile.extra":         "scalar Variant false false ",
+		"GiftNotification.item":       "object Item false false ",
+		"GiftNotification.message":    "scalar String false true ",
+		"GiftNotification.user_id":    "scalar String true false ",
+		"RewardNotification.currency": "scalar String false false \"coins\"",
+		"Item.durability":             "scalar float false true ",
+	} {
+		f := fields[key]
+		if got := fmt.Sprintf("%s %s %t %t %s", f.Kind, f.Type, f.Always, f.Nullable, f.Fallback); got != want {
+			t.Errorf("field %s: got %s, want %s", key, got, want)
+		}
+	}
+	checks := strings.Join(fields["PlayerProfile.rank"].Checks, "\n")
+	if !strings.Contains(checks, "not _rank in Rank.VALUES") {
+		t.Errorf("got checks of rank %q", checks)
+	}
+	checks = strings.Join(fields["RewardNotification.multiplier"].Checks, "\n")
+	if !strings.Contains(checks, "[1.0, 1.5, 2.0]") {
+		t.Errorf("got checks of multiplier %q", checks)
+	}
+	if len(fields["PlayerProfile.guild_id"].Checks) != 1 {
+		t.Errorf("a nullable field is not required to be set: got %q", fields["PlayerProfile.guild_id"].Checks)
+	}
+	// The setters check the values like the ones of the API.
+	for key, want := range map[string]string{
+		"PlayerProfile.rank":       "enum  Rank Rank.VALUES",
+		"PlayerProfile.version":    "scalar integer  ",
+		"PlayerProfile.inventory":  "object_map  Item ",
+		"PlayerProfile.tags":       "array string  ",
+		"PlayerProfile.settings":   "scalar   ",
+		"PlayerProfile.extra":      "scalar   ",
+		"GiftNotification.message": "scalar string  ",
+	} {
+		setter := fields[key].Setter()
+		if got := fmt.Sprintf("%s %s %s %s", setter.Kind, setter.JSONType, setter.Class, setter.Values); got != want {
+			t.Errorf("setter of %s: got %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestStorageClasses(t *testing.T) {
+	var manifest StorageManifest
+	content, err := os.ReadFile("examples/storage_manifest.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		t.Fatal(err)
+	}
+	_, _, collections, err := storageClasses(manifest, "examples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range collections {
+		got = append(got, fmt.Sprintf("%s %s %q %s %d %d", c.Name, c.Field, c.KeyFormat, c.ValueType(), c.PermissionRead, c.PermissionWrite))
+	}
+	want := []string{
+		`Profiles profiles "\"profile\"" PlayerProfile 2 1`,
+		`InventorySlots inventory_slots "\"slot_%s\"" Item 1 0`,
+		`DeviceSettings device_settings "\"%s_%s\"" Dictionary 1 1`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got collections %q, want %q", got, want)
+	}
+	if params := collections[2].KeyDeclarations(); params != ", p_platform : String, p_device_id : String" {
+		t.Errorf("got key parameters %q", params)
+	}
+
+	for _, c := range []StorageCollection{
+		{Name: "a", Key: "{Slot}"},
+		{Name: "a", Key: "slot_{slot"},
+		{Name: "a", Key: "k", Value: "player_profile.schema.json#/$defs/Rank"},
+		{Name: "a", Key: "k", Value: "missing.json"},
+		{Name: "a", Key: "k", PermissionRead: new(int)},
+	} {
+		if c.PermissionRead != nil {
+			*c.PermissionRead = 3
+		}
+		manifest := StorageManifest{Schemas: "schemas", Collections: []StorageCollection{c}}
+		if _, _, _, err := storageClasses(manifest, "examples"); err == nil {
+			t.Errorf("collection %+v: expected an error", c)
+		}
+	}
+}
+
+func TestNotificationClasses(t *testing.T) {
+	var registry NotificationRegistry
+	content, err := os.ReadFile("examples/notifications.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, &registry); err != nil {
+		t.Fatal(err)
+	}
+	classes, _, notifications, err := notificationClasses(registry, "examples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, n := range notifications {
+		got = append(got, fmt.Sprintf("%d %s %s %s %s", n.Code, n.Const, n.Name, n.Signal, n.ContentType()))
+	}
+	want := []string{
+		"100 GIFT_RECEIVED GiftReceivedNotification received_gift_received GiftNotification",
+		"101 REWARD_GRANTED RewardGrantedNotification received_reward_granted RewardNotification",
+		"102 TOURNAMENT_ENDED TournamentEndedNotification received_tournament_ended TournamentEndedContent",
+		"-2 FRIEND_REQUEST FriendRequestNotification received_friend_request Dictionary",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got notifications %q, want %q", got, want)
+	}
+	// The inline content is a class, before the ones of the schemas.
+	if classes[0].Name != "TournamentEndedContent" || classes[0].Fields[2].Type != "RewardNotification" {
+		t.Errorf("got class %+v", classes[0])
+	}
+
+	for _, n := range [][]RegisteredNotification{
+		{{Code: 1, Name: "a"}, {Code: 1, Name: "b"}},
+		{{Code: 1, Name: "a"}, {Code: 2, Name: "a"}},
+		{{Code: 1, Name: "Gift"}},
+		{{Code: 1, Name: "a", Content: json.RawMessage(`"notifications.schema.json#/definitions/Missing"`)}},
+		{{Code: 1, Name: "a", Content: json.RawMessage(`{"type": "string"}`)}},
+		{{Code: 1, Name: "gift"}},
+	} {
+		registry := NotificationRegistry{Schemas: "schemas", Notifications: n}
+		if _, _, _, err := notificationClasses(registry, "examples"); err == nil {
+			t.Errorf("notifications %+v: expected an error", n)
+		}
+	}
+}
+
+func TestSatoriFields(t *testing.T) {
+	var manifest SatoriManifest
+	content, err := os.ReadFile("examples/satori_manifest.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		t.Fatal(err)
+	}
+	classes, enums, flags, experiments, events, err := satoriFields(manifest, "examples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the classes of the schemas which the manifest uses are generated, as results of Satori.
+	var names []string
+	for _, class := range classes {
+		names = append(names, class.Name+" "+class.Extends)
+	}
+	if want := []string{"StoreConfigFlag SatoriAsyncResult", "ShopLayoutConfig SatoriAsyncResult", "SummerFestivalConfig SatoriAsyncResult", "Item SatoriAsyncResult"}; !reflect.DeepEqual(names, want) || len(enums) != 0 {
+		t.Errorf("got classes %q and enums %v, want %q", names, enums, want)
+	}
+	var got []string
+	for _, f := range flags {
+		got = append(got, fmt.Sprintf("%s %s %s %s", f.Name, f.Type, f.Default, f.ClassType()))
+	}
+	want := []string{
+		"double_xp bool false Dictionary",
+		"max_energy int 100 Dictionary",
+		"drop_rate float 0.25 Dictionary",
+		`motd String "Welcome!" Dictionary`,
+		`starter_item Dictionary {"id":"wooden_sword","count":1} Item`,
+		`store_config Dictionary {"currency":"coins","discount":0} StoreConfigFlag`,
+		"remote_config Dictionary {} Dictionary",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got flags %q, want %q", got, want)
+	}
+	// Variants are matched by value, their name by default.
+	if v := experiments[1].Variants[0]; experiments[1].Name != "shop_layout" || v.Name != "GRID" || v.Value != `"{\"columns\":3}"` {
+		t.Errorf("got experiment %+v", experiments[1])
+	}
+	if experiments[0].Class != "" || experiments[1].Class != "ShopLayoutConfig" {
+		t.Errorf("got experiment configs %q and %q", experiments[0].Class, experiments[1].Class)
+	}
+	if events[0].Pascal != "SummerFestival" || events[0].ClassType() != "SummerFestivalConfig" || events[1].ClassType() != "Dictionary" {
+		t.Errorf("got live events %+v", events)
+	}
+
+	for _, m := range []SatoriManifest{
+		{Flags: []SatoriFlag{{Name: "a", Type: "bool"}, {Name: "a", Type: "int"}}},
+		{Flags: []SatoriFlag{{Name: "a", Type: "double"}}},
+		{Flags: []SatoriFlag{{Name: "a", Type: "int", Default: json.RawMessage(`1.5`)}}},
+		{Flags: []SatoriFlag{{Name: "a", Type: "bool", Default: json.RawMessage(`"true"`)}}},
+		{Flags: []SatoriFlag{{Name: "a", Type: "string", Schema: json.RawMessage(`{"type": "object"}`)}}},
+		{Flags: []SatoriFlag{{Name: "a", Type: "json", Schema: json.RawMessage(`"player_profile.schema.json#/$defs/Rank"`)}}},
+		{Experiments: []SatoriExperiment{{Name: "a"}}},
+		{Experiments: []SatoriExperiment{{Name: "a", Variants: []SatoriVariant{{Name: "none"}}}}},
+		{Experiments: []SatoriExperiment{{Name: "a", Variants: []SatoriVariant{{Name: "b"}, {Name: "c", Value: "b"}}}}},
+		{Experiments: []SatoriExperiment{{Name: "item", Variants: []SatoriVariant{{Name: "b"}}, Config: json.RawMessage(`"player_profile.schema.json#/$defs/Item"`)}}},
+		{LiveEvents: []SatoriLiveEvent{{Name: "a", Config: json.RawMessage(`{"type": "string"}`)}}},
+	} {
+		m.Schemas = "schemas/player_profile.schema.json"
+		if _, _, _, _, _, err := satoriFields(m, "examples"); err == nil {
+			t.Errorf("manifest %+v: expected an error", m)
+		}
+	}
+}
+
+func TestSatoriEvents(t *testing.T) {
+	var taxonomy SatoriTaxonomy
+	content, err := os.ReadFile("examples/satori_events.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, &taxonomy); err != nil {
+		t.Fatal(err)
+	}
+	events, err := satoriEvents(taxonomy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The required metadata are the first parameters of the constructors.
+	var got []string
+	for _, m := range events[0].Metadata {
+		got = append(got, fmt.Sprintf("%s %s %s %t %s", m.Name, m.Key, m.Type, m.Required, m.Enum))
+	}
+	want := []string{
+		"level_id level_id String true ",
+		"stars stars int true ",
+		`difficulty difficulty String false ["easy", "normal", "hard"]`,
+		"duration duration float false ",
+		"first_try firstTry bool false ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got metadata %q, want %q", got, want)
+	}
+	if e := events[1]; e.Const != "PURCHASE" || e.Value != "float" || e.ValueConstant() != "TYPE_FLOAT" || !e.HasRequired() {
+		t.Errorf("got event %+v", e)
+	}
+	if e := events[2]; e.Value != "" || e.HasRequired() {
+		t.Errorf("got event %+v", e)
+	}
+
+	for _, events := range [][]TaxonomyEvent{
+		{},
+		{{Name: "a"}, {Name: "a"}},
+		{{Name: "validate"}},
+		{{Name: "a", Value: "double"}},
+		{{Name: "a", Metadata: []TaxonomyMetadata{{Key: "b", Type: "string"}, {Key: "b", Type: "int"}}}},
+		{{Name: "a", Value: "int", Metadata: []TaxonomyMetadata{{Key: "value", Type: "string"}}}},
+		{{Name: "a", Metadata: []TaxonomyMetadata{{Key: "b", Type: "object"}}}},
+		{{Name: "a", Metadata: []TaxonomyMetadata{{Key: "b", Type: "int", Enum: []string{"1"}}}}},
+	} {
+		if _, err := satoriEvents(SatoriTaxonomy{Events: events}); err == nil {
+			t.Errorf("events %+v: expected an error", events)
+		}
+	}
+}
+
+func TestClientExtra(t *testing.T) {
+	preamble, written := clientExtra("# About the file.\n\nconst A = 1\n\n## Doc.\nfunc b_async():\n\tpass\n\n\treturn\n\nfunc d_async():\n\tpass\n")
+	if preamble != "const A = 1" {
+		t.Errorf("preamble = %q", preamble)
+	}
+	if len(written) != 2 || written[0].Code != "## Doc.\nfunc b_async():\n\tpass\n\n\treturn" || written[1].Name != "d" {
+		t.Fatalf("methods = %+v", written)
+	}
+	var names []string
+	for _, m := range mergeMethods([]ClientMethod{{Name: "a"}, {Name: "c"}, {Name: "e"}}, written) {
+		names = append(names, m.Name)
+	}
+	if got := strings.Join(names, " "); got != "a b c d e" {
+		t.Errorf("merged methods = %s, want a b c d e", got)
+	}
+}
+
+func TestClientMethods(t *testing.T) {
+	var spec struct {
+		Paths       map[string]map[string]Operation
+		Definitions map[string]Definition
+	}
+	var overlay Overlay
+	for file, v := range map[string]interface{}{"spec/nakama.swagger.json": &spec, "overlay/nakama_client.json": &overlay} {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(content, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	find := func(methods []ClientMethod, name string) ClientMethod {
+		for _, m := range methods {
+			if m.Name == name {
+				return m
+			}
+		}
+		t.Fatalf("no method %s", name)
+		return ClientMethod{}
+	}
+	signature := func(m ClientMethod) string {
+		var params []string
+		for _, p := range m.Params {
+			params = append(params, p.Declaration())
+		}
+		return strings.Join(params, ", ")
+	}
+	propType := func(Property) string { return "Variant" }
+
+	methods := clientMethods(spec.Paths, spec.Definitions, overlay, "Nakama", propType)
+	// The wrappers of group user operations all come from the *GroupUsers rule.
+	if m := find(methods, "ban_group_users"); signature(m) != "p_session : NakamaSession, p_group_id : String, p_ids : PackedStringArray, p_cancel_token : NakamaCancellationToken = null" {
+		t.Errorf("ban_group_users_async(%s)", signature(m))
+	}
+	// Required parameters follow Order too, and omitted body fields are sent with their value.
+	if m := find(methods, "list_matches"); m.Args != "p_session, p_limit, p_authoritative, p_label if p_label else null, p_min, p_max, p_query if p_query else null, p_cancel_token" ||
+		!strings.HasPrefix(signature(m), "p_session : NakamaSession, p_min : int, p_max : int, p_limit : int, p_authoritative : bool, p_label : String") {
+		t.Errorf("list_matches_async(%s) calls list_matches_async(%s)", signature(m), m.Args)
+	}
+	if m := find(methods, "session_logout"); len(m.Params) != 2 || !strings.Contains(m.Args, `"refresh_token": p_session.refresh_token`) {
+		t.Errorf("session_logout_async(%s) calls session_logout_async(%s)", signature(m), m.Args)
+	}
+	if m := find(methods, "link_steam"); !strings.Contains(m.Args, `"account": {"token": p_token}`) || m.Params[1].Name != "p_token" {
+		t.Errorf("link_steam_async(%s) calls link_steam_async(%s)", signature(m), m.Args)
+	}
+	if m := find(methods, "link_facebook"); !strings.HasSuffix(m.Args, "null, p_cancel_token") {
+		t.Errorf("link_facebook_async calls link_facebook_async(%s)", m.Args)
+	}
+
+	// With -options, the optional parameters set the fields of the Options object, under their API name.
+	optionObjects = true
+	defer func() { optionObjects = false }()
+	methods = clientMethods(spec.Paths, spec.Definitions, overlay, "Nakama", propType)
+	m := find(methods, "list_matches")
+	want := []string{
+		"var options := NakamaAPI.ListMatchesOptions.new()",
+		"options.limit = p_limit",
+		"options.authoritative = p_authoritative",
+		"options.label = p_label if p_label else null",
+		"options.min_size = p_min",
+		"options.max_size = p_max",
+		"options.query = p_query if p_query else null",
+	}
+	if !reflect.DeepEqual(m.Setup, want) || m.Args != "p_session, options, p_cancel_token" {
+		t.Errorf("list_matches_async sets %q and calls list_matches_async(%s)", m.Setup, m.Args)
+	}
+	if m := find(methods, "link_facebook"); strings.Contains(m.Args, "null") || m.Setup != nil {
+		t.Errorf("link_facebook_async sets %q and calls link_facebook_async(%s)", m.Setup, m.Args)
+	}
+}
+
+func TestPaginationHelpers(t *testing.T) {
+	// NakamaAPI.gd is checked to be up to date with the generator by TestGeneratedFilesUpToDate.
+	content, err := os.ReadFile("../addons/com.heroiclabs.nakama/api/NakamaAPI.gd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, operation := range []string{
+		"list_friends", "list_groups", "list_storage_objects", "list_leaderboard_records",
+		"list_leaderboard_records_around_owner", "list_tournament_records", "list_tournament_records_around_owner",
+		"list_channel_messages", "list_notifications",
+	} {
+		for _, helper := range []string{"_pages_async(", "_all_async("} {
+			if !bytes.Contains(content, []byte("\tfunc "+operation+helper)) {
+				t.Errorf("NakamaAPI.gd has no %s%s)", operation, helper)
+			}
+		}
+	}
+}
+
+// generatedFiles are the files of the addon and of the test suite generated by codegen, with the arguments which generate them.
+var generatedFiles = []struct {
+	path string
+	args []string
+}{
+	{"../addons/com.heroiclabs.nakama/api/NakamaAPI.gd", []string{"-retry-policy", "overlay/nakama_retry.json", "spec/nakama.swagger.json", "Nakama"}},
+	{"../addons/com.heroiclabs.nakama/Satori/SatoriAPI.gd", []string{"-retry-policy", "overlay/satori_retry.json", "spec/satori.swagger.json", "Satori"}},
+	{"../addons/com.heroiclabs.nakama/client/NakamaClient.gd", []string{"-client", "overlay/nakama_client.json", "spec/nakama.swagger.json", "Nakama"}},
+	{"../test_suite/utils/arena_messages.gd", []string{"-opcodes", "examples/match_state.json", "Arena"}},
+	{"../test_suite/utils/game_messages.gd", []string{"-opcodes", "examples/match_opcodes.json", "Game"}},
+	{"../test_suite/utils/guild_rpcs.gd", []string{"-rpcs", "examples/guild_module", "Guild"}},
+	{"../test_suite/utils/guild_types.gd", []string{"-from-go", "examples/guild_module", "Guild"}},
+	{"../test_suite/utils/storage_types.gd", []string{"-json-schema", "examples/schemas", "Storage"}},
+	{"../test_suite/utils/game_storage.gd", []string{"-storage", "examples/storage_manifest.json", "Game"}},
+	{"../test_suite/utils/game_notifications.gd", []string{"-notifications", "examples/notifications.json", "Game"}},
+	{"../test_suite/utils/game_satori.gd", []string{"-satori", "examples/satori_manifest.json", "Game"}},
+	{"../test_suite/utils/game_events.gd", []string{"-satori-events", "examples/satori_events.json", "Game"}},
+}
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the generator")
+	}
+	for _, f := range generatedFiles {
+		want, err := os.ReadFile(f.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output := filepath.Join(t.TempDir(), filepath.Base(f.path))
+		args := append([]string{"run", "main.go", "-output", output}, f.args...)
+		if out, err := exec.Command("go", args...).CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %s\n%s", strings.Join(args, " "), err, out)
+		}
+		got, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatalf("go %s did not generate %s", strings.Join(args, " "), f.path)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is not up to date, regenerate it with: go run main.go -output %s %s", f.path, f.path, strings.Join(f.args, " "))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+# Hand-written part of NakamaClient, merged by codegen with the generated methods in name order. See nakama_client.json.
+# It is a template, executed with the functions of the generated code, like optionObjects and decl.
+
+const ChannelType = NakamaRTMessage.ChannelJoin.ChannelType
+
+## Delete one or more storage objects. [br]
+## p_session - The session of the user. [br]
+## p_ids - The ids of the objects to delete. [br]
+## Returns a task which represents the asynchronous operation.
+func delete_storage_objects_async(p_session : NakamaSession, p_ids : Array, p_cancel_token : NakamaCancellationToken = null) -> NakamaAsyncResult:
+	var ids : Array = []
+	for id{{ decl "Variant" }} in p_ids:
+		if not id is NakamaStorageObjectId:
+			continue # TODO Exceptions
+		var obj_id : NakamaStorageObjectId = id
+		ids.append(obj_id.as_delete().serialize())
+	return await _api_client.delete_storage_objects_async(p_session,
+		NakamaAPI.ApiDeleteStorageObjectsRequest.create(NakamaAPI, {
+			"object_ids": ids
+		}), p_cancel_token)
+
+## Read one or more objects from the storage engine. [br]
+## p_session - The session of the user. [br]
+## p_ids - The objects to read. [br]
+## Returns a task which resolves to the storage batch object.
+func read_storage_objects_async(p_session : NakamaSession, p_ids : Array, p_cancel_token : NakamaCancellationToken = null): # -> NakamaAPI.ApiStorageObjects:
+	var ids := []
+	for id{{ decl "Variant" }} in p_ids:
+		if not id is NakamaStorageObjectId:
+			continue # TODO Exceptions
+		var obj_id : NakamaStorageObjectId = id
+		ids.append(obj_id.as_read().serialize())
+	return await _api_client.read_storage_objects_async(p_session,
+		NakamaAPI.ApiReadStorageObjectsRequest.create(NakamaAPI, {
+			"object_ids": ids
+		}), p_cancel_token)
+
+## Execute a function with an input payload on the server. [br]
+## p_session - The session of the user. [br]
+## p_id - The ID of the function to execute on the server. [br]
+## p_payload - The payload to send with the function call. [br]
+## Returns a task which resolves to the RPC response.
+func rpc_async(p_session : NakamaSession, p_id : String, p_payload = null, p_cancel_token : NakamaCancellationToken = null): # -> NakamaAPI.ApiRpc:
+	if p_payload == null:
+		return await _api_client.rpc_func2_async(p_session.token, p_id, null, {{ if not optionObjects }}null, {{ end }}p_cancel_token)
+	return await _api_client.rpc_func_async(p_session.token, p_id, p_payload, null, p_cancel_token)
+
+## Execute a function on the server without a session. [br]
+## This function is usually used with server side code. DO NOT USE client side. [br]
+## p_http_key - The secure HTTP key used to authenticate. [br]
+## p_id - The id of the function to execute on the server. [br]
+## p_payload - A payload to send with the function call. [br]
+## Returns a task to resolve an RPC response.
+func rpc_async_with_key(p_http_key : String, p_id : String, p_payload = null, p_cancel_token : NakamaCancellationToken = null): # -> NakamaAPI.ApiRpc:
+{{- if optionObjects }}
+	if p_payload == null:
+		var query := NakamaAPI.RpcFunc2Options.new()
+		query.http_key = p_http_key
+		return await _api_client.rpc_func2_async("", p_id, query, p_cancel_token)
+	var options := NakamaAPI.RpcFuncOptions.new()
+	options.http_key = p_http_key
+	return await _api_client.rpc_func_async("", p_id, p_payload, options, p_cancel_token)
+{{- else }}
+	if p_payload == null:
+		return await _api_client.rpc_func2_async("", p_id, null, p_http_key, p_cancel_token)
+	return await _api_client.rpc_func_async("", p_id, p_payload, p_http_key, p_cancel_token)
+{{- end }}
+
+## Write objects to the storage engine. [br]
+## p_session - The session of the user. [br]
+## p_objects - The objects to write. [br]
+## Returns a task which resolves to the storage write acknowledgements.
+func write_storage_objects_async(p_session : NakamaSession, p_objects : Array, p_cancel_token : NakamaCancellationToken = null): # -> NakamaAPI.ApiStorageObjectAcks:
+	var writes : Array = []
+	for obj{{ decl "Variant" }} in p_objects:
+		if not obj is NakamaWriteStorageObject:
+			continue # TODO Exceptions
+		var write_obj : NakamaWriteStorageObject = obj
+		writes.append(write_obj.as_write().serialize())
+	return await _api_client.write_storage_objects_async(p_session,
+		NakamaAPI.ApiWriteStorageObjectsRequest.create(NakamaAPI, {
+			"objects": writes
+		}), p_cancel_token)
@@ -0,0 +1,60 @@
+// Package runtime is the part of github.com/heroiclabs/nakama-common/runtime which the Go code generated by
+// codegen uses, with the same declarations, so the tests compile it without downloading the module.
+package runtime
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Logger interface {
+	Debug(format string, v ...interface{})
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+	WithField(key string, v interface{}) Logger
+	WithFields(fields map[string]interface{}) Logger
+	Fields() map[string]interface{}
+}
+
+type NakamaModule interface{}
+
+type PresenceMeta interface {
+	GetHidden() bool
+	GetPersistence() bool
+	GetUsername() string
+	GetStatus() string
+	GetReason() uint32
+}
+
+type Presence interface {
+	PresenceMeta
+	GetUserId() string
+	GetSessionId() string
+	GetNodeId() string
+}
+
+type MatchData interface {
+	Presence
+	GetOpCode() int64
+	GetData() []byte
+	GetReliable() bool
+	GetReceiveTime() int64
+}
+
+type MatchDispatcher interface {
+	BroadcastMessage(opCode int64, data []byte, presences []Presence, sender Presence, reliable bool) error
+	BroadcastMessageDeferred(opCode int64, data []byte, presences []Presence, sender Presence, reliable bool) error
+	MatchKick(presences []Presence) error
+	MatchLabelUpdate(label string) error
+}
+
+type Match interface {
+	MatchInit(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, params map[string]interface{}) (interface{}, int, string)
+	MatchJoinAttempt(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, presence Presence, metadata map[string]string) (interface{}, bool, string)
+	MatchJoin(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, presences []Presence) interface{}
+	MatchLeave(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, presences []Presence) interface{}
+	MatchLoop(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, messages []MatchData) interface{}
+	MatchTerminate(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{}
+	MatchSignal(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string)
+}
@@ -0,0 +1,67 @@
+extends SceneTree
+
+# Compares the reflective NakamaSerializer with the per-class serializers generated by codegen
+# in NakamaAPI.gd, using a large leaderboard listing.
+#
+# godot --headless --path test_suite/ -s res://bench/serializer_bench.gd
+
+const RECORDS = 1000
+const ROUNDS = 20
+
+func _init():
+	var dict = _leaderboard(RECORDS)
+	var list : NakamaAPI.ApiLeaderboardRecordList = NakamaAPI.ApiLeaderboardRecordList._from_dict(dict)
+	var _records = list.records # Deserialize all the records up front, so both serializers do the same work
+
+	_report("deserialize", _measure(func():
+			NakamaSerializer.deserialize(NakamaAPI, "ApiLeaderboardRecordList", dict)
+		), _measure(func():
+			return NakamaAPI.ApiLeaderboardRecordList._from_dict(dict).records
+		))
+	_report("deserialize first 10", _measure(func():
+			NakamaSerializer.deserialize(NakamaAPI, "ApiLeaderboardRecordList", dict)
+		), _measure(func():
+			var lazy = NakamaAPI.ApiLeaderboardRecordList._from_dict(dict)
+			for i in 10:
+				lazy.get_records_at(i)
+		))
+	_report("serialize", _measure(func():
+			NakamaSerializer.serialize(list)
+		), _measure(func():
+			list._to_dict()
+		))
+	quit()
+
+func _leaderboard(p_count : int) -> Dictionary:
+	var records = []
+	for i in p_count:
+		records.append({
+			"create_time": "2024-03-19T10:00:00Z",
+			"expiry_time": "2024-03-26T10:00:00Z",
+			"leaderboard_id": "weekly",
+			"max_num_score": 1000000,
+			"metadata": "{\"level\": %d}" % i,
+			"num_score": 1,
+			"owner_id": "00000000-0000-0000-0000-%012d" % i,
+			"rank": str(i + 1),
+			"score": str(p_count - i),
+			"subscore": "0",
+			"update_time": "2024-03-19T10:00:00Z",
+			"username": "player%d" % i,
+		})
+	return {
+		"next_cursor": "next",
+		"prev_cursor": "prev",
+		"records": records,
+	}
+
+func _measure(p_call : Callable) -> float:
+	var start = Time.get_ticks_usec()
+	for r in ROUNDS:
+		p_call.call()
+	return float(Time.get_ticks_usec() - start) / ROUNDS / 1000.0
+
+func _report(p_name : String, p_reflective : float, p_generated : float):
+	print("%s %d records: reflective %.2f ms, generated %.2f ms (%.1fx)" % [
+		p_name, RECORDS, p_reflective, p_generated, p_reflective / max(p_generated, 0.001)
+	])
@@ -0,0 +1,22 @@
+extends "res://base_test.gd"
+
+func setup():
+	# Objects with the same fields are equal and have the same hash, whatever the order of the keys of their maps.
+	var a := NakamaAPI.ApiAccountDevice.create(NakamaAPI, {"id": "device", "vars": {"a": "1", "b": "2"}, "added_later": {"x": 1, "y": 2}})
+	var b := NakamaAPI.ApiAccountDevice.create(NakamaAPI, {"added_later": {"y": 2, "x": 1}, "vars": {"b": "2", "a": "1"}, "id": "device"})
+	if assert_cond(a.equals(b)):
+		return
+	if assert_equal(a.hash(), b.hash()):
+		return
+
+	# A deep copy shares no array or map with the original.
+	var flags := SatoriAPI.ApiFlagList.create(SatoriAPI, {"flags": [{"name": "double_xp", "value": "true"}]})
+	var copy := flags.duplicate_deep()
+	if assert_cond(copy.equals(flags)):
+		return
+	copy._flags[0]["value"] = "false"
+	if assert_equal(flags._flags[0]["value"], "true"):
+		return
+	if assert_false(copy.equals(flags)):
+		return
+	done()
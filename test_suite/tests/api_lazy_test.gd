@@ -0,0 +1,39 @@
+extends "res://base_test.gd"
+
+func setup():
+	var list := NakamaAPI.ApiLeaderboardRecordList.create(NakamaAPI, {"records": [
+		{"owner_id": "a", "score": "10", "num_score": 1},
+		{"owner_id": "b", "score": "5", "num_score": "2"},
+	]})
+
+	# Records which were not accessed are serialized as they were received.
+	var raw := NakamaAPI.ApiLeaderboardRecordList.create(NakamaAPI, {"records": [{"owner_id": "c", "num_score": "3"}]})
+	if assert_equal(raw.serialize()["records"], [{"owner_id": "c", "num_score": "3"}]):
+		return
+	if assert_cond(raw._records[0] is Dictionary):
+		return
+
+	# A missing field has no elements.
+	var empty := NakamaAPI.ApiLeaderboardRecordList.new()
+	if assert_equal(empty.get_records_at(0), null):
+		return
+	if assert_equal(list.get_records_at(2), null):
+		return
+
+	# The records stay dictionaries until they are accessed.
+	if assert_equal(list.get_records_count(), 2):
+		return
+	if assert_equal(list.get_records_at(0).owner_id, "a"):
+		return
+	if assert_cond(list._records[1] is Dictionary):
+		return
+
+	# Reading the whole array deserializes the others, with the rules of _from_dict().
+	var records : Array = list.records
+	if assert_equal(records[1].num_score, 2):
+		return
+	if assert_false(list._records_lazy):
+		return
+	if assert_equal(list.serialize()["records"][1], {"owner_id": "b", "score": "5", "num_score": 2}):
+		return
+	done()
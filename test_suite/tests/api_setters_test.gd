@@ -0,0 +1,47 @@
+extends "res://base_test.gd"
+
+func setup():
+	# The arguments of make() which are not null are set, even to the default value of their type.
+	var write := NakamaAPI.ApiWriteStorageObject.make("saves", "slot_1", 0, null, "{}")
+	if assert_equal(write.serialize(), {"collection": "saves", "key": "slot_1", "permission_read": 0, "value": "{}"}):
+		return
+
+	# Setters coerce the values like the ones received from the server.
+	var user := NakamaAPI.ApiUser.make()
+	user.username = &"player"
+	user.online = true
+	if assert_equal(user.serialize(), {"username": "player", "online": true}):
+		return
+
+	# Invalid values are rejected, and the field keeps its previous value.
+	var record := NakamaAPI.WriteLeaderboardRecordRequestLeaderboardRecordWrite.make(null, NakamaAPI.ApiOperator.BEST, "10")
+	record.operator = 42
+	if assert_equal(record.operator, NakamaAPI.ApiOperator.BEST):
+		return
+	if assert_equal(record.serialize(), {"operator": NakamaAPI.ApiOperator.BEST, "score": "10"}):
+		return
+
+	# Ints are accepted for the int64 values sent as strings.
+	var score := NakamaAPI.WriteLeaderboardRecordRequestLeaderboardRecordWrite.make(null, null, 10, 2)
+	if assert_equal(score.serialize(), {"score": "10", "subscore": "2"}):
+		return
+	if assert_equal(NakamaAPI.ApiLeaderboardRecord.create(NakamaAPI, {"score": 7}).score, "7"):
+		return
+
+	# The elements of maps and arrays are coerced and checked too.
+	var device := NakamaAPI.ApiAccountDevice.make("device", {"level": 3})
+	if assert_equal(device.vars, {"level": "3"}):
+		return
+	device.vars = {"level": [3]}
+	if assert_equal(device.vars, {"level": "3"}):
+		return
+	var list := NakamaAPI.ApiLeaderboardRecordList.new()
+	list.records = [{"owner_id": "a", "num_score": "2"}, NakamaAPI.ApiLeaderboardRecord.make(null, null, null, null, null, null, "b")]
+	if assert_equal(list.get_records_at(0).num_score, 2):
+		return
+	if assert_equal(list.get_records_at(1).owner_id, "b"):
+		return
+	list.records = ["a"]
+	if assert_equal(list.get_records_count(), 2):
+		return
+	done()
@@ -0,0 +1,43 @@
+extends "res://base_test.gd"
+
+const FakeServer = preload("res://utils/fake_server.gd")
+const ACCOUNT = "/v2/account"
+
+var client : NakamaClient
+var session : NakamaSession
+var results : Dictionary = {}
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	server.handle(ACCOUNT, func(_head, _body): return [200, {"user": {"id": "user"}}, 0.5])
+	client = Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http")
+	session = NakamaSession.new(FakeServer.token(3600), false, FakeServer.token(7200))
+
+	# Two requests started in the same frame, only the one of the closed screen is cancelled.
+	var closed_screen := NakamaCancellationToken.new()
+	var open_screen := NakamaCancellationToken.new()
+	_get_account("closed", closed_screen)
+	_get_account("open", open_screen)
+	await get_tree().create_timer(0.1).timeout
+	closed_screen.cancel()
+	while results.size() < 2:
+		await get_tree().process_frame
+	if assert_cond(results["closed"].was_cancelled()):
+		return
+	if assert_false(results["open"].is_exception()):
+		return
+
+	# A request started with a cancelled token is not sent.
+	var hits := server.count(ACCOUNT)
+	var late = await client.get_account_async(session, closed_screen)
+	if assert_cond(late.was_cancelled()):
+		return
+	if assert_equal(server.count(ACCOUNT), hits):
+		return
+	done()
+
+func _get_account(p_key : String, p_token : NakamaCancellationToken) -> void:
+	results[p_key] = await client.get_account_async(session, p_token)
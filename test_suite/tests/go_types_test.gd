@@ -0,0 +1,59 @@
+extends "res://base_test.gd"
+
+# guild_types.gd is generated with: go run main.go -from-go examples/guild_module Guild
+const Types = preload("res://utils/guild_types.gd")
+
+func setup():
+	var stored := {
+		"created_at": "2024-05-01T10:00:00Z",
+		"display_name": "Ada",
+		"editor": "admin",
+		"cooldown": 1500000000,
+		"inventory": {"sword": {"count": 1, "equipped": true}},
+		"guild": {"id": "g1", "members": [{"user_id": "u1", "rank": 2}]},
+		"scores": [[1, 2], [3]],
+		"extra": {"any": ["thing"]},
+		"title": "Founder",
+	}
+	var profile = Types.PlayerProfile.create(Types, stored)
+
+	# The fields of embedded structs are in the class, and their nested structs are classes.
+	if assert_equal(profile.created_at, "2024-05-01T10:00:00Z"):
+		return
+	if assert_equal(profile.editor, "admin"):
+		return
+	if assert_equal(profile.inventory["sword"].count, 1):
+		return
+	if assert_equal(profile.guild.members[0].rank, 2):
+		return
+	if assert_equal(profile.cooldown, 1500000000):
+		return
+	if assert_equal(profile.extra, {"any": ["thing"]}):
+		return
+
+	# Like encoding/json, the fields without omitempty are always encoded, and the others only when they are set.
+	var encoded : Dictionary = Types.InventoryItem.new().serialize()
+	if assert_equal(encoded, {"count": 0}):
+		return
+	encoded = profile.serialize()
+	if assert_equal(encoded["guild"]["open"], false):
+		return
+	if assert_false(encoded.has("updated_at") or encoded.has("settings")):
+		return
+	if assert_equal(encoded["scores"], [[1, 2], [3]]):
+		return
+	if assert_equal(Types.PlayerProfile.create(Types, encoded).serialize(), encoded):
+		return
+
+	# The keys which are not fields of the struct are kept, and sent back.
+	if assert_equal(encoded["title"], "Founder"):
+		return
+
+	# Setters check and coerce the values like the ones decoded.
+	profile.inventory = {"shield": {"count": 2}}
+	if assert_equal(profile.inventory["shield"].count, 2):
+		return
+	profile.inventory = {"shield": "broken"}
+	if assert_equal(profile.inventory["shield"].count, 2):
+		return
+	done()
@@ -0,0 +1,52 @@
+extends "res://base_test.gd"
+
+const FakeServer = preload("res://utils/fake_server.gd")
+const ACCOUNT = "/v2/account"
+
+class Tagger extends NakamaAPI.ApiClient.Interceptor:
+	var calls : Array = []
+
+	func before_request(p_request : NakamaAPI.ApiClient.Request) -> void:
+		calls.append("before " + p_request.operation_id)
+		p_request.headers["X-Tag"] = "tagged"
+
+	func after_response(p_request : NakamaAPI.ApiClient.Request, p_result : Variant) -> Variant:
+		calls.append("after " + p_request.operation_id)
+		if p_result is Dictionary:
+			p_result["user"]["id"] = "rewritten"
+		return p_result
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	var tags : Array = []
+	server.handle(ACCOUNT, func(head, _body):
+		tags.append(head.to_lower().contains("x-tag: tagged"))
+		return [200, {"user": {"id": "user"}}, 0.0])
+	var client := Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http")
+	var session := NakamaSession.new(FakeServer.token(3600), false, FakeServer.token(7200))
+
+	var tagger := Tagger.new()
+	client.add_interceptor(tagger)
+	var account = await client.get_account_async(session)
+	if assert_false(account.is_exception()):
+		return
+	if assert_equal(account.user.id, "rewritten"):
+		return
+	if assert_equal(tags, [true]):
+		return
+	if assert_equal(tagger.calls, ["before GetAccount", "after GetAccount"]):
+		return
+
+	# Removed interceptors are no longer called.
+	client.remove_interceptor(tagger)
+	account = await client.get_account_async(session)
+	if assert_equal(account.user.id, "user"):
+		return
+	if assert_equal(tags, [true, false]):
+		return
+	if assert_equal(tagger.calls.size(), 2):
+		return
+	done()
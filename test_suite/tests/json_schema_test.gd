@@ -0,0 +1,67 @@
+extends "res://base_test.gd"
+
+# storage_types.gd is generated with: go run main.go -json-schema examples/schemas Storage
+const Types = preload("res://utils/storage_types.gd")
+
+func setup():
+	var stored := {
+		"version": 2,
+		"display_name": "Ada_99",
+		"rank": Types.Rank.GRAND_MASTER,
+		"created_at": "2024-05-01T10:00:00Z",
+		"guild_id": null,
+		"tags": ["veteran"],
+		"inventory": {"sword": {"id": "sword", "count": 1, "durability": 0.5}},
+		"settings": {"language": "en"},
+	}
+	var profile = Types.PlayerProfile.create(Types, stored)
+	if assert_equal(profile.validate(), PackedStringArray()):
+		return
+	if assert_equal(profile.inventory["sword"].durability, 0.5):
+		return
+
+	# The defaults of the schema are the ones of the properties, and the nullable required fields are sent as null.
+	if assert_equal(profile.level, 1):
+		return
+	if assert_equal(profile.settings.volume, 0.8):
+		return
+	var encoded : Dictionary = profile.serialize()
+	if assert_cond(encoded.has("guild_id") and encoded["guild_id"] == null):
+		return
+	if assert_false(encoded.has("level")):
+		return
+	if assert_equal(Types.PlayerProfile.create(Types, encoded).serialize(), encoded):
+		return
+
+	# Setters reject the values which are not one of an enum of the schemas.
+	profile.rank = "diamond"
+	if assert_equal(profile.rank, Types.Rank.GRAND_MASTER):
+		return
+
+	# The errors have the path of the fields which do not match their constraints.
+	stored["display_name"] = "A!"
+	stored["rank"] = "diamond"
+	stored["tags"] = ["veteran", "admin"]
+	stored["inventory"]["sword"]["count"] = 0
+	stored["settings"]["language"] = "english"
+	stored.erase("created_at")
+	var errors : PackedStringArray = Types.PlayerProfile.create(Types, stored).validate()
+	var expected := [
+		"display_name is shorter than 3 characters",
+		"display_name does not match ^[A-Za-z0-9_]+$",
+		"rank is not one of [\"bronze\",\"silver\",\"gold\",\"grand_master\"]",
+		"created_at is required",
+		"tags[1] is not one of [\"new\",\"veteran\",\"tester\"]",
+		"inventory.sword.count is not greater than 0",
+		"settings.language does not match ^[a-z]{2}$",
+	]
+	if assert_equal(Array(errors), expected):
+		return
+
+	# Properties of allOf are merged into the class, and consts are the default of their property.
+	var gift = Types.GiftNotification.create(Types, {"user_id": "not-a-uuid", "item": {"id": "gem", "count": 3}})
+	if assert_equal(gift.kind, "gift"):
+		return
+	if assert_equal(Array(gift.validate()), ["user_id is not a valid uuid"]):
+		return
+	done()
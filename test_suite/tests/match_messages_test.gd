@@ -0,0 +1,65 @@
+extends "res://base_test.gd"
+
+# game_messages.gd is generated with: go run main.go -opcodes examples/match_opcodes.json Game
+const Messages = preload("res://utils/game_messages.gd")
+
+var received : Array = []
+var unknown : Array = []
+
+func setup():
+	var socket = Nakama.create_socket()
+	var messages = Messages.new(socket)
+	messages.received_player_input.connect(func(p_message, p_source): received.append([p_message, p_source]))
+	messages.received_chat_ping.connect(func(p_message, p_source): received.append([p_message, p_source]))
+	messages.received_unknown.connect(func(p_op_code, _p_source): unknown.append(p_op_code))
+
+	var input = Messages.PlayerInput.new()
+	input.tick = 42
+	input.move = Messages.Position.new()
+	input.move.x = 1.5
+	input.jump = true
+	input.emotes.append("wave")
+
+	# Match state is decoded by opcode and emitted with its typed signal.
+	var state = NakamaRTAPI.MatchData.new()
+	state.op_code = Messages.OpCode.PLAYER_INPUT
+	state.base64_data = Marshalls.raw_to_base64(input.encode())
+	socket.received_match_state.emit(state)
+	if assert_equal(received.size(), 1):
+		return
+	var decoded = received[0][0]
+	if assert_cond(decoded is Messages.PlayerInput):
+		return
+	if assert_equal(received[0][1], state):
+		return
+	if assert_equal(decoded.tick, 42):
+		return
+	if assert_equal(decoded.move.x, 1.5):
+		return
+	if assert_cond(decoded.jump):
+		return
+	if assert_equal(decoded.emotes, ["wave"]):
+		return
+
+	# So is party data.
+	var ping = Messages.ChatPing.new()
+	ping.label = "here"
+	var data = NakamaRTAPI.PartyData.new()
+	data.op_code = Messages.ChatPing.OP_CODE
+	data.base64_data = Marshalls.raw_to_base64(ping.encode())
+	socket.received_party_data.emit(data)
+	if assert_equal(received.size(), 2):
+		return
+	if assert_equal(received[1][0].label, "here"):
+		return
+	if assert_equal(received[1][0].at, null):
+		return
+
+	# Unknown opcodes, and payloads which are not messages, are reported as unknown.
+	messages.dispatch(99, PackedByteArray())
+	messages.dispatch(Messages.OpCode.STATE_SNAPSHOT, "[1, 2]".to_utf8_buffer())
+	if assert_equal(unknown, [99, Messages.OpCode.STATE_SNAPSHOT]):
+		return
+	if assert_equal(received.size(), 2):
+		return
+	done()
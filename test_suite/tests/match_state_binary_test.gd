@@ -0,0 +1,87 @@
+extends "res://base_test.gd"
+
+# arena_messages.gd is generated with: go run main.go -opcodes examples/match_state.json Arena
+const Messages = preload("res://utils/arena_messages.gd")
+
+func setup():
+	var sender = Messages.new(Nakama.create_socket())
+	var receiver = Messages.new(Nakama.create_socket())
+
+	# Binary messages round trip, within the precision of quantised floats and the range of ranged ints.
+	var input = Messages.FighterInput.new()
+	input.tick = -7
+	input.move_x = 0.5
+	input.move_y = 3.0
+	input.attack = true
+	var payload : PackedByteArray = input.encode()
+	if assert_equal(payload.size(), 3):
+		return
+	var decoded = Messages.FighterInput.decode(payload)
+	if assert_equal(decoded.tick, -7):
+		return
+	if assert_cond(absf(decoded.move_x - 0.5) <= 1.0 / 127):
+		return
+	if assert_cond(is_equal_approx(decoded.move_y, 1.0)):
+		return
+	if assert_cond(decoded.attack and not decoded.block):
+		return
+	if assert_equal(Messages.FighterInput.decode(payload.slice(0, 1)), null):
+		return
+
+	var state = Messages.ArenaState.new()
+	state.tick = 100
+	state.round_ends_in = 90.0
+	for i in range(4):
+		var fighter = Messages.Fighter.new()
+		fighter.slot = i
+		fighter.position = Messages.Position.new()
+		fighter.position.x = i * 10.25
+		fighter.health = 500
+		state.fighters.append(fighter)
+
+	# The first state is sent in full.
+	var full : PackedByteArray = sender.encode_arena_state(state)
+	var received = receiver.decode_arena_state(full)
+	if assert_equal(receiver.arena_state_sequence, 1):
+		return
+	if assert_equal(received.fighters.size(), 4):
+		return
+	if assert_cond(is_equal_approx(received.fighters[3].position.x, 30.75)):
+		return
+	if assert_equal(received.fighters[3].health, 200):
+		return
+
+	# Until it is acknowledged, the next ones are sent in full too.
+	state.tick = 101
+	if assert_equal(sender.encode_arena_state(state).size(), full.size()):
+		return
+	receiver.decode_arena_state(sender.encode_arena_state(state))
+
+	# Once it is, only the changes from it are sent: a flag per field, and the changed fields.
+	sender.acknowledge_arena_state(receiver.arena_state_sequence)
+	state.tick = 102
+	var delta : PackedByteArray = sender.encode_arena_state(state)
+	if assert_equal(delta.size(), 5):
+		return
+	received = receiver.decode_arena_state(delta)
+	if assert_equal(received.tick, 102):
+		return
+	if assert_equal(received.fighters.size(), 4):
+		return
+	if assert_cond(is_equal_approx(received.round_ends_in, 90.0)):
+		return
+	state.announcement = "Final round"
+	received = receiver.decode_arena_state(sender.encode_arena_state(state))
+	if assert_equal(received.announcement, "Final round"):
+		return
+	if assert_equal(received.fighters[2].slot, 2):
+		return
+
+	# Changes from a state the receiver does not have, and older states, are not decoded.
+	if assert_equal(Messages.new(Nakama.create_socket()).decode_arena_state(delta), null):
+		return
+	if assert_equal(receiver.decode_arena_state(full), null):
+		return
+	if assert_equal(receiver.arena_state_sequence, 5):
+		return
+	done()
@@ -0,0 +1,46 @@
+extends "res://base_test.gd"
+
+# game_notifications.gd is generated with: go run main.go -notifications examples/notifications.json Game
+const Notifications = preload("res://utils/game_notifications.gd")
+
+func setup():
+	var notifications = Notifications.new()
+	var received := []
+	notifications.received_gift_received.connect(func(p_notification): received.append(p_notification))
+	notifications.received_tournament_ended.connect(func(p_notification): received.append(p_notification))
+	notifications.received_friend_request.connect(func(p_notification): received.append(p_notification))
+	notifications.received_unknown.connect(func(p_notification): received.append(p_notification.code))
+
+	var list = NakamaAPI.ApiNotificationList.create(NakamaAPI, {"notifications": [
+		{"id": "n1", "code": 100, "sender_id": "u2", "persistent": true,
+			"content": JSON.stringify({"kind": "gift", "user_id": "u2", "item": {"id": "gem", "count": 3}})},
+		{"id": "n2", "code": 102, "content": JSON.stringify({"tournament_id": "t1", "rank": 2, "reward": {"kind": "reward", "amount": 50}})},
+		{"id": "n3", "code": -2, "subject": "Ada wants to be your friend", "content": ""},
+		{"id": "n4", "code": 7, "content": "{}"},
+		{"id": "n5", "code": 101, "content": "not json"},
+	]})
+	notifications.dispatch_all(list.notifications)
+	if assert_equal(received.size(), 5):
+		return
+
+	# Each code has its class, with the class of its content.
+	var gift = received[0]
+	if assert_cond(gift is Notifications.GiftReceivedNotification):
+		return
+	if assert_equal([gift.id, gift.sender_id, gift.persistent, gift.code], ["n1", "u2", true, Notifications.Code.GIFT_RECEIVED]):
+		return
+	if assert_equal(gift.content.item.count, 3):
+		return
+	if assert_equal(received[1].content.reward.amount, 50):
+		return
+	if assert_equal(received[2].content, {}):
+		return
+
+	# Unknown codes and contents which are not JSON objects are not typed.
+	if assert_equal(received.slice(3), [7, 101]):
+		return
+	if assert_cond(Notifications.parse(list.notifications[3]) == null):
+		return
+	if assert_cond(Notifications.parse(list.notifications[4]).is_exception()):
+		return
+	done()
@@ -0,0 +1,35 @@
+extends "res://base_test.gd"
+
+const FakeServer = preload("res://utils/fake_server.gd")
+const LIST = "/v2/storage/Collection"
+const WRITE = "/v2/storage"
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	# Both endpoints answer after the client gave up.
+	var slow := func(_head, _body): return [200, {}, 2.0]
+	server.handle(LIST, slow)
+	server.handle(WRITE, slow)
+	var client := Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http", 1)
+	client.auto_retry_count = 2
+	var session := NakamaSession.new(FakeServer.token(3600), false, FakeServer.token(7200))
+
+	# Reads are idempotent, they are sent again after a timeout.
+	var list = await client.list_storage_objects_async(session, "Collection")
+	if assert_cond(list.is_exception()):
+		return
+	if assert_equal(server.count(LIST), 2):
+		return
+
+	# Writes are not, a timeout is returned right away.
+	var write = await client.write_storage_objects_async(session, [
+		NakamaWriteStorageObject.new("Collection", "Key", 1, 1, "{}", "")
+	])
+	if assert_cond(write.is_exception()):
+		return
+	if assert_equal(server.count(WRITE), 1):
+		return
+	done()
@@ -0,0 +1,48 @@
+extends "res://base_test.gd"
+
+# guild_rpcs.gd is generated with: go run main.go -rpcs examples/guild_module Guild
+const Rpcs = preload("res://utils/guild_rpcs.gd")
+const FakeServer = preload("res://utils/fake_server.gd")
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	var sent := {}
+	server.handle("/v2/rpc/create_guild", func(_head, p_body):
+		# The payload of the RPC is sent as a JSON string.
+		sent["request"] = JSON.parse_string(JSON.parse_string(p_body))
+		var guild := {"id": "g1", "name": "Knights", "open": true, "members": [{"user_id": "u1", "rank": 0}], "stats": {"wins": 3}}
+		return [200, {"id": "create_guild", "payload": JSON.stringify(guild)}, 0.0])
+	server.handle("/v2/rpc/guild.search", func(_head, _body):
+		return [200, {"id": "guild.search", "payload": "not a guild list"}, 0.0])
+	var client := Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http")
+	var session := NakamaSession.new(FakeServer.token(3600), false, FakeServer.token(7200))
+	var rpcs = Rpcs.Client.new(client)
+
+	# The request is encoded from its class, without the fields which are not set.
+	var request = Rpcs.CreateGuildRequest.new()
+	request.name = "Knights"
+	request.open = true
+	var guild = await rpcs.create_guild_async(session, request)
+	if assert_false(guild.is_exception()):
+		return
+	if assert_equal(sent["request"], {"name": "Knights", "open": true}):
+		return
+
+	# The response is decoded into its class, with the nested classes.
+	if assert_cond(guild is Rpcs.Guild):
+		return
+	if assert_equal(guild.members[0].user_id, "u1"):
+		return
+	if assert_equal(guild.stats["wins"], 3.0):
+		return
+	if assert_equal(guild.level, 0):
+		return
+
+	# A response which is not JSON is an exception.
+	var list = await rpcs.guild_search_async(session, {"name": "Kn"})
+	if assert_cond(list is Rpcs.GuildList and list.is_exception()):
+		return
+	done()
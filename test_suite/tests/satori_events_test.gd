@@ -0,0 +1,53 @@
+extends "res://base_test.gd"
+
+# game_events.gd is generated with: go run main.go -satori-events examples/satori_events.json Game
+const GameEvents = preload("res://utils/game_events.gd")
+const FakeServer = preload("res://utils/fake_server.gd")
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	var sent := []
+	server.handle("/v1/event", func(p_head, p_body):
+		sent.append_array(JSON.parse_string(p_body)["events"])
+		return [200, {}, 0.0])
+	var adapter := SatoriHTTPAdapter.new()
+	add_child(adapter)
+	var client := SatoriClient.new(adapter, "key", "http", "127.0.0.1", server.port, 10)
+	var session := SatoriSession.new(FakeServer.token(3600), FakeServer.token(7200))
+	var events = GameEvents.new(client)
+
+	# The constructors convert the metadata to strings, and omit the optional ones which are null.
+	var completed : Event = GameEvents.level_completed("forest_1", 3, 1200, "hard", null, true)
+	if assert_equal([completed.name, completed.value], [GameEvents.LEVEL_COMPLETED, "1200"]):
+		return
+	if assert_equal(completed.metadata, {"level_id": "forest_1", "stars": "3", "difficulty": "hard", "firstTry": "true"}):
+		return
+	if assert_equal(GameEvents.validate(completed).size(), 0):
+		return
+
+	# Unknown events and metadata keys, missing metadata and invalid values are rejected.
+	if assert_equal(GameEvents.validate(Event.new("level_complete", 0)).size(), 1):
+		return
+	var typo := Event.new(GameEvents.LEVEL_COMPLETED, 0, "a lot", {"level_id": "forest_1", "star": "3", "difficulty": "extreme", "duration": "fast"})
+	if assert_equal(GameEvents.validate(typo).size(), 5):
+		return
+	if assert_equal(GameEvents.validate(GameEvents.purchase("sword", "dollars", 1.5)).size(), 1):
+		return
+
+	# Events are sent only when all of them are valid.
+	var result = await events.events_async(session, [completed, typo])
+	if assert_cond(result.is_exception()):
+		return
+	if assert_equal(server.count("/v1/event"), 0):
+		return
+	result = await events.events_async(session, [completed, GameEvents.purchase("sword", "gems", 1.5), GameEvents.tutorial_skipped()])
+	if assert_false(result.is_exception()):
+		return
+	if assert_equal(sent.map(func(e): return e["name"]), ["level_completed", "purchase", "tutorial_skipped"]):
+		return
+	if assert_equal(sent[1]["metadata"], {"item_id": "sword", "currency": "gems"}):
+		return
+	done()
@@ -0,0 +1,82 @@
+extends "res://base_test.gd"
+
+# game_satori.gd is generated with: go run main.go -satori examples/satori_manifest.json Game
+const GameSatori = preload("res://utils/game_satori.gd")
+const FakeServer = preload("res://utils/fake_server.gd")
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	var state := {"available": false}
+	server.handle("/v1/flag", func(p_head, p_body):
+		if not state["available"]:
+			return [503, {"code": 14, "message": "Unavailable"}, 0.0]
+		return [200, {"flags": [
+			{"name": "double_xp", "value": "true"},
+			{"name": "max_energy", "value": "not a number"},
+			{"name": "drop_rate", "value": "0.5"},
+			{"name": "starter_item", "value": JSON.stringify({"id": "axe", "count": 2})},
+			{"name": "remote_config", "value": JSON.stringify({"theme": "dark"})},
+		]}, 0.0])
+	server.handle("/v1/experiment", func(p_head, p_body):
+		return [200, {"experiments": [
+			{"name": "onboarding_flow", "value": "short_tutorial"},
+			{"name": "shop-layout", "value": JSON.stringify({"columns": 1})},
+		]}, 0.0])
+	server.handle("/v1/live-event", func(p_head, p_body):
+		return [200, {"live_events": [
+			{"id": "e1", "name": "summer_festival", "active_start_time_sec": "1000", "active_end_time_sec": "0",
+				"value": JSON.stringify({"bonus": 1.5, "reward": {"id": "hat", "count": 1}})},
+		]}, 0.0])
+	var adapter := SatoriHTTPAdapter.new()
+	add_child(adapter)
+	var client := SatoriClient.new(adapter, "key", "http", "127.0.0.1", server.port, 10)
+	var session := SatoriSession.new(FakeServer.token(3600), FakeServer.token(7200))
+	var satori = GameSatori.new(client)
+
+	# The flags have their defaults when the server is unreachable.
+	var flags = await satori.get_flags_async(session)
+	if assert_cond(flags.is_exception()):
+		return
+	if assert_equal([flags.double_xp, flags.max_energy, flags.drop_rate, flags.motd], [false, 100, 0.25, "Welcome!"]):
+		return
+	if assert_equal([flags.starter_item.id, flags.store_config.currency], ["wooden_sword", "coins"]):
+		return
+	if assert_equal(await satori.get_max_energy_async(session), 100):
+		return
+
+	# The values are typed, and the ones which can not be parsed keep their default.
+	state["available"] = true
+	flags = await satori.get_flags_async(session)
+	if assert_false(flags.is_exception()):
+		return
+	if assert_equal([flags.double_xp, flags.max_energy, flags.drop_rate], [true, 100, 0.5]):
+		return
+	if assert_equal([flags.starter_item.id, flags.starter_item.count, flags.remote_config], ["axe", 2, {"theme": "dark"}]):
+		return
+	if assert_equal(flags.values["max_energy"], "not a number"):
+		return
+	if assert_cond(await satori.get_double_xp_async(session)):
+		return
+
+	# Experiments have the variant of their value, NONE when the identity is not in them.
+	var experiments = await satori.get_experiments_async(session)
+	if assert_equal([experiments.onboarding_flow, experiments.shop_layout], [GameSatori.OnboardingFlow.SHORT_TUTORIAL, GameSatori.ShopLayout.LIST]):
+		return
+	if assert_equal(experiments.shop_layout_config.columns, 1):
+		return
+	if assert_equal(GameSatori.Experiments.new().onboarding_flow, GameSatori.OnboardingFlow.NONE):
+		return
+
+	# Live events have their config, and are null when they are not active.
+	var live_events = await satori.get_live_events_async(session)
+	if assert_cond(live_events.weekend_raid == null):
+		return
+	var festival = live_events.summer_festival
+	if assert_equal([festival.id, festival.start_time, festival.config.bonus, festival.config.reward.id], ["e1", 1000, 1.5, "hat"]):
+		return
+	if assert_cond(festival.is_active()):
+		return
+	done()
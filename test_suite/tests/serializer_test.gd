@@ -0,0 +1,42 @@
+extends "res://base_test.gd"
+
+class Payload extends NakamaAsyncResult:
+
+	const _SCHEMA = {
+		"name": {"name": "_name", "type": TYPE_STRING, "required": false},
+	}
+
+	var _name
+	var _unknown : Dictionary = {}
+
+func setup():
+	var dict = {
+		"name": "known",
+		"added_later": {"nested": [1, 2, 3]},
+	}
+	var payload = NakamaSerializer.deserialize(get_script(), "Payload", dict)
+	if assert_equal(payload._name, "known"):
+		return
+	if assert_cond(payload._unknown.has("added_later")):
+		return
+	# Unknown fields must survive a read-modify-write.
+	payload._name = "changed"
+	var out = NakamaSerializer.serialize(payload)
+	if assert_equal(out["name"], "changed"):
+		return
+	if assert_equal(JSON.stringify(out["added_later"]), JSON.stringify(dict["added_later"])):
+		return
+
+	# The generated API classes keep them too, with both serializers.
+	var account_dict = {
+		"custom_id": "custom",
+		"user": {"id": "user", "added_later": true},
+		"added_later": {"nested": [1, 2, 3]},
+	}
+	var account = NakamaAPI.ApiAccount.create(NakamaAPI, account_dict)
+	if assert_equal(account.serialize(), account_dict):
+		return
+	account = NakamaSerializer.deserialize(NakamaAPI, "ApiAccount", account_dict)
+	if assert_equal(NakamaSerializer.serialize(account)["added_later"], account_dict["added_later"]):
+		return
+	done()
@@ -0,0 +1,63 @@
+extends "res://base_test.gd"
+
+const FakeServer = preload("res://utils/fake_server.gd")
+const REFRESH = "/v2/account/session/refresh"
+const ACCOUNT = "/v2/account"
+const CONCURRENT = 5
+
+var server : FakeServer
+var client : NakamaClient
+var results : Array = []
+var refresh_fails = false
+
+func setup():
+	server = FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	server.handle(REFRESH, _on_refresh)
+	server.handle(ACCOUNT, func(_head, _body): return [200, {"user": {"id": "user"}}, 0.0])
+	client = Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http")
+
+	# Concurrent requests with a session about to expire trigger a single refresh.
+	var session := NakamaSession.new(FakeServer.token(10), false, FakeServer.token(3600))
+	await _run_concurrently(session)
+	if assert_equal(server.count(REFRESH), 1):
+		return
+	if assert_equal(server.count(ACCOUNT), CONCURRENT):
+		return
+	for result in results:
+		if assert_false(result.is_exception()):
+			return
+	if assert_false(session.would_expire_in(300)):
+		return
+
+	# A failed refresh is surfaced to all the requests which waited for it.
+	refresh_fails = true
+	session = NakamaSession.new(FakeServer.token(10), false, FakeServer.token(7200))
+	await _run_concurrently(session)
+	if assert_equal(server.count(REFRESH), 2):
+		return
+	if assert_equal(server.count(ACCOUNT), CONCURRENT):
+		return
+	for result in results:
+		if assert_cond(result.is_exception()):
+			return
+		if assert_equal(result.get_exception().status_code, 401):
+			return
+	done()
+
+func _on_refresh(_head, _body):
+	if refresh_fails:
+		return [401, {"code": 16, "message": "Refresh token invalid or expired."}, 0.2]
+	return [200, {"token": FakeServer.token(3600), "refresh_token": FakeServer.token(7200)}, 0.2]
+
+func _run_concurrently(p_session : NakamaSession) -> void:
+	results = []
+	for i in range(CONCURRENT):
+		_get_account(p_session)
+	while results.size() < CONCURRENT:
+		await get_tree().process_frame
+
+func _get_account(p_session : NakamaSession) -> void:
+	results.append(await client.get_account_async(p_session))
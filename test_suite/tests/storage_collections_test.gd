@@ -0,0 +1,70 @@
+extends "res://base_test.gd"
+
+# game_storage.gd is generated with: go run main.go -storage examples/storage_manifest.json Game
+const Storage = preload("res://utils/game_storage.gd")
+const FakeServer = preload("res://utils/fake_server.gd")
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	var stored := {}
+	server.handle("/v2/storage", func(p_head, p_body):
+		var body = JSON.parse_string(p_body)
+		if p_head.begins_with("PUT"):
+			# Writes are rejected when the object is not at their version.
+			var write : Dictionary = body["objects"][0]
+			var current : String = stored.get("version", "*")
+			if write.get("version", "") != "" and write["version"] != current:
+				return [400, {"code": 3, "message": "Storage write rejected - version check failed."}, 0.0]
+			stored = write.duplicate()
+			stored["version"] = str(current.to_int() + 1)
+			stored["user_id"] = "user"
+			return [200, {"acks": [{"collection": write["collection"], "key": write["key"], "version": stored["version"], "user_id": "user"}]}, 0.0]
+		if stored.is_empty() or body["object_ids"][0]["key"] != stored["key"]:
+			return [200, {"objects": []}, 0.0]
+		return [200, {"objects": [stored]}, 0.0])
+	var client := Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http")
+	var session := NakamaSession.new(FakeServer.token(3600), false, FakeServer.token(7200))
+	var storage = Storage.new(client)
+
+	# An object which does not exist is at version "*", so that writing it creates it only if it still does not.
+	var object = await storage.profiles.read_async(session)
+	if assert_false(object.is_exception() or object.exists):
+		return
+	if assert_equal(object.version, "*"):
+		return
+
+	# Values which do not match their schema are not sent.
+	object.value = Storage.PlayerProfile.new()
+	object.value.display_name = "Ada"
+	var ack = await storage.profiles.write_object_async(session, object)
+	if assert_cond(ack.is_exception() and server.count("/v2/storage") == 1):
+		return
+
+	object.value.rank = Storage.Rank.GOLD
+	object.value.created_at = "2024-05-01T10:00:00Z"
+	ack = await storage.profiles.write_object_async(session, object)
+	if assert_false(ack.is_exception()):
+		return
+	if assert_equal([object.version, object.exists], ["1", true]):
+		return
+	if assert_equal([stored["key"], int(stored["permission_read"]), int(stored["permission_write"])], ["profile", 2, 1]):
+		return
+
+	# The object read has the version of the last write, and a write with an older one is rejected.
+	object = await storage.profiles.read_async(session)
+	if assert_equal([object.version, object.value.rank], ["1", "gold"]):
+		return
+	ack = await storage.profiles.write_async(session, object.value, "0")
+	if assert_cond(ack.is_exception()):
+		return
+	ack = await storage.profiles.write_object_async(session, object)
+	if assert_equal(ack.version, "2"):
+		return
+
+	# The keys are built from their parameters.
+	if assert_equal(Storage.DeviceSettingsCollection.key("ios", "d1"), "ios_d1"):
+		return
+	done()
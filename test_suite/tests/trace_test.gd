@@ -0,0 +1,60 @@
+extends "res://base_test.gd"
+
+const FakeServer = preload("res://utils/fake_server.gd")
+const ACCOUNT = "/v2/account"
+const GAME_SESSION = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+func setup():
+	var server := FakeServer.new()
+	if assert_cond(server.port > 0):
+		return
+	add_child(server)
+	var heads : Array = []
+	server.handle(ACCOUNT, func(head, _body):
+		heads.append(head.to_lower())
+		if heads.size() == 3:
+			return [500, {"code": 13, "message": "Internal error"}, 0.0]
+		return [200, {"user": {"id": "user"}}, 0.0])
+	var client := Nakama.create_client("defaultkey", "127.0.0.1", server.port, "http")
+	var session := NakamaSession.new(FakeServer.token(3600), false, FakeServer.token(7200))
+
+	# Each request gets its own trace ID, sent in the traceparent header.
+	var first = await client.get_account_async(session)
+	var second = await client.get_account_async(session)
+	if assert_equal(first.trace_id.length(), 32):
+		return
+	if assert_cond(first.trace_id != second.trace_id):
+		return
+	if assert_cond(heads[0].contains("traceparent: 00-%s-" % first.trace_id)):
+		return
+
+	# A shared trace ID is used by all requests and failures carry it too.
+	client.trace_id = GAME_SESSION
+	client.correlation_header = "X-Correlation-Id"
+	var failed = await client.get_account_async(session)
+	if assert_cond(failed.is_exception()):
+		return
+	if assert_equal(failed.trace_id, GAME_SESSION):
+		return
+	if assert_equal(failed.get_exception().trace_id, GAME_SESSION):
+		return
+	if assert_cond(heads[2].contains("traceparent: 00-%s-" % GAME_SESSION)):
+		return
+	if assert_cond(heads[2].contains("x-correlation-id: %s" % GAME_SESSION)):
+		return
+
+	# An ID which is not a trace ID is rejected, and trace_id_of() makes one of it.
+	client.trace_id = "game-session-1"
+	if assert_equal(client.trace_id, GAME_SESSION):
+		return
+	var hashed := NakamaTrace.trace_id_of("game-session-1")
+	if assert_cond(NakamaTrace.is_valid_trace_id(hashed)):
+		return
+	if assert_equal(NakamaTrace.trace_id_of(GAME_SESSION), GAME_SESSION):
+		return
+
+	# Sockets created from the client use it in the IDs of their messages.
+	var socket = Nakama.create_socket_from(client)
+	if assert_equal(socket.trace_id, GAME_SESSION):
+		return
+	done()
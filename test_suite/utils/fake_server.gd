@@ -0,0 +1,75 @@
+extends Node
+
+# A minimal HTTP server on localhost, for tests which must control the server responses.
+# Handlers are registered by path, and return [status, body, delay_seconds].
+
+var port : int = 0
+var hits : Dictionary = {}
+
+var _server := TCPServer.new()
+var _handlers : Dictionary = {}
+var _peers : Array = []
+
+func _init(p_port : int = 0):
+	for attempt in range(20):
+		port = p_port if p_port > 0 else randi_range(20000, 40000)
+		if _server.listen(port, "127.0.0.1") == OK:
+			return
+	port = 0
+
+func handle(p_path : String, p_handler : Callable) -> void:
+	_handlers[p_path] = p_handler
+
+func count(p_path : String) -> int:
+	return hits.get(p_path, 0)
+
+func _exit_tree():
+	_server.stop()
+
+func _process(_delta):
+	while _server.is_connection_available():
+		_peers.append({"conn": _server.take_connection(), "data": PackedByteArray()})
+	for peer in _peers.duplicate():
+		var conn : StreamPeerTCP = peer["conn"]
+		conn.poll()
+		if conn.get_status() != StreamPeerTCP.STATUS_CONNECTED:
+			_peers.erase(peer)
+			continue
+		var available := conn.get_available_bytes()
+		if available > 0:
+			peer["data"].append_array(conn.get_data(available)[1])
+		var text : String = peer["data"].get_string_from_utf8()
+		var header_end := text.find("\r\n\r\n")
+		if header_end == -1:
+			continue
+		var length := 0
+		for line in text.substr(0, header_end).split("\r\n"):
+			if line.to_lower().begins_with("content-length:"):
+				length = int(line.split(":")[1].strip_edges())
+		if peer["data"].size() < header_end + 4 + length:
+			continue
+		_peers.erase(peer)
+		_respond(conn, text.substr(0, header_end), text.substr(header_end + 4))
+
+func _respond(p_conn : StreamPeerTCP, p_head : String, p_body : String) -> void:
+	var path := p_head.split("\r\n")[0].split(" ")[1].split("?")[0]
+	hits[path] = count(path) + 1
+	var reply : Array = [404, {"code": 5, "message": "Not found"}, 0.0]
+	if _handlers.has(path):
+		reply = _handlers[path].call(p_head, p_body)
+	if reply[2] > 0:
+		await get_tree().create_timer(reply[2]).timeout
+		p_conn.poll()
+		if p_conn.get_status() != StreamPeerTCP.STATUS_CONNECTED:
+			return # The client gave up waiting.
+	var body := JSON.stringify(reply[1]).to_utf8_buffer()
+	var head := "HTTP/1.1 %d Status\r\nContent-Type: application/json\r\nContent-Length: %d\r\nConnection: close\r\n\r\n" % [reply[0], body.size()]
+	p_conn.put_data(head.to_utf8_buffer())
+	p_conn.put_data(body)
+	p_conn.disconnect_from_host()
+
+# An unsigned JWT which the client can parse, expiring in p_expires_in seconds.
+static func token(p_expires_in : int, p_user_id : String = "user") -> String:
+	var payload := {"exp": int(Time.get_unix_time_from_system()) + p_expires_in, "uid": p_user_id, "usn": p_user_id}
+	var encoded := Marshalls.utf8_to_base64(JSON.stringify(payload)).replace("+", "-").replace("/", "_").replace("=", "")
+	return "e30.%s.sig" % encoded